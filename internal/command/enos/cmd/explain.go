@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+func newExplainCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "explain [CODE]",
+		Short: "Explain a diagnostic code",
+		Long: `Explain a diagnostic code. Diagnostics that have a code, e.g. 'Error[E1203]', can be explained
+to show their remediation and documentation. If no code is given all known codes are listed.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			req := &pb.ExplainDiagnosticCodeRequest{}
+			if len(args) > 0 {
+				req.Code = args[0]
+			}
+
+			res, err := rootState.enosConnection.Client.ExplainDiagnosticCode(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			return ui.ShowDiagnosticCodeExplanation(res)
+		},
+	}
+}
@@ -34,6 +34,7 @@ func newFmtCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVarP(&fmtCfg.Check, "check", "c", false, "Check if the input is formatted. Exit will be 0 for success, 1 for an error, 3 for success but files would be changed")
 	cmd.PersistentFlags().BoolVarP(&fmtCfg.Diff, "diff", "d", false, "Display the unified diff for files that change")
 	cmd.PersistentFlags().BoolVarP(&fmtCfg.Recursive, "recursive", "r", false, "Recursively scan the directory and format the files")
+	cmd.PersistentFlags().BoolVar(&fmtCfg.Canonical, "canonical", false, "Also order top-level blocks, sort matrix values, verifies, and depends_on, align step variables, and normalize depends_on references")
 
 	return cmd
 }
@@ -86,16 +87,23 @@ func runFmtCmd(cmd *cobra.Command, args []string) error {
 		}
 
 		files := []*pb.FormatRequest_File{}
+		seen := map[string]struct{}{}
 		readRawFiles := func(path string, info fs.FileInfo, err error) error {
 			if err != nil {
 				return err
 			}
 
-			fpFiles, err := flightplan.FindRawFiles(path, flightplan.FlightPlanFileNamePattern)
+			fpFiles, err := flightplan.FindFlightPlanFiles(path)
 			if err != nil {
 				return err
 			}
 			for path, bytes := range fpFiles {
+				// Included files might be found more than once when recursing
+				if _, ok := seen[path]; ok {
+					continue
+				}
+				seen[path] = struct{}{}
+
 				files = append(files, &pb.FormatRequest_File{
 					Path: path,
 					Body: bytes,
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hashicorp/enos/internal/ui/record"
+)
+
+type replayConfig struct {
+	realtime bool
+	speed    float64
+}
+
+var replayCfg = &replayConfig{}
+
+// newReplayCmd returns a new 'replay' command.
+func newReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay RECORDING",
+		Short: "Replay a recording of scenario operations",
+		Long: `Replay a recording of scenario operations that was created with --record. The recording
+is shown with the configured --format, which allows re-rendering the output of a scenario command
+after the fact, e.g. to view a CI failure locally or to build an HTML report.`,
+		Args:              cobra.ExactArgs(1),
+		PersistentPreRunE: replayCmdPreRun,
+		RunE:              runReplayCmd,
+	}
+
+	cmd.PersistentFlags().BoolVar(&replayCfg.realtime, "realtime", false, "Pace the replay by the time between events when they were recorded")
+	cmd.PersistentFlags().Float64Var(&replayCfg.speed, "speed", 1, "The speed multiplier of a --realtime replay")
+
+	return cmd
+}
+
+// replayCmdPreRun is the replay command pre-run. Replaying does not require an enos server so
+// we only setup our UI.
+func replayCmdPreRun(cmd *cobra.Command, args []string) error {
+	cmd.SilenceErrors = true // we handle this ourselves
+
+	if err := setupCLIUI(); err != nil {
+		return err
+	}
+
+	cmd.SilenceUsage = true
+
+	return nil
+}
+
+// runReplayCmd runs the replay command.
+func runReplayCmd(cmd *cobra.Command, args []string) error {
+	f, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("opening recording: %w", err)
+	}
+	defer f.Close()
+
+	opts := []record.ReplayOpt{}
+	if replayCfg.realtime {
+		opts = append(opts, record.WithRealtime(replayCfg.speed))
+	}
+
+	return record.Replay(context.Background(), f, ui, opts...)
+}
@@ -64,13 +64,16 @@ func Execute() {
 	rootCmd.AddCommand(newVersionCmd())
 	rootCmd.AddCommand(newScenarioCmd())
 	rootCmd.AddCommand(newFmtCmd())
+	rootCmd.AddCommand(newExplainCmd())
+	rootCmd.AddCommand(newReplayCmd())
+	rootCmd.AddCommand(newServerCmd())
 
 	rootCmd.PersistentFlags().StringVar(&rootState.logLevel, "log-level", "info", "The log level for client output. Supported levels are error, warn, info, debug, and trace")
 	rootCmd.PersistentFlags().StringVar(&rootState.logLevelServer, "server-log-level", "error", "The log level for server output. Supported leves are error, warn, info, and debug")
 	rootCmd.PersistentFlags().StringVar(&rootState.grpcListenAddr, "grpc-listen", "http://localhost:3205", "The gRPC server listen address")
 	rootCmd.PersistentFlags().IntVar(&rootState.grpcMaxRecv, "grpc-max-recv", 1024*1025*20, "The gRPC max message receive size in bytes")
 	rootCmd.PersistentFlags().IntVar(&rootState.grpcMaxSend, "grpc-max-send", 1024*1025*20, "The gRPC max message send size in bytes")
-	rootCmd.PersistentFlags().StringVar(&rootState.format, "format", "text", "The output format to use: text or json")
+	rootCmd.PersistentFlags().StringVar(&rootState.format, "format", "text", "The output format to use: text, json, html, markdown, sarif, or tui")
 	rootCmd.PersistentFlags().StringVar(&rootState.stdoutPath, "stdout", "", "The path to write output. (default $STDOUT)")
 	rootCmd.PersistentFlags().StringVar(&rootState.stderrPath, "stderr", "", "The path to write error output. (default $STDERR)")
 	rootCmd.PersistentFlags().Int32Var(&rootState.operatorConfig.WorkerCount, "worker-count", 4, "The number of scenario operation workers")
@@ -115,6 +118,18 @@ func setupCLIUI() error {
 		uiCfg.Format = pb.UI_Settings_FORMAT_HTML
 	}
 
+	if rootState.format == "markdown" {
+		uiCfg.Format = pb.UI_Settings_FORMAT_MARKDOWN
+	}
+
+	if rootState.format == "sarif" {
+		uiCfg.Format = pb.UI_Settings_FORMAT_SARIF
+	}
+
+	if rootState.format == "tui" {
+		uiCfg.Format = pb.UI_Settings_FORMAT_TUI
+	}
+
 	if term.IsTerminal(int(os.Stdout.Fd())) {
 		uiCfg.IsTty = true
 		uiCfg.UseColor = true
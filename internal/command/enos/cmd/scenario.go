@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/enos/internal/diagnostics"
 	"github.com/hashicorp/enos/internal/flightplan"
 	"github.com/hashicorp/enos/internal/operation/terraform"
+	"github.com/hashicorp/enos/internal/ui/record"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 )
 
@@ -31,6 +32,8 @@ type scenarioStateType struct {
 	sampleFilter        *sampleObserveFilter
 	noValidateSamples   bool
 	noValidateScenarios bool
+	recordPath          string
+	failurePolicy       string
 }
 
 // scenarioState is the 'scenario' sub-command configuration.
@@ -76,12 +79,17 @@ func newScenarioCmd() *cobra.Command {
 	scenarioCmd.AddCommand(newScenarioCheckCmd())
 	scenarioCmd.AddCommand(newScenarioLaunchCmd())
 	scenarioCmd.AddCommand(newScenarioDestroyCmd())
+	scenarioCmd.AddCommand(newScenarioReapCmd())
 	scenarioCmd.AddCommand(newScenarioRunCmd())
 	scenarioCmd.AddCommand(newScenarioExecCmd())
 	scenarioCmd.AddCommand(newScenarioOutputCmd())
 	scenarioCmd.AddCommand(newScenarioValidateConfigCmd())
+	scenarioCmd.AddCommand(newScenarioLintCmd())
+	scenarioCmd.AddCommand(newScenarioVarsCmd())
 	scenarioCmd.AddCommand(newScenarioSampleCmd())
 	scenarioCmd.AddCommand(newScenarioOutlineCmd())
+	scenarioCmd.AddCommand(newScenarioCleanCmd())
+	scenarioCmd.AddCommand(newScenarioStatusCmd())
 
 	return scenarioCmd
 }
@@ -103,12 +111,42 @@ func scenarioCmdPreRun(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Record operations if we've been asked to
+	if scenarioState.recordPath != "" {
+		recorder, err := record.New(ui, scenarioState.recordPath)
+		if err != nil {
+			return err
+		}
+		ui = recorder
+	}
+
 	// Load the configuration from our working dir
 	scenarioState.protoFp, err = readFlightPlanConfig(scenarioState.baseDir, scenarioState.varsFilesPaths)
 
 	return err
 }
 
+// addRecordFlag adds the --record flag to a scenario sub-command that streams operations.
+func addRecordFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&scenarioState.recordPath, "record", "", "Record every operation event and the operation responses to the given file. Recordings can be shown with 'enos replay'")
+}
+
+// addFailurePolicyFlag adds the --failure-policy flag to a scenario sub-command that launches
+// scenarios.
+func addFailurePolicyFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&scenarioState.failurePolicy, "failure-policy", "", "What to do with the scenario resources if launching fails: keep, destroy, or keep-for=<duration>, which must be paired with scenario reap. Overrides the scenario failure_policy")
+}
+
+// scenarioFailurePolicy returns the failure policy that was passed with the --failure-policy flag.
+// It returns nil if it was not set.
+func scenarioFailurePolicy() (*pb.Operation_FailurePolicy, error) {
+	if scenarioState.failurePolicy == "" {
+		return nil, nil
+	}
+
+	return flightplan.ParseFailurePolicy(scenarioState.failurePolicy)
+}
+
 // scenarioCmdPostRun is the scenario sub-command post-run. We'll use it to shut
 // down the server.
 func scenarioCmdPostRun(cmd *cobra.Command, args []string) {
@@ -232,7 +270,7 @@ func readFlightPlanConfig(dir string, varFilePaths []string) (*pb.FlightPlan, er
 		EnosVarsEnv: os.Environ(),
 	}
 
-	cfgFiles, err := flightplan.FindRawFiles(dir, flightplan.FlightPlanFileNamePattern)
+	cfgFiles, err := flightplan.FindFlightPlanFiles(dir)
 	if err != nil {
 		return nil, err
 	}
@@ -33,6 +33,8 @@ func newScenarioCheckCmd() *cobra.Command {
 
 	_ = cmd.Flags().MarkHidden("out") // Allow passing out for testing but mark it hidden
 
+	addRecordFlag(cmd)
+
 	return cmd
 }
 
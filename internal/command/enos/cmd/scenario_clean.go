@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+type scenarioCleanConfig struct {
+	dryRun bool
+	force  bool
+}
+
+var scenarioCleanCfg = &scenarioCleanConfig{}
+
+// newScenarioCleanCmd returns a new 'scenario clean' sub-command.
+func newScenarioCleanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clean [FILTER]",
+		Short: "Clean stale generated Terraform modules",
+		Long: `Clean stale generated Terraform modules from the out directory. Generated modules
+that are no longer produced by any scenario in the flight plan are reported as orphaned. Modules
+whose Terraform state is empty are removed. Modules that have or might have state in a remote
+backend are kept but have their provider plugin cache removed, unless --force is given.
+Orphaned modules are always considered, the FILTER only limits which modules of scenarios that
+are still in the flight plan are cleaned.` + scenarioFilterDesc,
+		RunE:              runScenarioCleanCmd,
+		ValidArgsFunction: scenarioNameCompletion,
+	}
+
+	cmd.PersistentFlags().BoolVar(&scenarioCleanCfg.dryRun, "dry-run", false, "Report what would be cleaned without removing anything")
+	cmd.PersistentFlags().BoolVar(&scenarioCleanCfg.force, "force", false, "Remove generated modules even if they have or might have non-empty state")
+
+	return cmd
+}
+
+// runScenarioCleanCmd runs a scenario clean.
+func runScenarioCleanCmd(cmd *cobra.Command, args []string) error {
+	ctx, cancel := scenarioTimeoutContext()
+	defer cancel()
+
+	sf, err := flightplan.ParseScenarioFilter(args)
+	if err != nil {
+		return ui.ShowScenarioClean(&pb.CleanScenariosResponse{
+			Diagnostics: diagnostics.FromErr(err),
+		})
+	}
+
+	res, err := rootState.enosConnection.Client.CleanScenarios(
+		ctx, &pb.CleanScenariosRequest{
+			Workspace: &pb.Workspace{
+				Flightplan: scenarioState.protoFp,
+				OutDir:     scenarioState.outDir,
+				TfExecCfg:  scenarioState.tfConfig.Proto(),
+			},
+			Filter: sf.Proto(),
+			DryRun: scenarioCleanCfg.dryRun,
+			Force:  scenarioCleanCfg.force,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return ui.ShowScenarioClean(res)
+}
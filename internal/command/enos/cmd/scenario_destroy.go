@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	"errors"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -11,16 +12,25 @@ import (
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 )
 
+type scenarioDestroyConfig struct {
+	allLaunched bool
+}
+
+var scenarioDestroyCfg = &scenarioDestroyConfig{}
+
 // newScenarioDestroyCmd returns a new `scenario destroy` sub-command.
 func newScenarioDestroyCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:               "destroy [FILTER]",
-		Short:             "Destroy previously generated Terraform modules from matching scenarios",
-		Long:              "Destroy previously generated Terraform modules from matching scenarios. " + scenarioFilterDesc,
+		Use:   "destroy [FILTER]",
+		Short: "Destroy previously generated Terraform modules from matching scenarios",
+		Long: `Destroy previously generated Terraform modules from matching scenarios. Use
+--all-launched to destroy every previously generated module in the out directory that has state,
+including modules of scenarios that are no longer in the flight plan. ` + scenarioFilterDesc,
 		RunE:              runScenarioDestroyCmd,
 		ValidArgsFunction: scenarioNameCompletion,
 	}
 
+	cmd.PersistentFlags().BoolVar(&scenarioDestroyCfg.allLaunched, "all-launched", false, "Destroy all previously generated modules that have state")
 	cmd.PersistentFlags().BoolVar(&scenarioState.tfConfig.Flags.NoLock, "no-lock", false, "Don't wait for the Terraform state lock")
 	cmd.PersistentFlags().BoolVar(&scenarioState.tfConfig.Flags.NoBackend, "no-backend", false, "Disable the configured backend")
 	cmd.PersistentFlags().BoolVar(&scenarioState.tfConfig.Flags.NoDownload, "no-download", false, "Disable downloading modules and providers")
@@ -31,6 +41,8 @@ func newScenarioDestroyCmd() *cobra.Command {
 
 	_ = cmd.Flags().MarkHidden("out") // Allow passing out for testing but mark it hidden
 
+	addRecordFlag(cmd)
+
 	return cmd
 }
 
@@ -39,6 +51,10 @@ func runScenarioDestroyCmd(cmd *cobra.Command, args []string) error {
 	ctx, cancel := scenarioTimeoutContext()
 	defer cancel()
 
+	if scenarioDestroyCfg.allLaunched && len(args) > 0 {
+		return errors.New("a scenario filter cannot be used with --all-launched")
+	}
+
 	sf, ws, err := prepareScenarioOpReq(args)
 	if err != nil {
 		return err
@@ -46,8 +62,9 @@ func runScenarioDestroyCmd(cmd *cobra.Command, args []string) error {
 
 	res, err := rootState.enosConnection.Client.DestroyScenarios(
 		ctx, &pb.DestroyScenariosRequest{
-			Workspace: ws,
-			Filter:    sf,
+			Workspace:   ws,
+			Filter:      sf,
+			AllLaunched: scenarioDestroyCfg.allLaunched,
 		},
 	)
 	if err != nil {
@@ -4,25 +4,47 @@
 package cmd
 
 import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/ui/status"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 )
 
+type scenarioExecConfig struct {
+	interactive bool
+}
+
+var scenarioExecCfg = &scenarioExecConfig{}
+
 func newScenarioExecCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:               "exec [FILTER] [ARGS] --cmd TERRAFORM-SUB-COMMAND",
-		Short:             "Execute a terraform sub-command in the context of previously generated Terraform modules from matching scenarios",
-		Long:              "Execute a terraform sub-command in the context of previously generated Terraform modules from matching scenarios. " + scenarioFilterDesc,
+		Use:   "exec [FILTER] [ARGS] --cmd TERRAFORM-SUB-COMMAND",
+		Short: "Execute a terraform sub-command in the context of previously generated Terraform modules from matching scenarios",
+		Long: `Execute a terraform sub-command in the context of previously generated Terraform modules from matching scenarios.
+Use --interactive to attach your terminal to the sub-command, e.g. for 'console' or 'state mv'. Interactive
+sub-commands must match a single scenario. ` + scenarioFilterDesc,
 		RunE:              runScenarioExecCmd,
 		ValidArgsFunction: scenarioNameCompletion,
 	}
 
 	cmd.PersistentFlags().StringVar(&scenarioState.tfConfig.ExecSubCmd, "cmd", "", "The Terraform sub-command")
+	cmd.PersistentFlags().BoolVarP(&scenarioExecCfg.interactive, "interactive", "i", false, "Attach STDIN, STDOUT, STDERR and the terminal to the sub-command")
 
 	_ = cmd.MarkFlagRequired("cmd")
 	_ = cmd.Flags().MarkHidden("out") // Allow passing out for testing but mark it hidden
 
+	addRecordFlag(cmd)
+
 	return cmd
 }
 
@@ -36,6 +58,10 @@ func runScenarioExecCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if scenarioExecCfg.interactive {
+		return runScenarioExecInteractive(ctx, sf, ws)
+	}
+
 	res, err := rootState.enosConnection.Client.ExecScenarios(
 		ctx, &pb.ExecScenariosRequest{
 			Workspace: ws,
@@ -48,3 +74,152 @@ func runScenarioExecCmd(cmd *cobra.Command, args []string) error {
 
 	return ui.ShowOperationResponses(rootState.enosConnection.StreamOperations(ctx, res, ui))
 }
+
+// runScenarioExecInteractive executes the sub-command with our STDIN, STDOUT, and STDERR
+// attached to it. If we're running in a terminal the sub-command will be given a
+// pseudo-terminal and our terminal will be put into raw mode until it exits.
+func runScenarioExecInteractive(ctx context.Context, sf *pb.Scenario_Filter, ws *pb.Workspace) error {
+	stream, err := rootState.enosConnection.Client.ExecScenarioStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	stdinFd := int(os.Stdin.Fd())
+	stdoutFd := int(os.Stdout.Fd())
+	isTTY := term.IsTerminal(stdinFd) && term.IsTerminal(stdoutFd)
+
+	// gRPC streams are not safe for concurrent senders
+	var sendMu sync.Mutex
+	send := func(req *pb.ExecScenarioStreamRequest) error {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+
+		return stream.Send(req)
+	}
+
+	start := &pb.ExecScenarioStreamRequest_Start{
+		Workspace: ws,
+		Filter:    sf,
+		Tty:       isTTY,
+		Term:      os.Getenv("TERM"),
+	}
+	if isTTY {
+		start.Size = terminalSize(stdoutFd)
+	}
+
+	err = send(&pb.ExecScenarioStreamRequest{
+		Request: &pb.ExecScenarioStreamRequest_Start_{Start: start},
+	})
+	if err != nil {
+		return err
+	}
+
+	var oldState *term.State
+	restoreTerm := func() {
+		if oldState != nil {
+			_ = term.Restore(stdinFd, oldState)
+			oldState = nil
+		}
+	}
+	defer restoreTerm()
+
+	if isTTY {
+		oldState, err = term.MakeRaw(stdinFd)
+		if err != nil {
+			return err
+		}
+
+		winchC := make(chan os.Signal, 1)
+		signal.Notify(winchC, syscall.SIGWINCH)
+		defer signal.Stop(winchC)
+
+		go func() {
+			for range winchC {
+				_ = send(&pb.ExecScenarioStreamRequest{
+					Request: &pb.ExecScenarioStreamRequest_Resize{Resize: terminalSize(stdoutFd)},
+				})
+			}
+		}()
+	}
+
+	go func() {
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if n > 0 {
+				data := make([]byte, n)
+				copy(data, buf[:n])
+				if err := send(&pb.ExecScenarioStreamRequest{
+					Request: &pb.ExecScenarioStreamRequest_Stdin_{
+						Stdin: &pb.ExecScenarioStreamRequest_Stdin{Data: data},
+					},
+				}); err != nil {
+					return
+				}
+			}
+
+			if err != nil {
+				_ = send(&pb.ExecScenarioStreamRequest{
+					Request: &pb.ExecScenarioStreamRequest_Stdin_{
+						Stdin: &pb.ExecScenarioStreamRequest_Stdin{Eof: true},
+					},
+				})
+
+				return
+			}
+		}
+	}()
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return errors.New("exec stream closed before the sub-command exited")
+			}
+
+			return err
+		}
+
+		switch t := res.GetResponse().(type) {
+		case *pb.ExecScenarioStreamResponse_Stdout:
+			_, _ = os.Stdout.Write(t.Stdout)
+		case *pb.ExecScenarioStreamResponse_Stderr:
+			_, _ = os.Stderr.Write(t.Stderr)
+		case *pb.ExecScenarioStreamResponse_Exit_:
+			exit := t.Exit
+			// Restore the terminal before we write any diagnostics
+			restoreTerm()
+
+			if err := ui.ShowDecode(exit.GetDecode(), false); err != nil {
+				return err
+			}
+
+			if err := ui.ShowDiagnostics(exit.GetDiagnostics()); err != nil {
+				return err
+			}
+
+			if diagnostics.HasFailed(ws.GetTfExecCfg().GetFailOnWarnings(), exit.GetDiagnostics()) {
+				return status.Error("failed to execute scenario")
+			}
+
+			if exit.GetExitCode() != 0 {
+				return &status.ErrExit{ExitCode: int(exit.GetExitCode())}
+			}
+
+			return nil
+		}
+	}
+}
+
+// terminalSize returns the size of the terminal.
+func terminalSize(fd int) *pb.ExecScenarioStreamRequest_TerminalSize {
+	width, height, err := term.GetSize(fd)
+	if err != nil {
+		return nil
+	}
+
+	return &pb.ExecScenarioStreamRequest_TerminalSize{
+		Rows: uint32(height),
+		Cols: uint32(width),
+	}
+}
@@ -20,6 +20,8 @@ func newScenarioGenerateCmd() *cobra.Command {
 		Hidden:            true, // This is hidden because it is intended for debug only
 	}
 
+	addRecordFlag(cmd)
+
 	return cmd
 }
 
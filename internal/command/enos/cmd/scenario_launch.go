@@ -4,13 +4,21 @@
 package cmd
 
 import (
+	"errors"
 	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 )
 
+type scenarioLaunchConfig struct {
+	ttl time.Duration
+}
+
+var scenarioLaunchCfg = &scenarioLaunchConfig{}
+
 // newScenarioLaunchCmd returns a new 'scenario run' command.
 func newScenarioLaunchCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -30,9 +38,15 @@ func newScenarioLaunchCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(&scenarioState.tfConfig.Flags.NoReconfigure, "no-reconfigure", false, "Don't reconfigure the backend during init")
 	cmd.PersistentFlags().Uint32Var(&scenarioState.tfConfig.Flags.Parallelism, "tf-parallelism", 10, "The Terraform scenario parallelism")
 	cmd.PersistentFlags().DurationVar(&scenarioState.lockTimeout, "lock-timeout", 1*time.Minute, "The Duration to wait for the Terraform lock")
+	cmd.PersistentFlags().StringSliceVar(&scenarioState.tfConfig.Flags.TargetSteps, "target-step", nil, "Only plan and apply the given steps and the steps they depend on")
+	cmd.PersistentFlags().DurationVar(&scenarioLaunchCfg.ttl, "ttl", 0, "Record that the scenario should be destroyed by 'enos scenario reap' after the duration")
+	cmd.PersistentFlags().StringSliceVar(&scenarioState.tfConfig.Flags.FromSteps, "from-step", nil, "Only plan and apply the given steps and the steps that depend on them")
 
 	_ = cmd.Flags().MarkHidden("out") // Allow passing out for testing but mark it hidden
 
+	addRecordFlag(cmd)
+	addFailurePolicyFlag(cmd)
+
 	return cmd
 }
 
@@ -46,10 +60,25 @@ func runScenarioLaunchCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	failurePolicy, err := scenarioFailurePolicy()
+	if err != nil {
+		return err
+	}
+
+	var ttl *durationpb.Duration
+	if scenarioLaunchCfg.ttl < 0 {
+		return errors.New("--ttl must be greater than zero")
+	}
+	if scenarioLaunchCfg.ttl > 0 {
+		ttl = durationpb.New(scenarioLaunchCfg.ttl)
+	}
+
 	res, err := rootState.enosConnection.Client.LaunchScenarios(
 		ctx, &pb.LaunchScenariosRequest{
-			Workspace: ws,
-			Filter:    sf,
+			Workspace:     ws,
+			Filter:        sf,
+			FailurePolicy: failurePolicy,
+			Ttl:           ttl,
 		},
 	)
 	if err != nil {
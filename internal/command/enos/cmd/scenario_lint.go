@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/lint"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+type scenarioLintConfig struct {
+	configPath string
+}
+
+var scenarioLintCfg = &scenarioLintConfig{}
+
+// newScenarioLintCmd returns a new 'scenario lint' sub-command.
+func newScenarioLintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Lint configuration",
+		Long: `Lint enos flightplan configuration for problems that are valid configuration but are
+likely mistakes, e.g. modules, variables, qualities or globals that are never used. Rules can be
+enabled, disabled, or have their severity changed in a lint configuration file. If no
+configuration file is given the ` + lint.ConfigFileName + ` file in the flight plan directory
+is used if it exists.`,
+		RunE: runScenarioLintCmd,
+	}
+
+	cmd.PersistentFlags().StringVar(&scenarioLintCfg.configPath, "config", "", "The path to the lint configuration file")
+
+	return cmd
+}
+
+// runScenarioLintCmd runs a scenario lint.
+func runScenarioLintCmd(cmd *cobra.Command, args []string) error {
+	ctx, cancel := scenarioTimeoutContext()
+	defer cancel()
+
+	cfg, err := readLintConfig()
+	if err != nil {
+		return ui.ShowScenarioLint(&pb.LintScenariosResponse{
+			Diagnostics: diagnostics.FromErr(err),
+		})
+	}
+
+	res, err := rootState.enosConnection.Client.LintScenarios(
+		ctx, &pb.LintScenariosRequest{
+			Workspace: &pb.Workspace{
+				Flightplan: scenarioState.protoFp,
+				TfExecCfg:  scenarioState.tfConfig.Proto(),
+			},
+			Config: cfg,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return ui.ShowScenarioLint(res)
+}
+
+// readLintConfig reads the lint configuration file. If no path has been given we'll use the
+// default configuration file in the flight plan directory if it exists.
+func readLintConfig() (*pb.Lint_Config, error) {
+	path := scenarioLintCfg.configPath
+	if path == "" {
+		path = filepath.Join(scenarioState.baseDir, lint.ConfigFileName)
+		if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+	}
+
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Lint_Config{Path: path, Hcl: src}, nil
+}
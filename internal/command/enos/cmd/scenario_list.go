@@ -4,7 +4,14 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
+	"fmt"
 	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -13,15 +20,31 @@ import (
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 )
 
+type scenarioListConfig struct {
+	affectedBy []string
+}
+
+var scenarioListCfg = &scenarioListConfig{}
+
 // newScenarioListCmd returns a new 'scenario list' sub-command.
 func newScenarioListCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:               "list [FILTER]",
-		Short:             "List scenarios",
-		Long:              "List all scenario and variant combinations",
+	cmd := &cobra.Command{
+		Use:   "list [FILTER]",
+		Short: "List scenarios",
+		Long: `List all scenario and variant combinations. When --affected-by is given, only the
+scenario variants that could be affected by the changed files are listed. A scenario variant is
+affected when a changed file is a flight plan file that defines the scenario or shared
+configuration, a variables file, a local module source of a step, or a file that is referenced
+with file() or abspath() in the scenario. Each value can either be a path to a changed file or
+directory, or a git revision or revision range, e.g. main...HEAD, in which case the changed files
+are determined with the local git repository.`,
 		RunE:              runScenarioListCmd,
 		ValidArgsFunction: scenarioNameCompletion,
 	}
+
+	cmd.PersistentFlags().StringSliceVar(&scenarioListCfg.affectedBy, "affected-by", nil, "Only list scenario variants affected by changes to the given paths or git revision range")
+
+	return cmd
 }
 
 // runScenarioListCmd runs a scenario list.
@@ -36,12 +59,25 @@ func runScenarioListCmd(cmd *cobra.Command, args []string) error {
 		})
 	}
 
+	affectedBy, err := changedFiles(ctx, scenarioState.protoFp.GetBaseDir(), scenarioListCfg.affectedBy)
+	if err != nil {
+		return ui.ShowScenarioList(&pb.ListScenariosResponse{
+			Diagnostics: diagnostics.FromErr(err),
+		})
+	}
+
+	if len(scenarioListCfg.affectedBy) > 0 && len(affectedBy) == 0 {
+		// Nothing has changed so no scenarios are affected
+		return ui.ShowScenarioList(&pb.ListScenariosResponse{})
+	}
+
 	stream, err := rootState.enosConnection.Client.ListScenarios(
 		ctx, &pb.ListScenariosRequest{
 			Workspace: &pb.Workspace{
 				Flightplan: scenarioState.protoFp,
 			},
-			Filter: sf.Proto(),
+			Filter:     sf.Proto(),
+			AffectedBy: affectedBy,
 		},
 	)
 	if err != nil {
@@ -69,3 +105,97 @@ func runScenarioListCmd(cmd *cobra.Command, args []string) error {
 
 	return ui.ShowScenarioList(res)
 }
+
+// changedFiles takes paths and/or git revision ranges and returns the absolute paths of all
+// changed files. Arguments that exist on the filesystem are considered paths. Arguments that are
+// revision ranges or that git can resolve to a revision are passed to git diff in the base
+// directory. Everything else is considered a path, which allows passing deleted files.
+func changedFiles(ctx context.Context, baseDir string, args []string) ([]string, error) {
+	changed := []string{}
+	for _, arg := range args {
+		path := arg
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(baseDir, path)
+		}
+
+		if _, err := os.Stat(path); err == nil || !isGitRevision(ctx, baseDir, arg) {
+			changed = append(changed, path)
+
+			continue
+		}
+
+		paths, err := gitChangedFiles(ctx, baseDir, arg)
+		if err != nil {
+			return nil, fmt.Errorf("getting files changed in %s: %w", arg, err)
+		}
+
+		changed = append(changed, paths...)
+	}
+
+	return changed, nil
+}
+
+// isGitRevision returns whether or not the argument is a git revision range or a revision that
+// git can resolve.
+func isGitRevision(ctx context.Context, dir string, arg string) bool {
+	if strings.Contains(arg, "..") {
+		// Relative paths like ../modules/foo.tf also contain "..", only consider it a range if
+		// none of the path segments are a parent directory.
+		isPath := false
+		for _, seg := range strings.Split(filepath.ToSlash(arg), "/") {
+			if seg == ".." {
+				isPath = true
+
+				break
+			}
+		}
+
+		if !isPath {
+			return true
+		}
+	}
+
+	_, err := git(ctx, dir, "rev-parse", "--verify", "--quiet", arg+"^{commit}")
+
+	return err == nil
+}
+
+// gitChangedFiles returns the absolute paths of the files changed in the git revision range.
+func gitChangedFiles(ctx context.Context, dir string, revRange string) ([]string, error) {
+	top, err := git(ctx, dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := git(ctx, dir, "diff", "--name-only", revRange, "--")
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{}
+	for _, line := range strings.Split(out, "\n") {
+		if line == "" {
+			continue
+		}
+		paths = append(paths, filepath.Join(top, line))
+	}
+
+	return paths, nil
+}
+
+func git(ctx context.Context, dir string, args ...string) (string, error) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", strings.Join(args, " "), msg)
+		}
+
+		return "", fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
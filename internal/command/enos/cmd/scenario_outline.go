@@ -21,7 +21,7 @@ func newScenarioOutlineCmd() *cobra.Command {
 		ValidArgsFunction: scenarioNameCompletion,
 	}
 
-	outlineCmd.PersistentFlags().StringVar(&rootState.format, "format", "text", "The output format to use: text, json, html")
+	outlineCmd.PersistentFlags().StringVar(&rootState.format, "format", "text", "The output format to use: text, json, html, markdown")
 
 	return outlineCmd
 }
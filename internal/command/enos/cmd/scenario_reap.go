@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+type scenarioReapConfig struct {
+	interval time.Duration
+}
+
+var scenarioReapCfg = &scenarioReapConfig{}
+
+// newScenarioReapCmd returns a new 'scenario reap' sub-command.
+func newScenarioReapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reap",
+		Short: "Destroy launched scenarios that have outlived their TTL",
+		Long: `Destroy every launched scenario in the out directory that has outlived its TTL. A TTL is
+recorded in the generated module manifest when a scenario is launched with --ttl or when a launch
+fails with a keep-for failure policy. Use --interval to keep reaping periodically until enos is
+interrupted.`,
+		Args: cobra.NoArgs,
+		RunE: runScenarioReapCmd,
+	}
+
+	cmd.PersistentFlags().DurationVar(&scenarioReapCfg.interval, "interval", 0, "Reap expired scenarios every interval until interrupted")
+	cmd.PersistentFlags().BoolVar(&scenarioState.tfConfig.Flags.NoLock, "no-lock", false, "Don't wait for the Terraform state lock")
+	cmd.PersistentFlags().BoolVar(&scenarioState.tfConfig.Flags.NoDownload, "no-download", false, "Disable downloading modules and providers")
+	cmd.PersistentFlags().Uint32Var(&scenarioState.tfConfig.Flags.Parallelism, "tf-parallelism", 10, "Terraform scenario parallelism")
+	cmd.PersistentFlags().DurationVar(&scenarioState.lockTimeout, "lock-timeout", 1*time.Minute, "Duration to wait for the Terraform lock")
+
+	_ = cmd.Flags().MarkHidden("out") // Allow passing out for testing but mark it hidden
+
+	addRecordFlag(cmd)
+
+	return cmd
+}
+
+// runScenarioReapCmd is the function that reaps expired scenarios.
+func runScenarioReapCmd(cmd *cobra.Command, args []string) error {
+	_, ws, err := prepareScenarioOpReq(args)
+	if err != nil {
+		return err
+	}
+
+	if scenarioReapCfg.interval <= 0 {
+		ctx, cancel := scenarioTimeoutContext()
+		defer cancel()
+
+		return reapScenarios(ctx, ws)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	ticker := time.NewTicker(scenarioReapCfg.interval)
+	defer ticker.Stop()
+
+	// Keep reaping until we're interrupted, even if reaping has failed. The failures have already
+	// been shown but we return them all when we're done so that the exit status reflects them.
+	var errs error
+	for {
+		errs = errors.Join(errs, reapScenarios(ctx, ws))
+
+		select {
+		case <-ctx.Done():
+			return errs
+		case <-ticker.C:
+		}
+	}
+}
+
+// reapScenarios reaps expired scenarios in the workspace and waits for the dispatched destroy
+// operations to complete.
+func reapScenarios(ctx context.Context, ws *pb.Workspace) error {
+	res, err := rootState.enosConnection.Client.ReapScenarios(
+		ctx, &pb.ReapScenariosRequest{
+			Workspace: ws,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return ui.ShowOperationResponses(rootState.enosConnection.StreamOperations(ctx, res, ui))
+}
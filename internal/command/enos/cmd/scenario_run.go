@@ -33,6 +33,9 @@ func newScenarioRunCmd() *cobra.Command {
 
 	_ = cmd.Flags().MarkHidden("out") // Allow passing out for testing but mark it hidden
 
+	addRecordFlag(cmd)
+	addFailurePolicyFlag(cmd)
+
 	return cmd
 }
 
@@ -46,10 +49,16 @@ func runScenarioRunCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	failurePolicy, err := scenarioFailurePolicy()
+	if err != nil {
+		return err
+	}
+
 	res, err := rootState.enosConnection.Client.RunScenarios(
 		ctx, &pb.RunScenariosRequest{
-			Workspace: ws,
-			Filter:    sf,
+			Workspace:     ws,
+			Filter:        sf,
+			FailurePolicy: failurePolicy,
 		},
 	)
 	if err != nil {
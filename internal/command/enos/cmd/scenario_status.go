@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+	"github.com/hashicorp/go-multierror"
+)
+
+// newScenarioStatusCmd returns a new 'scenario status' command.
+func newScenarioStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [FILTER]",
+		Short: "Show which scenarios have been launched",
+		Long: `Show which scenarios have been launched. For each selected scenario the state
+of the generated Terraform module is read from its configured backend and the number of
+resources in each step module are reported. ` + scenarioFilterDesc,
+		RunE:              runScenarioStatusCmd,
+		ValidArgsFunction: scenarioNameCompletion,
+	}
+
+	return cmd
+}
+
+// runScenarioStatusCmd is the function that shows the scenario status.
+func runScenarioStatusCmd(cmd *cobra.Command, args []string) error {
+	ctx, cancel := scenarioTimeoutContext()
+	defer cancel()
+
+	sf, ws, err := prepareScenarioOpReq(args)
+	if err != nil {
+		return err
+	}
+
+	res, err := rootState.enosConnection.Client.StatusScenarios(
+		ctx, &pb.StatusScenariosRequest{
+			Workspace: ws,
+			Filter:    sf,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// Stream the operations to wait until all state has been read and then
+	// display them
+	opRes := rootState.enosConnection.StreamOperations(ctx, res, ui)
+
+	var merr *multierror.Error
+	merr = multierror.Append(merr, ui.ShowDecode(opRes.GetDecode(), true))
+	merr = multierror.Append(merr, ui.ShowScenarioStatus(opRes))
+
+	return merr.ErrorOrNil()
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// newScenarioVarsCmd returns a new 'scenario vars' sub-command.
+func newScenarioVarsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "vars",
+		Short: "Show variable values",
+		Long: `Show every variable with its resolved value, type, and where the value came from.
+Values can come from variable defaults, variables files, or ENOS_VAR_ environment variables.
+Variables without a value or default and values set for undeclared variables are flagged.
+Values of sensitive variables are redacted.`,
+		RunE: runScenarioVarsCmd,
+	}
+}
+
+// runScenarioVarsCmd runs a scenario vars.
+func runScenarioVarsCmd(cmd *cobra.Command, args []string) error {
+	ctx, cancel := scenarioTimeoutContext()
+	defer cancel()
+
+	res, err := rootState.enosConnection.Client.ListScenarioVariables(
+		ctx, &pb.ListScenarioVariablesRequest{
+			Workspace: &pb.Workspace{
+				Flightplan: scenarioState.protoFp,
+				TfExecCfg:  scenarioState.tfConfig.Proto(),
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return ui.ShowScenarioVariables(res)
+}
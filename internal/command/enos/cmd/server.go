@@ -7,9 +7,13 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
 	"github.com/hashicorp/enos/internal/client"
@@ -19,6 +23,58 @@ import (
 	"github.com/hashicorp/go-hclog"
 )
 
+type serverConfig struct {
+	reapInterval time.Duration
+}
+
+var serverCfg = &serverConfig{}
+
+// newServerCmd returns a new 'server' sub-command.
+func newServerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "server",
+		Short: "Run the Enos server",
+		Long: `Run the Enos gRPC server on the --grpc-listen address until interrupted. The server only reaps
+expired scenarios when --reap-interval is set, in which case it destroys every launched scenario in
+the out directory that has outlived its TTL every interval, like 'enos scenario reap'.`,
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: scenarioCmdPreRun,
+		PersistentPostRun: scenarioCmdPostRun,
+		RunE:              runServerCmd,
+	}
+
+	cmd.PersistentFlags().DurationVar(&serverCfg.reapInterval, "reap-interval", 0, "Reap expired scenarios every interval. Reaping is disabled by default")
+	cmd.PersistentFlags().BoolVar(&scenarioState.tfConfig.FailOnWarnings, "fail-on-warnings", false, "Fail immediately if warning diagnostics are created")
+	cmd.PersistentFlags().StringVarP(&scenarioState.baseDir, "chdir", "d", "", "Use the given directory as the working directory")
+	cmd.PersistentFlags().StringVarP(&scenarioState.outDir, "out", "o", "", "Configure the base directory where generated modules will be created")
+	cmd.PersistentFlags().StringSliceVar(&scenarioState.varsFilesPaths, "var-file", []string{}, "The path to use for variable values files. By default enos will load all enos*.vars.hcl files in the working directory.")
+	cmd.PersistentFlags().BoolVar(&scenarioState.tfConfig.Flags.NoLock, "no-lock", false, "Don't wait for the Terraform state lock")
+	cmd.PersistentFlags().BoolVar(&scenarioState.tfConfig.Flags.NoDownload, "no-download", false, "Disable downloading modules and providers")
+	cmd.PersistentFlags().Uint32Var(&scenarioState.tfConfig.Flags.Parallelism, "tf-parallelism", 10, "Terraform scenario parallelism")
+	cmd.PersistentFlags().DurationVar(&scenarioState.lockTimeout, "lock-timeout", 1*time.Minute, "Duration to wait for the Terraform lock")
+
+	return cmd
+}
+
+// runServerCmd runs the server until it is interrupted.
+func runServerCmd(cmd *cobra.Command, args []string) error {
+	_, ws, err := prepareScenarioOpReq(args)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if serverCfg.reapInterval <= 0 {
+		<-ctx.Done()
+
+		return nil
+	}
+
+	return rootState.enosServer.Reaper(ctx, ws, serverCfg.reapInterval)
+}
+
 // startServer starts the enos gRPC server and returns the instance and client to
 // the server.
 func startServer(
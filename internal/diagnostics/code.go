@@ -0,0 +1,554 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostics
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// Code is a stable identifier for a class of diagnostic. Codes are grouped by the
+// component that produces them:
+//
+//	E1xxx: flight plan decoding
+//	E2xxx: module generation
+//	E3xxx: Terraform operations
+//	E4xxx: linting
+//
+// A Code can be set as the Extra of an hcl.Diagnostic and it will be carried over
+// when the diagnostic is converted with FromHCL.
+type Code string
+
+// Coder is something that has a diagnostic code.
+type Coder interface {
+	DiagnosticCode() Code
+}
+
+var _ Coder = Code("")
+
+// DiagnosticCode returns the code.
+func (c Code) DiagnosticCode() Code {
+	return c
+}
+
+// String returns the code as a string.
+func (c Code) String() string {
+	return string(c)
+}
+
+// Diagnostic codes. Codes must never be reused for a different class of diagnostic.
+const (
+	CodeInvalidBlock               Code = "E1001"
+	CodeInvalidBlockLabel          Code = "E1002"
+	CodeRedeclaredBlock            Code = "E1003"
+	CodeVariableDeclaredInVarsFile Code = "E1004"
+	CodeInvalidAttributeValue      Code = "E1005"
+	CodeInvalidVariableValue       Code = "E1101"
+	CodeInvalidVariableDefault     Code = "E1102"
+	CodeInvalidVariableValidation  Code = "E1103"
+	CodeVariableValidationFailed   Code = "E1104"
+	CodeMissingStep                Code = "E1201"
+	CodeRedeclaredStep             Code = "E1202"
+	CodeUnknownStepReference       Code = "E1203"
+	CodeDuplicateStepDependency    Code = "E1204"
+	CodeUnknownModule              Code = "E1205"
+	CodeInvalidSkipStep            Code = "E1206"
+	CodeRedeclaredOutput           Code = "E1207"
+	CodeUnknowableStepVariable     Code = "E1208"
+	CodeUndefinedProvider          Code = "E1301"
+	CodeInvalidProviderReference   Code = "E1302"
+	CodeDefaultProviderAlias       Code = "E1303"
+	CodeInvalidMatrix              Code = "E1401"
+	CodeNoMatchingScenarios        Code = "E1402"
+	CodeNoScenarios                Code = "E1403"
+	CodeInvalidVerifies            Code = "E1501"
+	CodeInvalidSample              Code = "E1601"
+	CodeInvalidTerraformSetting    Code = "E1701"
+	CodeInvalidTerraformCLI        Code = "E1702"
+	CodeInvalidInclude             Code = "E1801"
+	CodeInvalidImport              Code = "E1802"
+	CodeImportCycle                Code = "E1803"
+	CodeModuleGenerateFailed       Code = "E2001"
+	CodeTerraformUnavailable       Code = "E3001"
+	CodeTerraformInitFailed        Code = "E3002"
+	CodeTerraformValidateFailed    Code = "E3003"
+	CodeTerraformPlanFailed        Code = "E3004"
+	CodeTerraformApplyFailed       Code = "E3005"
+	CodeTerraformDestroyFailed     Code = "E3006"
+	CodeTerraformShowFailed        Code = "E3007"
+	CodeTerraformOutputFailed      Code = "E3008"
+	CodeTerraformExecFailed        Code = "E3009"
+	CodeTerraformPartialLaunch     Code = "E3010"
+	CodeTerraformStepTimeout       Code = "E3011"
+	CodeScenarioHookFailed         Code = "E3012"
+	CodeInvalidLintConfig          Code = "E4001"
+	CodeLintUnusedModule           Code = "E4101"
+	CodeLintUnusedVariable         Code = "E4102"
+	CodeLintUnusedQuality          Code = "E4103"
+	CodeLintUnusedGlobal           Code = "E4104"
+	CodeLintStepWithoutVerifies    Code = "E4201"
+	CodeLintRedundantDependsOn     Code = "E4202"
+	CodeLintExcludedMatrixValue    Code = "E4301"
+	CodeLintUndocumentedScenario   Code = "E4401"
+)
+
+const (
+	codeDocBaseURL          = "https://github.com/hashicorp/enos#"
+	codeTerraformDocBaseURL = "https://developer.hashicorp.com/terraform/cli/commands/"
+	codeTerraformOutputHint = "The output of the failed command is included with the diagnostic."
+)
+
+// CodeInfo describes a diagnostic code.
+type CodeInfo struct {
+	Code        Code
+	Summary     string
+	Remediation string
+	DocURL      string
+}
+
+// Proto returns the code info as a proto message.
+func (c *CodeInfo) Proto() *pb.DiagnosticCode {
+	return &pb.DiagnosticCode{
+		Code:        c.Code.String(),
+		Summary:     c.Summary,
+		Remediation: c.Remediation,
+		DocUrl:      c.DocURL,
+	}
+}
+
+var codeRe = regexp.MustCompile(`^E\d{4}$`)
+
+// registry is our registry of all known diagnostic codes.
+var registry = map[Code]*CodeInfo{}
+
+func init() {
+	for _, info := range []*CodeInfo{
+		{
+			Code:        CodeInvalidBlock,
+			Summary:     "invalid or unexpected block",
+			Remediation: "Remove the block or move it to a location where it is supported. Top-level blocks are include, module, provider, quality, sample, scenario, terraform, terraform_cli, variable, and globals.",
+			DocURL:      codeDocBaseURL + "dsl",
+		},
+		{
+			Code:        CodeInvalidBlockLabel,
+			Summary:     "invalid block label",
+			Remediation: "Block labels must be valid identifiers: they must start with a letter or underscore and only contain letters, digits, underscores, and dashes.",
+			DocURL:      codeDocBaseURL + "dsl",
+		},
+		{
+			Code:        CodeRedeclaredBlock,
+			Summary:     "block has been declared more than once",
+			Remediation: "Blocks must have unique labels. Rename or remove the duplicate block.",
+			DocURL:      codeDocBaseURL + "dsl",
+		},
+		{
+			Code:        CodeVariableDeclaredInVarsFile,
+			Summary:     "variable declared in a variables file",
+			Remediation: "Variables files (enos*.vars.hcl) may only set values for variables. Move the variable block into a flight plan file (enos*.hcl).",
+			DocURL:      codeDocBaseURL + "variable",
+		},
+		{
+			Code:        CodeInvalidAttributeValue,
+			Summary:     "invalid attribute value",
+			Remediation: "Change the attribute value to the type described in the diagnostic.",
+			DocURL:      codeDocBaseURL + "dsl",
+		},
+		{
+			Code:        CodeInvalidVariableValue,
+			Summary:     "invalid value for variable",
+			Remediation: "Make sure the value set in enos*.vars.hcl or the ENOS_VAR_ environment variable can be converted to the type of the variable.",
+			DocURL:      codeDocBaseURL + "variable",
+		},
+		{
+			Code:        CodeInvalidVariableDefault,
+			Summary:     "invalid default value for variable",
+			Remediation: "Make sure the variable default can be converted to the type of the variable.",
+			DocURL:      codeDocBaseURL + "variable",
+		},
+		{
+			Code:        CodeInvalidVariableValidation,
+			Summary:     "invalid variable validation",
+			Remediation: "Every validation block requires a condition that refers only to the variable being validated and an error_message that is a string.",
+			DocURL:      codeDocBaseURL + "variable",
+		},
+		{
+			Code:        CodeVariableValidationFailed,
+			Summary:     "variable value failed validation",
+			Remediation: "Update the value set in enos*.vars.hcl, the ENOS_VAR_ environment variable, or the variable default so that it satisfies the validation condition.",
+			DocURL:      codeDocBaseURL + "variable",
+		},
+		{
+			Code:        CodeMissingStep,
+			Summary:     "scenario does not have any steps",
+			Remediation: "Every scenario requires at least one step block.",
+			DocURL:      codeDocBaseURL + "scenario",
+		},
+		{
+			Code:        CodeRedeclaredStep,
+			Summary:     "step has been declared more than once in a scenario",
+			Remediation: "Step names must be unique within a scenario. Rename or remove the duplicate step.",
+			DocURL:      codeDocBaseURL + "scenario",
+		},
+		{
+			Code:        CodeUnknownStepReference,
+			Summary:     "unknown step reference",
+			Remediation: "Steps can only refer to steps that have been defined before them in the scenario. Check the spelling of the step name or move the referenced step before the step that uses it.",
+			DocURL:      codeDocBaseURL + "scenario",
+		},
+		{
+			Code:        CodeDuplicateStepDependency,
+			Summary:     "step depends on the same step more than once",
+			Remediation: "Remove the duplicate value from the step's depends_on attribute.",
+			DocURL:      codeDocBaseURL + "scenario",
+		},
+		{
+			Code:        CodeUnknownModule,
+			Summary:     "unknown or invalid step module",
+			Remediation: "Make sure the step's module attribute refers to a module block that has been defined, e.g. module = module.my_module.",
+			DocURL:      codeDocBaseURL + "module",
+		},
+		{
+			Code:        CodeInvalidSkipStep,
+			Summary:     "invalid skip_step value",
+			Remediation: "The skip_step attribute must be a bool that is known at decode time. It cannot refer to step outputs.",
+			DocURL:      codeDocBaseURL + "scenario",
+		},
+		{
+			Code:        CodeRedeclaredOutput,
+			Summary:     "output has been declared more than once in a scenario",
+			Remediation: "Output names must be unique within a scenario. Rename or remove the duplicate output.",
+			DocURL:      codeDocBaseURL + "scenario",
+		},
+		{
+			Code:        CodeUnknowableStepVariable,
+			Summary:     "step variable cannot be resolved",
+			Remediation: "Step variables that refer to other steps must reference a step output or a variable that has a known value.",
+			DocURL:      codeDocBaseURL + "scenario",
+		},
+		{
+			Code:        CodeUndefinedProvider,
+			Summary:     "provider has not been defined",
+			Remediation: "Define a provider block with the matching type and alias before using it in a scenario or step.",
+			DocURL:      codeDocBaseURL + "provider",
+		},
+		{
+			Code:        CodeInvalidProviderReference,
+			Summary:     "invalid provider reference",
+			Remediation: "Provider attributes must be a provider value, e.g. provider.aws.east, or a \"type.alias\" string.",
+			DocURL:      codeDocBaseURL + "provider",
+		},
+		{
+			Code:        CodeDefaultProviderAlias,
+			Summary:     "default provider used as an alias",
+			Remediation: "Providers labeled \"default\" are always available to scenario modules. Remove the provider from the step's providers attribute.",
+			DocURL:      codeDocBaseURL + "provider",
+		},
+		{
+			Code:        CodeInvalidMatrix,
+			Summary:     "invalid matrix",
+			Remediation: "A scenario can have at most one matrix block. Matrix attributes must be non-empty lists of strings and include and exclude blocks must only contain matrix attributes.",
+			DocURL:      codeDocBaseURL + "scenario",
+		},
+		{
+			Code:        CodeNoMatchingScenarios,
+			Summary:     "no scenarios matched the filter",
+			Remediation: "Use 'enos scenario list' to see the available scenarios and variants and check the filter for typos.",
+			DocURL:      codeDocBaseURL + "scenario-list",
+		},
+		{
+			Code:        CodeNoScenarios,
+			Summary:     "no scenarios have been defined",
+			Remediation: "Make sure the directory contains enos*.hcl files that define at least one scenario block, or pass the correct directory with --chdir.",
+			DocURL:      codeDocBaseURL + "scenario",
+		},
+		{
+			Code:        CodeInvalidVerifies,
+			Summary:     "invalid quality verification",
+			Remediation: "The verifies attribute must be a known quality value or list of quality values and each quality may only be verified once per step.",
+			DocURL:      codeDocBaseURL + "quality",
+		},
+		{
+			Code:        CodeInvalidSample,
+			Summary:     "invalid sample",
+			Remediation: "Samples must have at least one subset and each subset must refer to a scenario by name or filter, but not both.",
+			DocURL:      codeDocBaseURL + "sample",
+		},
+		{
+			Code:        CodeInvalidTerraformSetting,
+			Summary:     "invalid terraform settings",
+			Remediation: "Check the terraform block for unsupported or redefined attributes and blocks.",
+			DocURL:      codeDocBaseURL + "terraform-settings",
+		},
+		{
+			Code:        CodeInvalidTerraformCLI,
+			Summary:     "invalid terraform_cli",
+			Remediation: "Check the terraform_cli block attribute types and make sure the scenario's terraform_cli refers to a defined terraform_cli block.",
+			DocURL:      codeDocBaseURL + "terraform-cli",
+		},
+		{
+			Code:        CodeInvalidInclude,
+			Summary:     "invalid include",
+			Remediation: "The include block paths must be a list of glob patterns relative to the file that contains the include block, and every pattern must match at least one file.",
+			DocURL:      codeDocBaseURL + "include",
+		},
+		{
+			Code:        CodeInvalidImport,
+			Summary:     "invalid import",
+			Remediation: "The import block source must be a local directory, a local archive, or an http(s) URL of an archive. Remote archives require a checksum in the form sha256:<hex digest> which must match the archive.",
+			DocURL:      codeDocBaseURL + "import",
+		},
+		{
+			Code:        CodeImportCycle,
+			Summary:     "import cycle",
+			Remediation: "Remove one of the import blocks in the cycle so that no library imports itself directly or indirectly.",
+			DocURL:      codeDocBaseURL + "import",
+		},
+		{
+			Code:        CodeModuleGenerateFailed,
+			Summary:     "failed to generate the Terraform module for a scenario",
+			Remediation: "Make sure the out directory is writable and that the scenario decodes with 'enos scenario validate'.",
+			DocURL:      codeDocBaseURL + "scenario-generate",
+		},
+		{
+			Code:        CodeTerraformUnavailable,
+			Summary:     "unable to execute Terraform",
+			Remediation: "Make sure terraform is installed and in your PATH, or set the path of the binary in the scenario's terraform_cli block.",
+			DocURL:      codeDocBaseURL + "terraform-cli",
+		},
+		{
+			Code:        CodeTerraformInitFailed,
+			Summary:     "terraform init failed",
+			Remediation: "Check that module sources, provider requirements, and backend configuration are valid and reachable. " + codeTerraformOutputHint,
+			DocURL:      codeTerraformDocBaseURL + "init",
+		},
+		{
+			Code:        CodeTerraformValidateFailed,
+			Summary:     "terraform validate failed",
+			Remediation: "The generated module is not valid. Check that step variables match the module's input variables and types. " + codeTerraformOutputHint,
+			DocURL:      codeTerraformDocBaseURL + "validate",
+		},
+		{
+			Code:        CodeTerraformPlanFailed,
+			Summary:     "terraform plan failed",
+			Remediation: codeTerraformOutputHint,
+			DocURL:      codeTerraformDocBaseURL + "plan",
+		},
+		{
+			Code:        CodeTerraformApplyFailed,
+			Summary:     "terraform apply failed",
+			Remediation: "Resources may have been partially created. Use 'enos scenario status' to inspect the scenario and 'enos scenario destroy' to clean it up. " + codeTerraformOutputHint,
+			DocURL:      codeTerraformDocBaseURL + "apply",
+		},
+		{
+			Code:        CodeTerraformDestroyFailed,
+			Summary:     "terraform destroy failed",
+			Remediation: "Some resources may still exist. Retry the destroy or inspect the remaining state with 'enos scenario exec --cmd \"state list\"'. " + codeTerraformOutputHint,
+			DocURL:      codeTerraformDocBaseURL + "destroy",
+		},
+		{
+			Code:        CodeTerraformShowFailed,
+			Summary:     "terraform show failed",
+			Remediation: "Make sure the scenario has been launched and its module can be initialized. " + codeTerraformOutputHint,
+			DocURL:      codeTerraformDocBaseURL + "show",
+		},
+		{
+			Code:        CodeTerraformOutputFailed,
+			Summary:     "terraform output failed",
+			Remediation: "Make sure the scenario has been launched and that the requested output is defined in the scenario. " + codeTerraformOutputHint,
+			DocURL:      codeTerraformDocBaseURL + "output",
+		},
+		{
+			Code:        CodeTerraformExecFailed,
+			Summary:     "terraform sub-command failed",
+			Remediation: "Check the sub-command passed with --cmd. " + codeTerraformOutputHint,
+			DocURL:      codeDocBaseURL + "scenario-exec",
+		},
+		{
+			Code:        CodeTerraformPartialLaunch,
+			Summary:     "only some scenario steps were targeted",
+			Remediation: "The Terraform state only reflects the targeted steps and the steps they depend on. Launch the scenario without --target-step or --from-step to converge the entire scenario.",
+			DocURL:      codeDocBaseURL + "scenario-launch",
+		},
+		{
+			Code:        CodeTerraformStepTimeout,
+			Summary:     "step exceeded its timeout",
+			Remediation: "A step took longer to apply than its timeout attribute allows and the apply was aborted. Check the step's resources for commands that hang, or increase the step timeout.",
+			DocURL:      codeDocBaseURL + "scenario",
+		},
+		{
+			Code:        CodeScenarioHookFailed,
+			Summary:     "scenario hook failed",
+			Remediation: "A scenario hook command exited with an error. The hook's stdout and stderr are included with the operation results.",
+			DocURL:      codeDocBaseURL + "scenario",
+		},
+		{
+			Code:        CodeInvalidLintConfig,
+			Summary:     "invalid lint configuration",
+			Remediation: "Check the .enos-lint.hcl file. It may only contain rule blocks labeled with a known rule name that set the enabled and severity attributes.",
+			DocURL:      codeDocBaseURL + "scenario-lint",
+		},
+		{
+			Code:        CodeLintUnusedModule,
+			Summary:     "module is not used by any step",
+			Remediation: "Remove the module block or use it in a scenario step.",
+			DocURL:      codeDocBaseURL + "scenario-lint",
+		},
+		{
+			Code:        CodeLintUnusedVariable,
+			Summary:     "variable is never referenced",
+			Remediation: "Remove the variable block or reference it with var.<name>.",
+			DocURL:      codeDocBaseURL + "scenario-lint",
+		},
+		{
+			Code:        CodeLintUnusedQuality,
+			Summary:     "quality is never verified",
+			Remediation: "Remove the quality block or verify it in a scenario step with verifies = quality.<name>.",
+			DocURL:      codeDocBaseURL + "scenario-lint",
+		},
+		{
+			Code:        CodeLintUnusedGlobal,
+			Summary:     "global is never referenced",
+			Remediation: "Remove the global or reference it with global.<name>.",
+			DocURL:      codeDocBaseURL + "scenario-lint",
+		},
+		{
+			Code:        CodeLintStepWithoutVerifies,
+			Summary:     "step does not verify any quality",
+			Remediation: "Add a verifies attribute to the step with the qualities that it verifies.",
+			DocURL:      codeDocBaseURL + "quality",
+		},
+		{
+			Code:        CodeLintRedundantDependsOn,
+			Summary:     "step depends_on is redundant",
+			Remediation: "The step already references the step in its variables, which implies the dependency. Remove it from depends_on.",
+			DocURL:      codeDocBaseURL + "scenario-lint",
+		},
+		{
+			Code:        CodeLintExcludedMatrixValue,
+			Summary:     "matrix value is excluded from every variant",
+			Remediation: "The matrix value never appears in a scenario variant because exclude blocks remove every combination that includes it. Remove the value from the matrix or relax the excludes.",
+			DocURL:      codeDocBaseURL + "scenario-lint",
+		},
+		{
+			Code:        CodeLintUndocumentedScenario,
+			Summary:     "scenario does not have a description",
+			Remediation: "Add a description attribute to the scenario that describes what it verifies.",
+			DocURL:      codeDocBaseURL + "scenario-lint",
+		},
+	} {
+		registry[info.Code] = info
+	}
+}
+
+// LookupCode returns the information for a registered diagnostic code.
+func LookupCode(code Code) (*CodeInfo, bool) {
+	info, ok := registry[Code(strings.ToUpper(code.String()))]
+
+	return info, ok
+}
+
+// Codes returns all registered diagnostic codes sorted by code.
+func Codes() []*CodeInfo {
+	codes := make([]*CodeInfo, 0, len(registry))
+	for _, info := range registry {
+		codes = append(codes, info)
+	}
+
+	slices.SortFunc(codes, func(a, b *CodeInfo) int {
+		return strings.Compare(a.Code.String(), b.Code.String())
+	})
+
+	return codes
+}
+
+// ValidateCode returns an error if the code is not a well formed or registered code.
+func ValidateCode(code Code) error {
+	if !codeRe.MatchString(strings.ToUpper(code.String())) {
+		return fmt.Errorf("%s is not a valid diagnostic code, codes must be E followed by four digits", code)
+	}
+
+	if _, ok := LookupCode(code); !ok {
+		return fmt.Errorf("%s is not a known diagnostic code", code)
+	}
+
+	return nil
+}
+
+// CodedError is an error that carries a diagnostic code. When converted into
+// diagnostics with FromErr the code will be set.
+type CodedError struct {
+	Code Code
+	Err  error
+}
+
+var (
+	_ error = (*CodedError)(nil)
+	_ Coder = (*CodedError)(nil)
+)
+
+// ErrWithCode wraps the error with a diagnostic code. It returns nil if the
+// error is nil.
+func ErrWithCode(code Code, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &CodedError{Code: code, Err: err}
+}
+
+// WithCode sets the diagnostic code on any HCL diagnostics that don't have extra information.
+func WithCode(diags hcl.Diagnostics, code Code) hcl.Diagnostics {
+	for i := range diags {
+		if diags[i].Extra == nil {
+			diags[i].Extra = code
+		}
+	}
+
+	return diags
+}
+
+// Error returns the wrapped error message.
+func (e *CodedError) Error() string {
+	if e.Err == nil {
+		return ""
+	}
+
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *CodedError) Unwrap() error {
+	return e.Err
+}
+
+// DiagnosticCode returns the code.
+func (e *CodedError) DiagnosticCode() Code {
+	return e.Code
+}
+
+// codeForErr returns the code of the error if it has one.
+func codeForErr(err error) string {
+	var coder Coder
+	if errors.As(err, &coder) {
+		return coder.DiagnosticCode().String()
+	}
+
+	return ""
+}
+
+// codeForHCL returns the code of the HCL diagnostic if it has one.
+func codeForHCL(diag *hcl.Diagnostic) string {
+	if coder, ok := hcl.DiagnosticExtra[Coder](diag); ok {
+		return coder.DiagnosticCode().String()
+	}
+
+	return ""
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostics
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mitchellh/colorstring"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+func TestCodesRegistry(t *testing.T) {
+	t.Parallel()
+
+	codes := Codes()
+	require.NotEmpty(t, codes)
+
+	for i, info := range codes {
+		t.Run(info.Code.String(), func(t *testing.T) {
+			t.Parallel()
+
+			require.NoError(t, ValidateCode(info.Code))
+			require.NotEmpty(t, info.Summary)
+			require.NotEmpty(t, info.Remediation)
+			require.NotEmpty(t, info.DocURL)
+
+			if i > 0 {
+				require.Less(t, codes[i-1].Code.String(), info.Code.String())
+			}
+		})
+	}
+}
+
+func TestValidateCode(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateCode(CodeUnknownStepReference))
+	require.NoError(t, ValidateCode("e1203"))
+	require.Error(t, ValidateCode("1203"))
+	require.Error(t, ValidateCode("E9999"))
+}
+
+func TestFromErrWithCode(t *testing.T) {
+	t.Parallel()
+
+	err := fmt.Errorf("wrapped: %w", ErrWithCode(CodeTerraformInitFailed, errors.New("init failed")))
+	diags := FromErr(err)
+	require.Len(t, diags, 1)
+	require.Equal(t, CodeTerraformInitFailed.String(), diags[0].GetCode())
+	require.Equal(t, "wrapped: init failed", diags[0].GetSummary())
+
+	require.NoError(t, ErrWithCode(CodeTerraformInitFailed, nil))
+	require.Empty(t, FromErr(errors.New("no code"))[0].GetCode())
+}
+
+func TestFromHCLWithCode(t *testing.T) {
+	t.Parallel()
+
+	diags := FromHCL(nil, hcl.Diagnostics{
+		{
+			Severity: hcl.DiagError,
+			Summary:  "no step named foo has been previously defined",
+			Extra:    CodeUnknownStepReference,
+		},
+		{
+			Severity: hcl.DiagError,
+			Summary:  "no code",
+		},
+	})
+	require.Len(t, diags, 2)
+	require.Equal(t, CodeUnknownStepReference.String(), diags[0].GetCode())
+	require.Empty(t, diags[1].GetCode())
+
+	str := String(diags[0], WithStringColor(&colorstring.Colorize{Disable: true}))
+	require.Contains(t, str, "Error[E1203]:")
+	require.Contains(t, str, "enos explain E1203")
+}
+
+func TestWithCode(t *testing.T) {
+	t.Parallel()
+
+	diags := WithCode(hcl.Diagnostics{
+		{Severity: hcl.DiagError, Summary: "coded", Extra: CodeUnknownStepReference},
+		{Severity: hcl.DiagError, Summary: "no code"},
+	}, CodeInvalidImport)
+	require.Equal(t, CodeUnknownStepReference, diags[0].Extra)
+	require.Equal(t, CodeInvalidImport, diags[1].Extra)
+}
@@ -89,6 +89,7 @@ func FromErr(err error) []*pb.Diagnostic {
 	return []*pb.Diagnostic{{
 		Severity: pb.Diagnostic_SEVERITY_ERROR,
 		Summary:  err.Error(),
+		Code:     codeForErr(err),
 	}}
 }
 
@@ -192,6 +193,7 @@ func FromHCL(files map[string]*hcl.File, diags hcl.Diagnostics) []*pb.Diagnostic
 		pbDiag := &pb.Diagnostic{
 			Summary: diag.Summary,
 			Detail:  diag.Detail,
+			Code:    codeForHCL(diag),
 		}
 
 		switch diag.Severity {
@@ -410,13 +412,13 @@ func String(diag *pb.Diagnostic, opts ...StringOpt) string {
 
 	switch diag.GetSeverity() {
 	case pb.Diagnostic_SEVERITY_ERROR:
-		buf.WriteString(cfg.color.Color("[bold][red]Error: [reset]"))
+		buf.WriteString(cfg.color.Color("[bold][red]Error" + codeLabel(diag) + ": [reset]"))
 		leftRuleLine = cfg.color.Color("[red]│[reset] ")
 		leftRuleStart = cfg.color.Color("[red]╷[reset]")
 		leftRuleEnd = cfg.color.Color("[red]╵[reset]")
 		leftRuleWidth = 4
 	case pb.Diagnostic_SEVERITY_WARNING:
-		buf.WriteString(cfg.color.Color("[bold][yellow]Warning: [reset]"))
+		buf.WriteString(cfg.color.Color("[bold][yellow]Warning" + codeLabel(diag) + ": [reset]"))
 		leftRuleLine = cfg.color.Color("[yellow]│[reset] ")
 		leftRuleStart = cfg.color.Color("[yellow]╷[reset]")
 		leftRuleEnd = cfg.color.Color("[yellow]╵[reset]")
@@ -449,6 +451,12 @@ func String(diag *pb.Diagnostic, opts ...StringOpt) string {
 		}
 	}
 
+	if diag.GetCode() != "" {
+		if _, ok := LookupCode(Code(diag.GetCode())); ok {
+			fmt.Fprintf(&buf, "\nRun 'enos explain %s' for more information.\n", diag.GetCode())
+		}
+	}
+
 	// Before we return, we'll finally add the left rule prefixes to each
 	// line so that the overall message is visually delimited from what's
 	// around it. We'll do that by scanning over what we already generated
@@ -475,6 +483,16 @@ func String(diag *pb.Diagnostic, opts ...StringOpt) string {
 	return strings.TrimSpace(ruleBuf.String())
 }
 
+// codeLabel returns the code label for the diagnostic severity, if it has a code.
+func codeLabel(diag *pb.Diagnostic) string {
+	if diag.GetCode() == "" {
+		return ""
+	}
+
+	// Codes aren't valid colors so the brackets will be written as-is.
+	return "[" + diag.GetCode() + "]"
+}
+
 func appendSourceSnippets(buf *bytes.Buffer, diag *pb.Diagnostic, color *colorstring.Colorize) {
 	if diag.GetRange() == nil {
 		fmt.Fprintf(buf, "  (source code not available)\n")
@@ -33,6 +33,11 @@ func OpResWarnings(res *pb.Operation_Response) bool {
 	return HasWarnings(resDiags(res))
 }
 
+// OpResDiagnostics returns all of the diagnostics in an operation response.
+func OpResDiagnostics(res *pb.Operation_Response) []*pb.Diagnostic {
+	return resDiags(res)
+}
+
 // OpEventErrors returns whether the event has errors.
 func OpEventErrors(e *pb.Operation_Event) bool {
 	return HasErrors(eventDiags(e))
@@ -67,6 +72,10 @@ func resDiags(res *pb.Operation_Response) []*pb.Diagnostic {
 		res.GetLaunch().GetValidate().GetDiagnostics(),
 		res.GetLaunch().GetPlan().GetDiagnostics(),
 		res.GetLaunch().GetApply().GetDiagnostics(),
+		hookDiags(res.GetLaunch().GetHooks()),
+		res.GetLaunch().GetFailurePolicy().GetDiagnostics(),
+		res.GetLaunch().GetFailurePolicy().GetPriorStateShow().GetDiagnostics(),
+		res.GetLaunch().GetFailurePolicy().GetDestroy().GetDiagnostics(),
 		res.GetRun().GetDiagnostics(),
 		res.GetRun().GetInit().GetDiagnostics(),
 		res.GetRun().GetValidate().GetDiagnostics(),
@@ -74,14 +83,22 @@ func resDiags(res *pb.Operation_Response) []*pb.Diagnostic {
 		res.GetRun().GetApply().GetDiagnostics(),
 		res.GetRun().GetPriorStateShow().GetDiagnostics(),
 		res.GetRun().GetDestroy().GetDiagnostics(),
+		hookDiags(res.GetRun().GetHooks()),
+		res.GetRun().GetFailurePolicy().GetDiagnostics(),
+		res.GetRun().GetFailurePolicy().GetPriorStateShow().GetDiagnostics(),
+		res.GetRun().GetFailurePolicy().GetDestroy().GetDiagnostics(),
 		res.GetDestroy().GetDiagnostics(),
 		res.GetDestroy().GetInit().GetDiagnostics(),
 		res.GetDestroy().GetPriorStateShow().GetDiagnostics(),
 		res.GetDestroy().GetDestroy().GetDiagnostics(),
+		hookDiags(res.GetDestroy().GetHooks()),
 		res.GetExec().GetDiagnostics(),
 		res.GetExec().GetExec().GetDiagnostics(),
 		res.GetOutput().GetDiagnostics(),
 		res.GetOutput().GetOutput().GetDiagnostics(),
+		res.GetState().GetDiagnostics(),
+		res.GetState().GetInit().GetDiagnostics(),
+		res.GetState().GetShow().GetDiagnostics(),
 	)
 }
 
@@ -98,5 +115,16 @@ func eventDiags(e *pb.Operation_Event) []*pb.Diagnostic {
 		e.GetExec().GetDiagnostics(),
 		e.GetOutput().GetDiagnostics(),
 		e.GetShow().GetDiagnostics(),
+		e.GetHook().GetDiagnostics(),
 	)
 }
+
+// hookDiags returns all of the diagnostics of scenario hooks.
+func hookDiags(hooks []*pb.Operation_Response_Hook) []*pb.Diagnostic {
+	diags := []*pb.Diagnostic{}
+	for _, hook := range hooks {
+		diags = append(diags, hook.GetDiagnostics()...)
+	}
+
+	return diags
+}
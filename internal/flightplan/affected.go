@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+// pathFuncs are the functions that reference local files by path. We record their arguments while
+// decoding the flight plan and scenarios so that we know which files a scenario variant depends on.
+var pathFuncs = []string{"abspath", "file"}
+
+// ChangedFiles is a set of changed files or directories. It is used to determine whether or not a
+// scenario variant might be affected by a change.
+type ChangedFiles struct {
+	paths []string
+}
+
+// NewChangedFiles takes a base directory and changed paths. Relative paths are considered to be
+// relative to the base directory.
+func NewChangedFiles(baseDir string, paths ...string) *ChangedFiles {
+	c := &ChangedFiles{paths: []string{}}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(baseDir, path)
+		}
+		c.paths = append(c.paths, normalizePath(path))
+	}
+
+	return c
+}
+
+// Paths returns the changed paths.
+func (c *ChangedFiles) Paths() []string {
+	if c == nil {
+		return nil
+	}
+
+	return c.paths
+}
+
+// Affects returns whether or not any of the changed paths are, contain, or are contained by any
+// of the dependencies.
+func (c *ChangedFiles) Affects(deps ...string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, dep := range deps {
+		dep = normalizePath(dep)
+		for _, path := range c.paths {
+			if pathContains(dep, path) || pathContains(path, dep) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ScenarioDependencies returns the local files and directories that a decoded scenario variant
+// depends on. That includes the flight plan file that defines the scenario, any flight plan files
+// that define shared configuration, local step module sources, and any files that were referenced
+// with file() or abspath() while decoding the scenario or the shared configuration.
+func (fp *FlightPlan) ScenarioDependencies(res *ScenarioDecodeResponse) []string {
+	if fp == nil || res == nil || res.Scenario == nil {
+		return nil
+	}
+
+	deps := []string{}
+	if fp.BodyContent != nil {
+		for _, block := range fp.BodyContent.Blocks {
+			if block.Type == blockTypeSample {
+				// Samples don't change scenarios
+				continue
+			}
+
+			if block.Type == blockTypeScenario &&
+				(len(block.Labels) < 1 || block.Labels[0] != res.Scenario.Name) {
+				// Only the block of our scenario is relevant
+				continue
+			}
+
+			deps = append(deps, block.DefRange.Filename)
+		}
+	}
+
+	for _, step := range res.Scenario.Steps {
+		if step.Module == nil {
+			continue
+		}
+
+		if src, ok := localModuleSource(fp.BaseDir, step.Module.Source); ok {
+			deps = append(deps, src)
+		}
+	}
+
+	deps = append(deps, res.Files...)
+	deps = append(deps, fp.fileRefs.Paths()...)
+	slices.Sort(deps)
+
+	return slices.Compact(deps)
+}
+
+// localModuleSource returns the absolute path to a module source if it is a local path.
+func localModuleSource(baseDir string, source string) (string, bool) {
+	if filepath.IsAbs(source) {
+		return source, true
+	}
+
+	if !strings.HasPrefix(source, "./") && !strings.HasPrefix(source, "../") {
+		return "", false
+	}
+
+	return filepath.Join(baseDir, source), true
+}
+
+// pathContains returns whether or not the path is or is contained by the directory.
+func pathContains(dir string, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// normalizePath returns a clean absolute path with symlinks resolved. Changed files might have
+// been deleted so we'll resolve the deepest parent directory that exists.
+func normalizePath(path string) string {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.Clean(path)
+	}
+
+	rest := ""
+	for dir := path; ; dir = filepath.Dir(dir) {
+		if _, err := os.Lstat(dir); err == nil {
+			if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+				return filepath.Join(resolved, rest)
+			}
+
+			break
+		}
+
+		if filepath.Dir(dir) == dir {
+			break
+		}
+		rest = filepath.Join(filepath.Base(dir), rest)
+	}
+
+	return path
+}
+
+// fileRefs records local file references made while decoding a scenario.
+type fileRefs struct {
+	mu    sync.Mutex
+	paths []string
+}
+
+// recordingFuncs returns the path functions in the eval context wrapped so that they record the
+// absolute path of the file they reference.
+func (r *fileRefs) recordingFuncs(evalCtx *hcl.EvalContext) map[string]function.Function {
+	funcs := map[string]function.Function{}
+
+	abspath, ok := lookupFunc(evalCtx, "abspath")
+	if !ok {
+		return funcs
+	}
+
+	for _, name := range pathFuncs {
+		fn, ok := lookupFunc(evalCtx, name)
+		if !ok {
+			continue
+		}
+
+		funcs[name] = r.recordingFunc(fn, abspath)
+	}
+
+	return funcs
+}
+
+func (r *fileRefs) recordingFunc(fn function.Function, abspath function.Function) function.Function {
+	return function.New(&function.Spec{
+		Description: fn.Description(),
+		Params:      fn.Params(),
+		VarParam:    fn.VarParam(),
+		Type:        fn.ReturnTypeForValues,
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			if len(args) > 0 && args[0].IsWhollyKnown() && !args[0].IsNull() && args[0].Type() == cty.String {
+				if path, err := abspath.Call(args[:1]); err == nil {
+					r.mu.Lock()
+					r.paths = append(r.paths, path.AsString())
+					r.mu.Unlock()
+				}
+			}
+
+			return fn.Call(args)
+		},
+	})
+}
+
+// Paths returns the recorded paths.
+func (r *fileRefs) Paths() []string {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Clone(r.paths)
+}
+
+// lookupFunc finds a function in the eval context or any of its parents.
+func lookupFunc(evalCtx *hcl.EvalContext, name string) (function.Function, bool) {
+	for ctx := evalCtx; ctx != nil; ctx = ctx.Parent() {
+		if fn, ok := ctx.Functions[name]; ok {
+			return fn, true
+		}
+	}
+
+	return function.Function{}, false
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestScenarioDependencies tests that we determine which scenario variants are affected by
+// changed files.
+func TestScenarioDependencies(t *testing.T) {
+	t.Parallel()
+
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	testWriteIncludeFiles(t, dir, map[string]string{
+		"enos-globals.hcl": `
+globals {
+  license = file("./vault.hclic")
+}
+`,
+		"enos-modules.hcl": `
+module "consul" {
+  source = "./modules/consul"
+}
+
+module "raft" {
+  source = "./modules/raft"
+}
+
+module "remote" {
+  source = "hashicorp/remote/aws"
+}
+`,
+		"enos-backend.hcl": `
+scenario "backend" {
+  matrix {
+    backend = ["raft", "consul"]
+  }
+
+  step "backend" {
+    module = matrix.backend
+  }
+}
+`,
+		"enos-distro.hcl": `
+scenario "distro" {
+  matrix {
+    distro = ["ubuntu", "rhel"]
+  }
+
+  step "remote" {
+    module = module.remote
+
+    variables {
+      script = file("./scripts/${matrix.distro}.sh")
+    }
+  }
+}
+`,
+		"modules/consul/main.tf": "",
+		"modules/raft/main.tf":   "",
+		"scripts/ubuntu.sh":      "",
+		"scripts/rhel.sh":        "",
+		"vault.hclic":            "",
+	})
+
+	files, err := FindFlightPlanFiles(dir)
+	require.NoError(t, err)
+	dec, err := NewDecoder(
+		WithDecoderBaseDir(dir),
+		WithDecoderFPFiles(files),
+		WithDecoderDecodeTarget(DecodeTargetScenariosComplete),
+	)
+	require.NoError(t, err)
+	diags := dec.Parse()
+	require.False(t, diags.HasErrors(), diags.Error())
+	fp, scenarioDecoder, diags := dec.Decode(context.Background())
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	iter := scenarioDecoder.Iterator()
+	diags = iter.Start(context.Background())
+	require.False(t, diags.HasErrors(), diags.Error())
+	defer iter.Stop()
+
+	deps := map[string][]string{}
+	for iter.Next(context.Background()) {
+		res := iter.Scenario()
+		require.False(t, res.Diagnostics.HasErrors(), res.Diagnostics.Error())
+		deps[res.Scenario.String()] = fp.ScenarioDependencies(res)
+	}
+	require.Len(t, deps, 4)
+
+	for desc, test := range map[string]struct {
+		changed  []string
+		expected []string
+	}{
+		"local module": {
+			[]string{"modules/raft/main.tf"},
+			[]string{"backend [backend:raft]"},
+		},
+		"module directory": {
+			[]string{"modules"},
+			[]string{"backend [backend:consul]", "backend [backend:raft]"},
+		},
+		"file reference": {
+			[]string{"scripts/rhel.sh"},
+			[]string{"distro [distro:rhel]"},
+		},
+		"scenario file": {
+			[]string{"enos-distro.hcl"},
+			[]string{"distro [distro:rhel]", "distro [distro:ubuntu]"},
+		},
+		"shared file": {
+			[]string{"enos-modules.hcl"},
+			[]string{"backend [backend:consul]", "backend [backend:raft]", "distro [distro:rhel]", "distro [distro:ubuntu]"},
+		},
+		"deleted file": {
+			[]string{"modules/consul/variables.tf"},
+			[]string{"backend [backend:consul]"},
+		},
+		"affected via globals file()": {
+			[]string{"vault.hclic"},
+			[]string{"backend [backend:consul]", "backend [backend:raft]", "distro [distro:rhel]", "distro [distro:ubuntu]"},
+		},
+		"unrelated file": {
+			[]string{"README.md"},
+			[]string{},
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			changed := NewChangedFiles(dir, test.changed...)
+			affected := []string{}
+			for scenario, scenarioDeps := range deps {
+				if changed.Affects(scenarioDeps...) {
+					affected = append(affected, scenario)
+				}
+			}
+			slices.Sort(affected)
+			require.Equal(t, test.expected, affected)
+		})
+	}
+}
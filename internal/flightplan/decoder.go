@@ -6,7 +6,9 @@ package flightplan
 import (
 	"context"
 	"fmt"
+	"maps"
 	"path/filepath"
+	"slices"
 
 	yaml "github.com/zclconf/go-cty-yaml"
 	"github.com/zclconf/go-cty/cty"
@@ -320,6 +322,9 @@ func (d *Decoder) scenarioDecoder(
 func (d *Decoder) Decode(ctx context.Context) (*FlightPlan, *ScenarioDecoder, hcl.Diagnostics) {
 	diags := hcl.Diagnostics{}
 
+	// Make sure we decode deep enough to evaluate our filter
+	d.target = d.filter.decodeTarget(d.target)
+
 	fp, err := NewFlightPlan(WithFlightPlanBaseDirectory(d.dir))
 	if err != nil {
 		return fp, nil, diags.Append(&hcl.Diagnostic{
@@ -344,13 +349,34 @@ func (d *Decoder) Decode(ctx context.Context) (*FlightPlan, *ScenarioDecoder, hc
 		}
 	}
 
+	// Record the files that are referenced while decoding the top-level blocks, e.g. globals, as
+	// every scenario depends on them. Scenarios record their own references when they're decoded
+	// so we'll restore the original path functions after we've decoded the top-level blocks.
+	pathFuncsOrig := map[string]function.Function{}
+	for _, name := range pathFuncs {
+		if fn, ok := evalCtx.Functions[name]; ok {
+			pathFuncsOrig[name] = fn
+		}
+	}
+	fp.fileRefs = &fileRefs{}
+	maps.Copy(evalCtx.Functions, fp.fileRefs.recordingFuncs(evalCtx))
+
 	fpFiles := d.FPParser.Files()
 	varsFiles := d.VarsParser.Files()
+	for name, file := range fpFiles {
+		fp.Files[name] = file
+	}
 
-	// Create a "unified" body of all flightplan files to use for decoding
+	// Create a "unified" body of all flightplan files to use for decoding. Merge them in order
+	// of their names so that decoding is deterministic.
+	names := make([]string, 0, len(fpFiles))
+	for name := range fpFiles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
 	files := []*hcl.File{}
-	for _, file := range fpFiles {
-		files = append(files, file)
+	for _, name := range names {
+		files = append(files, fpFiles[name])
 	}
 	body := hcl.MergeFiles(files)
 
@@ -362,6 +388,12 @@ func (d *Decoder) Decode(ctx context.Context) (*FlightPlan, *ScenarioDecoder, hc
 		return fp, nil, diags
 	}
 
+	// Make sure that any included files were found
+	diags = diags.Extend(fp.decodeIncludes())
+	if diags.HasErrors() {
+		return fp, nil, diags
+	}
+
 	// Decode to our desired target level. Start with the lowest level and continue until we've
 	// reached our desired target. Each target level includes more blocks. Where appropriate, each
 	// decoder is responsible for extending the eval context and/or falling through to the next
@@ -448,6 +480,12 @@ func (d *Decoder) Decode(ctx context.Context) (*FlightPlan, *ScenarioDecoder, hc
 		}
 
 		if d.target >= DecodeTargetProviders {
+			// Decode our imported libraries and add their namespaces to the eval context.
+			diags = diags.Extend(d.decodeImports(ctx, fp, evalCtx))
+			if diags != nil && diags.HasErrors() {
+				return diags
+			}
+
 			diags = diags.Extend(fp.decodeProviders(evalCtx))
 			if diags != nil && diags.HasErrors() {
 				return diags
@@ -465,6 +503,7 @@ func (d *Decoder) Decode(ctx context.Context) (*FlightPlan, *ScenarioDecoder, hc
 	}
 
 	diags = diags.Extend(decodeToLevel())
+	maps.Copy(evalCtx.Functions, pathFuncsOrig)
 	if diags.HasErrors() {
 		return fp, nil, diags
 	}
@@ -281,6 +281,10 @@ func testRequireEqualFP(t *testing.T, fp, expected *FlightPlan) {
 			require.Equal(t, expected.ScenarioBlocks[i].Name, gotBlock.Name)
 			require.Equal(t, expected.ScenarioBlocks[i].Scenarios[j].Name, gotBlock.Scenarios[j].Name)
 			require.Equal(t, expected.ScenarioBlocks[i].Scenarios[j].Description, gotBlock.Scenarios[j].Description)
+			require.Equal(t,
+				FailurePolicyString(expected.ScenarioBlocks[i].Scenarios[j].FailurePolicy),
+				FailurePolicyString(gotBlock.Scenarios[j].FailurePolicy),
+			)
 			require.Equal(t, expected.ScenarioBlocks[i].Scenarios[j].TerraformSetting, gotBlock.Scenarios[j].TerraformSetting)
 			require.Equal(t, expected.ScenarioBlocks[i].Scenarios[j].TerraformCLI, gotBlock.Scenarios[j].TerraformCLI)
 			if expected.ScenarioBlocks[i].Scenarios[j].Variants == nil {
@@ -319,6 +323,7 @@ func testRequireEqualFP(t *testing.T, fp, expected *FlightPlan) {
 				require.Equal(t, expected.ScenarioBlocks[i].Scenarios[j].Steps[is].DependsOn, gotBlock.Scenarios[j].Steps[is].DependsOn)
 				require.Equal(t, expected.ScenarioBlocks[i].Scenarios[j].Steps[is].Verifies, gotBlock.Scenarios[j].Steps[is].Verifies)
 				require.Equal(t, expected.ScenarioBlocks[i].Scenarios[j].Steps[is].Skip, gotBlock.Scenarios[j].Steps[is].Skip)
+				require.Equal(t, expected.ScenarioBlocks[i].Scenarios[j].Steps[is].Timeout, gotBlock.Scenarios[j].Steps[is].Timeout)
 				require.Equal(t, expected.ScenarioBlocks[i].Scenarios[j].Steps[is].Module.Name, gotBlock.Scenarios[j].Steps[is].Module.Name)
 				require.Equal(t, expected.ScenarioBlocks[i].Scenarios[j].Steps[is].Module.Source, gotBlock.Scenarios[j].Steps[is].Module.Source)
 				require.Equal(t, expected.ScenarioBlocks[i].Scenarios[j].Steps[is].Module.Version, gotBlock.Scenarios[j].Steps[is].Module.Version)
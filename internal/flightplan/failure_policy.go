@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// Failure policies.
+const (
+	FailurePolicyKeep    = "keep"
+	FailurePolicyDestroy = "destroy"
+	FailurePolicyKeepFor = "keep-for"
+)
+
+// ParseFailurePolicy parses a failure policy string. Valid policies are "keep", "destroy", and
+// "keep-for=<duration>".
+func ParseFailurePolicy(policy string) (*pb.Operation_FailurePolicy, error) {
+	name, dur, hasDur := strings.Cut(strings.TrimSpace(policy), "=")
+
+	switch name {
+	case FailurePolicyKeep, FailurePolicyDestroy:
+		if hasDur {
+			return nil, fmt.Errorf("failure policy %s does not accept a duration", name)
+		}
+
+		if name == FailurePolicyKeep {
+			return &pb.Operation_FailurePolicy{Type: pb.Operation_FailurePolicy_TYPE_KEEP}, nil
+		}
+
+		return &pb.Operation_FailurePolicy{Type: pb.Operation_FailurePolicy_TYPE_DESTROY}, nil
+	case FailurePolicyKeepFor:
+		if !hasDur {
+			return nil, errors.New("failure policy keep-for requires a duration, e.g. keep-for=2h")
+		}
+
+		keepFor, err := time.ParseDuration(dur)
+		if err != nil {
+			return nil, fmt.Errorf("invalid keep-for duration: %w", err)
+		}
+		if keepFor <= 0 {
+			return nil, errors.New("keep-for duration must be greater than zero")
+		}
+
+		return &pb.Operation_FailurePolicy{
+			Type:    pb.Operation_FailurePolicy_TYPE_KEEP_FOR,
+			KeepFor: durationpb.New(keepFor),
+		}, nil
+	default:
+		return nil, fmt.Errorf(
+			"unknown failure policy %q, must be one of %s, %s, or %s=<duration>",
+			policy, FailurePolicyKeep, FailurePolicyDestroy, FailurePolicyKeepFor,
+		)
+	}
+}
+
+// FailurePolicyString returns the failure policy as a string.
+func FailurePolicyString(policy *pb.Operation_FailurePolicy) string {
+	switch policy.GetType() {
+	case pb.Operation_FailurePolicy_TYPE_DESTROY:
+		return FailurePolicyDestroy
+	case pb.Operation_FailurePolicy_TYPE_KEEP_FOR:
+		return FailurePolicyKeepFor + "=" + policy.GetKeepFor().AsDuration().String()
+	case pb.Operation_FailurePolicy_TYPE_KEEP, pb.Operation_FailurePolicy_TYPE_UNSPECIFIED:
+		return FailurePolicyKeep
+	default:
+		return FailurePolicyKeep
+	}
+}
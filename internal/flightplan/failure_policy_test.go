@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// TestParseFailurePolicy tests parsing failure policy strings.
+func TestParseFailurePolicy(t *testing.T) {
+	t.Parallel()
+
+	for desc, test := range map[string]struct {
+		in       string
+		expected *pb.Operation_FailurePolicy
+		str      string
+		fail     bool
+	}{
+		"keep": {
+			in:       "keep",
+			expected: &pb.Operation_FailurePolicy{Type: pb.Operation_FailurePolicy_TYPE_KEEP},
+			str:      "keep",
+		},
+		"destroy": {
+			in:       "destroy",
+			expected: &pb.Operation_FailurePolicy{Type: pb.Operation_FailurePolicy_TYPE_DESTROY},
+			str:      "destroy",
+		},
+		"keep-for": {
+			in: "keep-for=2h",
+			expected: &pb.Operation_FailurePolicy{
+				Type:    pb.Operation_FailurePolicy_TYPE_KEEP_FOR,
+				KeepFor: durationpb.New(2 * time.Hour),
+			},
+			str: "keep-for=2h0m0s",
+		},
+		"keep-for without duration": {
+			in:   "keep-for",
+			fail: true,
+		},
+		"keep-for negative duration": {
+			in:   "keep-for=-1h",
+			fail: true,
+		},
+		"destroy with duration": {
+			in:   "destroy=1h",
+			fail: true,
+		},
+		"unknown": {
+			in:   "explode",
+			fail: true,
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := ParseFailurePolicy(test.in)
+			if test.fail {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected.GetType(), policy.GetType())
+			require.Equal(t, test.expected.GetKeepFor().AsDuration(), policy.GetKeepFor().AsDuration())
+			require.Equal(t, test.str, FailurePolicyString(policy))
+		})
+	}
+}
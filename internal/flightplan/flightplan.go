@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
 	"github.com/zclconf/go-cty/cty"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 )
@@ -25,6 +27,7 @@ const (
 	blockTypeCloud             = "cloud"
 	blockTypeMatrixExclude     = "exclude"
 	blockTypeGlobals           = "globals"
+	blockTypeHook              = "hook"
 	blockTypeMatrixInclude     = "include"
 	blockTypeLocals            = "locals"
 	blockTypeMatrix            = "matrix"
@@ -48,6 +51,8 @@ const (
 var flightPlanSchema = &hcl.BodySchema{
 	Blocks: []hcl.BlockHeaderSchema{
 		{Type: blockTypeGlobals},
+		{Type: blockTypeInclude},
+		{Type: blockTypeImport, LabelNames: []string{attrLabelNameDefault}},
 		{Type: blockTypeSample, LabelNames: []string{attrLabelNameDefault}},
 		{Type: blockTypeTerraformSetting, LabelNames: []string{attrLabelNameDefault}},
 		{Type: blockTypeTerraformCLI, LabelNames: []string{attrLabelNameDefault}},
@@ -100,13 +105,19 @@ type FlightPlan struct {
 	BaseDir           string
 	BodyContent       *hcl.BodyContent
 	Files             map[string]*hcl.File
+	Imports           []*Import
 	Modules           []*Module
 	Providers         []*Provider
 	Qualities         []*Quality
 	TerraformSettings []*TerraformSetting
 	TerraformCLIs     []*TerraformCLI
-	Samples           []*Sample
-	ScenarioBlocks    ScenarioBlocks
+	Variables         []*Variable
+	// UndeclaredVariableValues are user supplied values for variables that have not been declared.
+	UndeclaredVariableValues map[string]*VariableValue
+	Samples                  []*Sample
+	ScenarioBlocks           ScenarioBlocks
+	// fileRefs are the files that were referenced while decoding the top-level blocks.
+	fileRefs *fileRefs
 }
 
 func (fp *FlightPlan) Scenarios() []*Scenario {
@@ -153,6 +164,7 @@ func (fp *FlightPlan) decodeVariables(
 			Summary:  "Variable declaration in enos.vars.hcl file",
 			Detail:   fmt.Sprintf("An enos.vars.hcl file is used to assign values to variables that have already been declared in enos.hcl files, not to declare new variables. To declare variable %q, place this block in one of your enos.hcl files, such as enos-variables.hcl.\n\nTo set a value for this variable in %s, use the definition syntax instead:\n    %s = <value>", name, block.TypeRange.Filename, name),
 			Subject:  block.TypeRange.Ptr(),
+			Extra:    diagnostics.CodeVariableDeclaredInVarsFile,
 		})
 	}
 	if diags.HasErrors() {
@@ -220,12 +232,28 @@ func (fp *FlightPlan) decodeVariables(
 			continue
 		}
 
+		moreDiags = variable.validate(ctx, values[variable.Name])
+		diags = diags.Extend(moreDiags)
+		if moreDiags != nil && moreDiags.HasErrors() {
+			continue
+		}
+
+		fp.Variables = append(fp.Variables, variable)
 		vars[variable.Name] = variable.Value()
 	}
 
-	// NOTE: We only keep track of variable values in the eval context. That is
-	// fine for now but if we ever want to handle things like "sensitive" we'll
-	// have to keep them around in the flight plan.
+	fp.UndeclaredVariableValues = map[string]*VariableValue{}
+	for name, value := range values {
+		declared := slices.ContainsFunc(fp.BodyContent.Blocks.OfType(blockTypeVariable), func(block *hcl.Block) bool {
+			return len(block.Labels) > 0 && block.Labels[0] == name
+		})
+		if !declared {
+			fp.UndeclaredVariableValues[name] = value
+		}
+	}
+
+	// Expose the variable values to the rest of the flight plan. The variables themselves are kept
+	// in the flight plan so that we can handle things like "sensitive".
 	ctx.Variables["var"] = cty.ObjectVal(vars)
 
 	return diags
@@ -361,6 +389,7 @@ func (fp *FlightPlan) decodeQualities(ctx *hcl.EvalContext) hcl.Diagnostics {
 				Summary:  "quality has previously been defined",
 				Detail:   fmt.Sprintf(`quality %s has already been defined`, quality.Name),
 				Subject:  block.DefRange.Ptr(),
+				Extra:    diagnostics.CodeRedeclaredBlock,
 			})
 
 			continue
@@ -418,6 +447,16 @@ func (fp *FlightPlan) decodeProviders(ctx *hcl.EvalContext) hcl.Diagnostics {
 	// provider type -> alias name -> provider object value
 	providers := map[string]map[string]cty.Value{}
 
+	// Imported providers are aliased with their namespace prefix
+	for _, imp := range allImports(fp.Imports) {
+		for _, provider := range imp.Providers {
+			if _, ok := providers[provider.Type]; !ok {
+				providers[provider.Type] = map[string]cty.Value{}
+			}
+			providers[provider.Type][provider.Alias] = provider.ToCtyValue()
+		}
+	}
+
 	for _, block := range fp.BodyContent.Blocks.OfType(blockTypeProvider) {
 		moreDiags := verifyBlockLabelsAreValidIdentifiers(block)
 		diags = diags.Extend(moreDiags)
@@ -452,6 +491,7 @@ func (fp *FlightPlan) decodeProviders(ctx *hcl.EvalContext) hcl.Diagnostics {
 				Detail:   fmt.Sprintf(`provider %s with alias %s has already been defined`, provider.Type, provider.Alias),
 				Subject:  hcl.RangeBetween(block.LabelRanges[0], block.LabelRanges[1]).Ptr(),
 				Context:  block.DefRange.Ptr(),
+				Extra:    diagnostics.CodeRedeclaredBlock,
 			})
 
 			continue
@@ -478,6 +518,13 @@ func (fp *FlightPlan) decodeModules(ctx *hcl.EvalContext) hcl.Diagnostics {
 	diags := hcl.Diagnostics{}
 	mods := map[string]cty.Value{}
 
+	// Imported modules are named with their namespace prefix
+	for _, imp := range allImports(fp.Imports) {
+		for _, module := range imp.Modules {
+			mods[module.Name] = module.ToCtyValue()
+		}
+	}
+
 	for _, block := range fp.BodyContent.Blocks.OfType(blockTypeModule) {
 		moreDiags := verifyBlockLabelsAreValidIdentifiers(block)
 		diags = diags.Extend(moreDiags)
@@ -530,6 +577,7 @@ func filterTerraformMetaAttrs(in hcl.Attributes) (hcl.Attributes, hcl.Diagnostic
 				Detail:   fmt.Sprintf(`Terraform meta-arguments "%s" are not valid`, attr.Name),
 				Subject:  attr.NameRange.Ptr(),
 				Context:  hcl.RangeBetween(attr.NameRange, attr.Range).Ptr(),
+				Extra:    diagnostics.CodeInvalidBlock,
 			})
 		default:
 			if out == nil {
@@ -557,6 +605,7 @@ func verifyNoBlockInAttrOnlySchema(in hcl.Body) hcl.Diagnostics {
 				Summary:  "unexpected block",
 				Subject:  block.TypeRange.Ptr(),
 				Context:  hcl.RangeBetween(block.TypeRange, block.Range()).Ptr(),
+				Extra:    diagnostics.CodeInvalidBlock,
 			})
 		}
 	}
@@ -587,6 +636,7 @@ func verifyBodyOnlyHasBlocksWithLabels(in hcl.Body, allowed ...string) hcl.Diagn
 					Detail:   fmt.Sprintf("block type %s is not allowed, must be one of %x", block.Type, allowed),
 					Subject:  block.TypeRange.Ptr(),
 					Context:  hcl.RangeBetween(block.TypeRange, block.Range()).Ptr(),
+					Extra:    diagnostics.CodeInvalidBlock,
 				})
 			}
 		}
@@ -607,6 +657,7 @@ func verifyBlockLabelsAreValidIdentifiers(block *hcl.Block) hcl.Diagnostics {
 			Detail:   "block can only have a single name label",
 			Subject:  block.TypeRange.Ptr(),
 			Context:  hcl.RangeBetween(block.TypeRange, block.DefRange).Ptr(),
+			Extra:    diagnostics.CodeInvalidBlockLabel,
 		})
 
 		return diags
@@ -630,6 +681,7 @@ func verifyValidIdentifier(label string, hclRange *hcl.Range) hcl.Diagnostics {
 			Summary:  "label is invalid",
 			Detail:   "label is not a valid HCL identifier",
 			Subject:  hclRange,
+			Extra:    diagnostics.CodeInvalidBlockLabel,
 		})
 	}
 
@@ -641,6 +693,7 @@ func verifyValidIdentifier(label string, hclRange *hcl.Range) hcl.Diagnostics {
 			Summary:  "label is invalid",
 			Detail:   "label is not a valid enos identifier",
 			Subject:  hclRange,
+			Extra:    diagnostics.CodeInvalidBlockLabel,
 		})
 	}
 
@@ -659,6 +712,7 @@ func verifyBlockHasNLabels(block *hcl.Block, n int) hcl.Diagnostics {
 			Detail:   fmt.Sprintf("block has %d labels but required %d", len(block.Labels), n),
 			Subject:  block.TypeRange.Ptr(),
 			Context:  block.DefRange.Ptr(),
+			Extra:    diagnostics.CodeInvalidBlock,
 		})
 	}
 
@@ -0,0 +1,334 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"bytes"
+	"regexp"
+	"slices"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// canonicalBlockOrder is the order of top-level blocks in canonically formatted flight plans.
+var canonicalBlockOrder = []string{
+	blockTypeInclude,
+	blockTypeImport,
+	blockTypeTerraformCLI,
+	blockTypeTerraformSetting,
+	blockTypeProvider,
+	blockTypeModule,
+	blockTypeVariable,
+	blockTypeGlobals,
+	blockTypeQuality,
+	blockTypeSample,
+	blockTypeScenario,
+}
+
+// formatBlankLine matches blank lines between attributes.
+var formatBlankLine = regexp.MustCompile(`\n[ \t]*\r?\n[ \t]*[^\s]`)
+
+// formatEdit is a replacement of a byte range in the source.
+type formatEdit struct {
+	start int
+	end   int
+	text  string
+}
+
+// FormatCanonical formats the flight plan source into its canonical form. In addition to the
+// normal HCL formatting it orders top-level blocks, sorts matrix values and verifies, aligns
+// step variables, and normalizes depends_on references. Comments are always preserved. Any
+// expressions that contain comments are left as-is.
+func FormatCanonical(path string, src []byte) ([]byte, hcl.Diagnostics) {
+	src, diags := formatCanonicalBlockOrder(path, src)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	src, moreDiags := formatCanonicalBlocks(path, src)
+	diags = diags.Extend(moreDiags)
+	if moreDiags.HasErrors() {
+		return nil, diags
+	}
+
+	return hclwrite.Format(src), diags
+}
+
+// formatCanonicalBlockOrder orders the top-level blocks. Comments that precede a block are moved
+// with it. Blocks of the same type retain their relative order and blocks that are not known are
+// placed last.
+func formatCanonicalBlockOrder(path string, src []byte) ([]byte, hcl.Diagnostics) {
+	file, diags := hclsyntax.ParseConfig(src, path, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return src, diags
+	}
+
+	type item struct {
+		rank  int
+		attr  bool
+		rng   hcl.Range
+		text  string
+		blank bool
+	}
+
+	items := []*item{}
+	for _, attr := range body.Attributes {
+		items = append(items, &item{rank: -1, attr: true, rng: attr.SrcRange})
+	}
+	for _, block := range body.Blocks {
+		rank := slices.Index(canonicalBlockOrder, block.Type)
+		if rank < 0 {
+			rank = len(canonicalBlockOrder)
+		}
+		items = append(items, &item{rank: rank, rng: block.Range()})
+	}
+	if len(items) < 2 {
+		return src, diags
+	}
+
+	slices.SortFunc(items, func(a, b *item) int {
+		return a.rng.Start.Byte - b.rng.Start.Byte
+	})
+
+	sorted := slices.IsSortedFunc(items, func(a, b *item) int {
+		return a.rank - b.rank
+	})
+	if sorted {
+		return src, diags
+	}
+
+	// Split the source into the segments that belong to each item. Each segment starts after
+	// the previous item and ends at the end of the line the item ends on so that leading and
+	// trailing comments are kept with the item.
+	prev := 0
+	header := ""
+	for i, it := range items {
+		end := it.rng.End.Byte
+		if nl := bytes.IndexByte(src[end:], '\n'); nl >= 0 {
+			end += nl + 1
+		} else {
+			end = len(src)
+		}
+
+		seg := string(src[prev:end])
+		if i == 0 {
+			// Anything before the comments that are attached to the first item, e.g. a license
+			// header, stays at the top of the file.
+			lead := it.rng.Start.Byte - prev
+			if idx := strings.LastIndex(seg[:lead], "\n\n"); idx >= 0 {
+				header = strings.TrimSpace(seg[:idx])
+				seg = seg[idx:]
+			}
+		}
+
+		it.blank = strings.HasPrefix(strings.TrimLeft(seg, " \t\r"), "\n")
+		it.text = strings.TrimSpace(seg)
+		prev = end
+	}
+	tail := strings.TrimSpace(string(src[prev:]))
+
+	slices.SortStableFunc(items, func(a, b *item) int {
+		return a.rank - b.rank
+	})
+
+	out := strings.Builder{}
+	if header != "" {
+		out.WriteString(header + "\n\n")
+	}
+	for i, it := range items {
+		if i > 0 {
+			if it.attr && items[i-1].attr && !it.blank {
+				out.WriteString("\n")
+			} else {
+				out.WriteString("\n\n")
+			}
+		}
+		out.WriteString(it.text)
+	}
+	out.WriteString("\n")
+	if tail != "" {
+		out.WriteString("\n" + tail + "\n")
+	}
+
+	return []byte(out.String()), diags
+}
+
+// formatCanonicalBlocks sorts and normalizes the contents of blocks.
+func formatCanonicalBlocks(path string, src []byte) ([]byte, hcl.Diagnostics) {
+	file, diags := hclsyntax.ParseConfig(src, path, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return src, diags
+	}
+
+	edits := formatCanonicalBody(src, body, nil)
+	if len(edits) < 1 {
+		return src, diags
+	}
+
+	// Apply our edits from the end so that the byte ranges of earlier edits are still valid.
+	slices.SortFunc(edits, func(a, b *formatEdit) int {
+		return b.start - a.start
+	})
+
+	out := slices.Clone(src)
+	for _, edit := range edits {
+		out = slices.Concat(out[:edit.start], []byte(edit.text), out[edit.end:])
+	}
+
+	return out, diags
+}
+
+func formatCanonicalBody(src []byte, body *hclsyntax.Body, parent *hclsyntax.Block) []*formatEdit {
+	edits := []*formatEdit{}
+
+	if parent != nil {
+		switch parent.Type {
+		case blockTypeMatrix, blockTypeMatrixInclude, blockTypeMatrixExclude:
+			for _, attr := range body.Attributes {
+				if edit, ok := formatSortTuple(src, attr.Expr, nil); ok {
+					edits = append(edits, edit)
+				}
+			}
+		case blockTypeScenarioStep:
+			if attr, ok := body.Attributes["verifies"]; ok {
+				if edit, ok := formatSortTuple(src, attr.Expr, nil); ok {
+					edits = append(edits, edit)
+				}
+			}
+			if attr, ok := body.Attributes["depends_on"]; ok {
+				if edit, ok := formatSortTuple(src, attr.Expr, formatDependsOnElement); ok {
+					edits = append(edits, edit)
+				}
+			}
+		case blockTypeVariables:
+			if edit, ok := formatAlignAttributes(src, parent); ok {
+				edits = append(edits, edit)
+			}
+		}
+	}
+
+	for _, block := range body.Blocks {
+		edits = append(edits, formatCanonicalBody(src, block.Body, block)...)
+	}
+
+	return edits
+}
+
+// formatSortTuple returns an edit that sorts the elements of a tuple. If given, the normalize
+// func is used to normalize the element source before sorting.
+func formatSortTuple(
+	src []byte,
+	expr hclsyntax.Expression,
+	normalize func(hclsyntax.Expression, string) string,
+) (*formatEdit, bool) {
+	tuple, ok := expr.(*hclsyntax.TupleConsExpr)
+	if !ok || len(tuple.Exprs) < 1 {
+		return nil, false
+	}
+
+	rng := tuple.SrcRange
+	orig := src[rng.Start.Byte:rng.End.Byte]
+	if formatHasComments(orig) {
+		return nil, false
+	}
+
+	elems := make([]string, 0, len(tuple.Exprs))
+	sorted := make([]string, 0, len(tuple.Exprs))
+	for _, elm := range tuple.Exprs {
+		text := string(src[elm.Range().Start.Byte:elm.Range().End.Byte])
+		elems = append(elems, text)
+		if normalize != nil {
+			text = normalize(elm, text)
+		}
+		sorted = append(sorted, text)
+	}
+
+	slices.Sort(sorted)
+	if slices.Equal(elems, sorted) {
+		return nil, false
+	}
+
+	text := "[" + strings.Join(sorted, ", ") + "]"
+	if bytes.Contains(orig, []byte("\n")) {
+		text = "[\n" + strings.Join(sorted, ",\n") + ",\n]"
+	}
+
+	return &formatEdit{start: rng.Start.Byte, end: rng.End.Byte, text: text}, true
+}
+
+// formatDependsOnElement normalizes depends_on elements that refer to a step by name into step
+// references.
+func formatDependsOnElement(expr hclsyntax.Expression, text string) string {
+	tmpl, ok := expr.(*hclsyntax.TemplateExpr)
+	if !ok || len(tmpl.Parts) != 1 {
+		return text
+	}
+
+	lit, ok := tmpl.Parts[0].(*hclsyntax.LiteralValueExpr)
+	if !ok || !lit.Val.IsKnown() || lit.Val.IsNull() {
+		return text
+	}
+
+	name := lit.Val.AsString()
+	if !hclsyntax.ValidIdentifier(name) {
+		return text
+	}
+
+	return "step." + name
+}
+
+// formatAlignAttributes returns an edit that removes blank lines between the attributes of a
+// block so that the formatter aligns all of them.
+func formatAlignAttributes(src []byte, block *hclsyntax.Block) (*formatEdit, bool) {
+	if len(block.Body.Blocks) > 0 || len(block.Body.Attributes) < 2 {
+		return nil, false
+	}
+
+	start := block.OpenBraceRange.End.Byte
+	end := block.CloseBraceRange.Start.Byte
+	inner := src[start:end]
+	if !formatBlankLine.Match(inner) || formatHasComments(inner) {
+		return nil, false
+	}
+
+	attrs := make([]*hclsyntax.Attribute, 0, len(block.Body.Attributes))
+	for _, attr := range block.Body.Attributes {
+		attrs = append(attrs, attr)
+	}
+	slices.SortFunc(attrs, func(a, b *hclsyntax.Attribute) int {
+		return a.SrcRange.Start.Byte - b.SrcRange.Start.Byte
+	})
+
+	lines := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		lines = append(lines, string(src[attr.SrcRange.Start.Byte:attr.SrcRange.End.Byte]))
+	}
+
+	return &formatEdit{start: start, end: end, text: "\n" + strings.Join(lines, "\n") + "\n"}, true
+}
+
+// formatHasComments returns whether or not the source contains comments.
+func formatHasComments(src []byte) bool {
+	tokens, _ := hclsyntax.LexConfig(src, "", hcl.InitialPos)
+	for _, token := range tokens {
+		if token.Type == hclsyntax.TokenComment {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatCanonical(t *testing.T) {
+	t.Parallel()
+
+	for desc, test := range map[string]struct {
+		in       string
+		expected string
+	}{
+		"block order": {
+			in: `# Copyright header
+
+scenario "test" {
+  step "one" {
+    module = module.foo
+  }
+}
+
+# The foo module
+module "foo" {
+  source = "./foo" # inline
+}
+
+quality "bar" {
+  description = "bar"
+}
+
+terraform_cli "default" {
+}
+
+unknown "block" {
+}
+
+module "bar" {
+  source = "./bar"
+}
+`,
+			expected: `# Copyright header
+
+terraform_cli "default" {
+}
+
+# The foo module
+module "foo" {
+  source = "./foo" # inline
+}
+
+module "bar" {
+  source = "./bar"
+}
+
+quality "bar" {
+  description = "bar"
+}
+
+scenario "test" {
+  step "one" {
+    module = module.foo
+  }
+}
+
+unknown "block" {
+}
+`,
+		},
+		"matrix values": {
+			in: `scenario "test" {
+  matrix {
+    distro = ["ubuntu", "rhel", "amzn"]
+    arch = [
+      "arm64",
+      "amd64",
+    ]
+    edition = ["oss", /* keep */ "ent"]
+
+    exclude {
+      distro = ["rhel", "amzn"]
+    }
+  }
+}
+`,
+			expected: `scenario "test" {
+  matrix {
+    distro = ["amzn", "rhel", "ubuntu"]
+    arch = [
+      "amd64",
+      "arm64",
+    ]
+    edition = ["oss", /* keep */ "ent"]
+
+    exclude {
+      distro = ["amzn", "rhel"]
+    }
+  }
+}
+`,
+		},
+		"steps": {
+			in: `scenario "test" {
+  step "three" {
+    module     = module.foo
+    verifies   = [quality.b, quality.a]
+    depends_on = ["two", step.one]
+
+    variables {
+      foo = step.one.foo
+
+      longer_name = step.two.bar
+    }
+  }
+}
+`,
+			expected: `scenario "test" {
+  step "three" {
+    module     = module.foo
+    verifies   = [quality.a, quality.b]
+    depends_on = [step.one, step.two]
+
+    variables {
+      foo         = step.one.foo
+      longer_name = step.two.bar
+    }
+  }
+}
+`,
+		},
+		"variables with comments": {
+			in: `scenario "test" {
+  step "two" {
+    variables {
+      foo = step.one.foo
+
+      # The bar
+      longer_name = step.one.bar
+    }
+  }
+}
+`,
+			expected: `scenario "test" {
+  step "two" {
+    variables {
+      foo = step.one.foo
+
+      # The bar
+      longer_name = step.one.bar
+    }
+  }
+}
+`,
+		},
+		"vars file": {
+			in: `foo = "bar"
+baz = "qux"
+
+another = "one"
+`,
+			expected: `foo = "bar"
+baz = "qux"
+
+another = "one"
+`,
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			out, diags := FormatCanonical("enos.hcl", []byte(test.in))
+			require.False(t, diags.HasErrors(), diags.Error())
+			require.Equal(t, test.expected, string(out))
+
+			// Formatting must be idempotent
+			again, diags := FormatCanonical("enos.hcl", out)
+			require.False(t, diags.HasErrors(), diags.Error())
+			require.Equal(t, string(out), string(again))
+		})
+	}
+}
@@ -0,0 +1,639 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+const blockTypeImport = "import"
+
+// importChecksumPrefix is the only supported checksum algorithm for archive sources.
+const importChecksumPrefix = "sha256:"
+
+// importMaxArchiveSize is the largest archive we'll download for an import source.
+const importMaxArchiveSize = 64 << 20
+
+// importMaxExtractSize is the total number of bytes we'll extract from an import archive.
+const importMaxExtractSize = 512 << 20
+
+// importMaxExtractEntries is the largest number of entries we'll extract from an import archive.
+const importMaxExtractEntries = 10000
+
+var importSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "source", Required: true},
+		{Name: "checksum"},
+	},
+}
+
+// importLibrarySchema is the schema of the flight plan files of an imported library. Any other
+// blocks are ignored so that a library can also be a flight plan in its own right.
+var importLibrarySchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: blockTypeImport, LabelNames: []string{attrLabelNameDefault}},
+		{Type: blockTypeGlobals},
+		{Type: blockTypeModule, LabelNames: []string{attrLabelNameDefault}},
+		{Type: blockTypeProvider, LabelNames: []string{attrLabelNameType, attrLabelNameAlias}},
+		{Type: blockTypeQuality, LabelNames: []string{attrLabelNameDefault}},
+	},
+}
+
+// Import is a shared flight plan library that has been imported into a namespace. The modules,
+// providers, and qualities of the library are available in the eval context as
+// import.<name>.module.<name>, import.<name>.provider.<type>.<alias>, and
+// import.<name>.quality.<name>.
+//
+// To make them usable in scenarios, imported modules and qualities are named with the namespace
+// prefix, e.g. "shared.create_vpc", and imported providers are aliased with the namespace prefix,
+// e.g. "shared_east".
+type Import struct {
+	Name      string
+	Source    string
+	Checksum  string
+	Dir       string
+	Modules   []*Module
+	Providers []*Provider
+	Qualities []*Quality
+	Imports   []*Import
+	// prefix is the full namespace of the import, e.g. "shared.network" for nested imports.
+	prefix string
+}
+
+// importDecoder decodes import blocks and the libraries they refer to.
+type importDecoder struct {
+	parser   *hclparse.Parser
+	cacheDir string
+	funcs    *hcl.EvalContext
+}
+
+// decodeImports decodes the top-level import blocks.
+func (d *Decoder) decodeImports(ctx context.Context, fp *FlightPlan, evalCtx *hcl.EvalContext) hcl.Diagnostics {
+	dec := &importDecoder{
+		parser:   d.FPParser,
+		cacheDir: filepath.Join(fp.BaseDir, ".enos", "imports"),
+		funcs:    d.baseEvalContext(),
+	}
+
+	// Keep track of the directories that we're importing so that we can detect cycles.
+	root, err := filepath.EvalSymlinks(fp.BaseDir)
+	if err != nil {
+		root = fp.BaseDir
+	}
+
+	imports, ns, diags := dec.decodeBlocks(
+		ctx, fp.BodyContent.Blocks.OfType(blockTypeImport), "", []string{root},
+	)
+	fp.Imports = imports
+	evalCtx.Variables["import"] = ns
+	if diags.HasErrors() {
+		return diags
+	}
+
+	// Qualities have already been decoded so we'll add our imported qualities now. Modules and
+	// providers are added when they are decoded.
+	qualities := map[string]cty.Value{}
+	if val, ok := evalCtx.Variables["quality"]; ok && !val.IsNull() && val.CanIterateElements() {
+		qualities = val.AsValueMap()
+		if qualities == nil {
+			qualities = map[string]cty.Value{}
+		}
+	}
+	for _, imp := range allImports(fp.Imports) {
+		for _, quality := range imp.Qualities {
+			qualities[quality.Name] = quality.ToCtyValue()
+		}
+	}
+	evalCtx.Variables["quality"] = cty.ObjectVal(qualities)
+
+	return diags
+}
+
+// decodeBlocks decodes import blocks and returns the imports and the namespace value.
+func (d *importDecoder) decodeBlocks(
+	ctx context.Context,
+	blocks hcl.Blocks,
+	prefix string,
+	stack []string,
+) ([]*Import, cty.Value, hcl.Diagnostics) {
+	diags := hcl.Diagnostics{}
+	imports := []*Import{}
+	vals := map[string]cty.Value{}
+
+	for _, block := range blocks {
+		moreDiags := verifyBlockLabelsAreValidIdentifiers(block)
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+
+		if _, ok := vals[block.Labels[0]]; ok {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "import has previously been defined",
+				Detail:   fmt.Sprintf("import %s has already been defined", block.Labels[0]),
+				Subject:  block.LabelRanges[0].Ptr(),
+				Context:  block.DefRange.Ptr(),
+				Extra:    diagnostics.CodeRedeclaredBlock,
+			})
+
+			continue
+		}
+
+		imp, moreDiags := d.decode(ctx, block, prefix, stack)
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+
+		imports = append(imports, imp)
+		vals[imp.Name] = imp.ToCtyValue()
+	}
+
+	return imports, cty.ObjectVal(vals), diags
+}
+
+// decode decodes a single import block and the library that it refers to.
+func (d *importDecoder) decode(
+	ctx context.Context,
+	block *hcl.Block,
+	prefix string,
+	stack []string,
+) (*Import, hcl.Diagnostics) {
+	imp := &Import{Name: block.Labels[0]}
+
+	content, diags := block.Body.Content(importSchema)
+	diags = diagnostics.WithCode(diags, diagnostics.CodeInvalidImport)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	for _, field := range []struct {
+		name string
+		dst  *string
+	}{
+		{"source", &imp.Source},
+		{"checksum", &imp.Checksum},
+	} {
+		name, dst := field.name, field.dst
+		attr, ok := content.Attributes[name]
+		if !ok {
+			continue
+		}
+
+		val, moreDiags := attr.Expr.Value(d.funcs)
+		diags = diags.Extend(diagnostics.WithCode(moreDiags, diagnostics.CodeInvalidImport))
+		if moreDiags.HasErrors() {
+			return nil, diags
+		}
+
+		if val.IsNull() || !val.IsKnown() || !val.Type().Equals(cty.String) || val.AsString() == "" {
+			return nil, diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "invalid value",
+				Detail:   name + " must be a non-empty string",
+				Subject:  attr.Expr.Range().Ptr(),
+				Context:  attr.Range.Ptr(),
+				Extra:    diagnostics.CodeInvalidImport,
+			})
+		}
+		*dst = val.AsString()
+	}
+
+	importErr := func(err error) hcl.Diagnostics {
+		attr := content.Attributes["source"]
+
+		return diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "unable to import " + imp.Name,
+			Detail:   err.Error(),
+			Subject:  attr.Expr.Range().Ptr(),
+			Context:  block.DefRange.Ptr(),
+			Extra:    diagnostics.CodeInvalidImport,
+		})
+	}
+
+	var err error
+	imp.Dir, err = d.resolve(ctx, filepath.Dir(block.DefRange.Filename), imp.Source, imp.Checksum)
+	if err != nil {
+		return nil, importErr(err)
+	}
+
+	if slices.Contains(stack, imp.Dir) {
+		cycle := slices.Clone(stack[slices.Index(stack, imp.Dir):])
+		cycle = append(cycle, imp.Dir)
+
+		return nil, diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "import cycle",
+			Detail:   fmt.Sprintf("import %s creates an import cycle: %s", imp.Name, strings.Join(cycle, " -> ")),
+			Subject:  block.DefRange.Ptr(),
+			Extra:    diagnostics.CodeImportCycle,
+		})
+	}
+
+	files, err := FindFlightPlanFiles(imp.Dir)
+	if err != nil {
+		return nil, importErr(err)
+	}
+	if len(files) == 0 {
+		return nil, importErr(fmt.Errorf("no flight plan files found in %s", imp.Dir))
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	hclFiles := []*hcl.File{}
+	for _, name := range names {
+		file, moreDiags := d.parser.ParseHCL(files[name], name)
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+		hclFiles = append(hclFiles, file)
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	libContent, _, moreDiags := hcl.MergeFiles(hclFiles).PartialContent(importLibrarySchema)
+	diags = diags.Extend(moreDiags)
+	if moreDiags.HasErrors() {
+		return nil, diags
+	}
+
+	imp.prefix = prefix + imp.Name
+
+	return imp, diags.Extend(d.decodeLibrary(ctx, imp, libContent, append(stack, imp.Dir)))
+}
+
+// decodeLibrary decodes the contents of an imported library.
+func (d *importDecoder) decodeLibrary(
+	ctx context.Context,
+	imp *Import,
+	content *hcl.BodyContent,
+	stack []string,
+) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	// Libraries have their own eval context with only their own globals and imports.
+	libFP := &FlightPlan{BodyContent: content}
+	evalCtx := d.funcs.NewChild()
+	evalCtx.Variables = map[string]cty.Value{}
+	evalCtx.Functions = d.funcs.Functions
+
+	diags = diags.Extend(libFP.decodeGlobals(evalCtx))
+	if diags.HasErrors() {
+		return diags
+	}
+
+	imports, ns, moreDiags := d.decodeBlocks(ctx, content.Blocks.OfType(blockTypeImport), imp.prefix+".", stack)
+	diags = diags.Extend(moreDiags)
+	if moreDiags.HasErrors() {
+		return diags
+	}
+	imp.Imports = imports
+	evalCtx.Variables["import"] = ns
+
+	for _, block := range content.Blocks.OfType(blockTypeModule) {
+		moreDiags := verifyBlockLabelsAreValidIdentifiers(block)
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+
+		module := NewModule()
+		moreDiags = module.decode(block, evalCtx.NewChild())
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+
+		// Relative module sources are relative to the library
+		if strings.HasPrefix(module.Source, "./") || strings.HasPrefix(module.Source, "../") {
+			module.Source = filepath.Join(imp.Dir, module.Source)
+		}
+
+		module.Name = imp.prefix + "." + module.Name
+		imp.Modules = append(imp.Modules, module)
+	}
+
+	for _, block := range content.Blocks.OfType(blockTypeProvider) {
+		moreDiags := verifyBlockLabelsAreValidIdentifiers(block)
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+
+		provider := NewProvider()
+		moreDiags = provider.decode(block, evalCtx.NewChild())
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+
+		provider.Alias = imp.aliasPrefix() + provider.Alias
+		imp.Providers = append(imp.Providers, provider)
+	}
+
+	for _, block := range content.Blocks.OfType(blockTypeQuality) {
+		moreDiags := verifyBlockLabelsAreValidIdentifiers(block)
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+
+		quality := NewQuality()
+		moreDiags = quality.decode(block, evalCtx.NewChild())
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+
+		quality.Name = imp.prefix + "." + quality.Name
+		imp.Qualities = append(imp.Qualities, quality)
+	}
+
+	return diags
+}
+
+// resolve resolves the import source to a local directory. Sources can be local directories,
+// local archives, or http(s) URLs of archives.
+func (d *importDecoder) resolve(ctx context.Context, dir string, source string, checksum string) (string, error) {
+	if checksum != "" && !strings.HasPrefix(checksum, importChecksumPrefix) {
+		return "", fmt.Errorf("checksum %s must be in the form %s<hex digest>", checksum, importChecksumPrefix)
+	}
+
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		if checksum == "" {
+			return "", errors.New("a checksum is required for remote archive sources")
+		}
+
+		return d.extract(checksum, func() ([]byte, error) {
+			return downloadImportArchive(ctx, source)
+		})
+	}
+
+	path := source
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+
+	path, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	if info.IsDir() {
+		if checksum != "" {
+			return "", errors.New("checksums are only supported for archive sources")
+		}
+
+		return path, nil
+	}
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	if checksum == "" {
+		checksum = importChecksumPrefix + sha256Hex(src)
+	}
+
+	return d.extract(checksum, func() ([]byte, error) {
+		return src, nil
+	})
+}
+
+// extract verifies the archive checksum and extracts it into the import cache. Archives are
+// cached by their checksum so that they only need to be fetched and extracted once.
+func (d *importDecoder) extract(checksum string, fetch func() ([]byte, error)) (string, error) {
+	digest := strings.ToLower(strings.TrimPrefix(checksum, importChecksumPrefix))
+	if _, err := hex.DecodeString(digest); err != nil || len(digest) != sha256.Size*2 {
+		return "", fmt.Errorf("checksum %s is not a valid sha256 digest", checksum)
+	}
+
+	dest := filepath.Join(d.cacheDir, digest)
+	if info, err := os.Stat(dest); err == nil && info.IsDir() {
+		return filepath.EvalSymlinks(dest)
+	}
+
+	src, err := fetch()
+	if err != nil {
+		return "", err
+	}
+
+	if got := sha256Hex(src); got != digest {
+		return "", fmt.Errorf("archive checksum %s%s does not match expected checksum %s", importChecksumPrefix, got, checksum)
+	}
+
+	if err := os.MkdirAll(d.cacheDir, 0o755); err != nil {
+		return "", err
+	}
+
+	tmp, err := os.MkdirTemp(d.cacheDir, digest+"-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := extractImportArchive(src, tmp, importMaxExtractSize, importMaxExtractEntries); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(tmp, dest); err != nil {
+		// Somebody else might have extracted the same archive concurrently
+		if info, statErr := os.Stat(dest); statErr == nil && info.IsDir() {
+			return filepath.EvalSymlinks(dest)
+		}
+
+		return "", err
+	}
+
+	return filepath.EvalSymlinks(dest)
+}
+
+// extractImportArchive extracts a tar archive, which may be gzip compressed, into the directory.
+// Extraction fails if the archive contains more than maxSize bytes or maxEntries entries, which
+// protects us against archives that decompress to far more than their own size.
+func extractImportArchive(src []byte, dir string, maxSize int64, maxEntries int) error {
+	var r io.Reader = bytes.NewReader(src)
+	if len(src) > 2 && src[0] == 0x1f && src[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	remaining := maxSize
+	for entries := 0; ; entries++ {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading archive: %w", err)
+		}
+
+		if entries >= maxEntries {
+			return fmt.Errorf("archive exceeds the maximum of %d entries", maxEntries)
+		}
+
+		name := filepath.Clean(filepath.FromSlash(hdr.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("archive contains invalid path %s", hdr.Name)
+		}
+		path := filepath.Join(dir, name)
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				return err
+			}
+
+			f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+			if err != nil {
+				return err
+			}
+
+			// Copy one byte past our budget so that we can tell when the archive exceeds it.
+			n, err := io.Copy(f, io.LimitReader(tr, remaining+1))
+			f.Close()
+			if err != nil {
+				return err
+			}
+
+			remaining -= n
+			if remaining < 0 {
+				return fmt.Errorf("archive exceeds the maximum extracted size of %d bytes", maxSize)
+			}
+		default:
+			// Links and special files are not supported in libraries
+		}
+	}
+}
+
+func downloadImportArchive(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading %s: %s", url, res.Status)
+	}
+
+	// Read one byte past the limit so that we can tell a full archive from a truncated one.
+	body, err := io.ReadAll(io.LimitReader(res.Body, importMaxArchiveSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > importMaxArchiveSize {
+		return nil, fmt.Errorf("downloading %s: archive exceeds the maximum size of %d bytes", url, importMaxArchiveSize)
+	}
+
+	return body, nil
+}
+
+func sha256Hex(src []byte) string {
+	sum := sha256.Sum256(src)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// ToCtyValue returns the import namespace as an object cty.Value.
+func (i *Import) ToCtyValue() cty.Value {
+	modules := map[string]cty.Value{}
+	for _, module := range i.Modules {
+		modules[strings.TrimPrefix(module.Name, i.prefix+".")] = module.ToCtyValue()
+	}
+
+	providers := map[string]map[string]cty.Value{}
+	for _, provider := range i.Providers {
+		if _, ok := providers[provider.Type]; !ok {
+			providers[provider.Type] = map[string]cty.Value{}
+		}
+		providers[provider.Type][strings.TrimPrefix(provider.Alias, i.aliasPrefix())] = provider.ToCtyValue()
+	}
+	providerVals := map[string]cty.Value{}
+	for pType, aliases := range providers {
+		providerVals[pType] = cty.ObjectVal(aliases)
+	}
+
+	qualities := map[string]cty.Value{}
+	for _, quality := range i.Qualities {
+		qualities[strings.TrimPrefix(quality.Name, i.prefix+".")] = quality.ToCtyValue()
+	}
+
+	imports := map[string]cty.Value{}
+	for _, imp := range i.Imports {
+		imports[imp.Name] = imp.ToCtyValue()
+	}
+
+	return cty.ObjectVal(map[string]cty.Value{
+		"module":   cty.ObjectVal(modules),
+		"provider": cty.ObjectVal(providerVals),
+		"quality":  cty.ObjectVal(qualities),
+		"import":   cty.ObjectVal(imports),
+	})
+}
+
+// aliasPrefix returns the prefix of imported provider aliases. Provider aliases must be valid
+// Terraform identifiers so the namespace is joined with underscores.
+func (i *Import) aliasPrefix() string {
+	return strings.ReplaceAll(i.prefix, ".", "_") + "_"
+}
+
+// allImports returns the imports and all of their nested imports.
+func allImports(imports []*Import) []*Import {
+	all := []*Import{}
+	for _, imp := range imports {
+		all = append(all, imp)
+		all = append(all, allImports(imp.Imports)...)
+	}
+
+	return all
+}
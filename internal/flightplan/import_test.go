@@ -0,0 +1,264 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+const testImportLibrary = `
+globals {
+  region = "us-east-1"
+}
+
+module "create_vpc" {
+  source = "./modules/create_vpc"
+  region = global.region
+}
+
+provider "aws" "east" {
+  region = global.region
+}
+
+quality "vpc_created" {
+  description = "The VPC has been created"
+}
+`
+
+const testImportScenario = `
+scenario "test" {
+  providers = [import.shared.provider.aws.east]
+
+  step "vpc" {
+    module   = import.shared.module.create_vpc
+    verifies = [import.shared.quality.vpc_created]
+  }
+}
+`
+
+func testImportArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	buf := bytes.Buffer{}
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, body := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(body)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	return buf.Bytes()
+}
+
+func testDecodeImports(t *testing.T, dir string) (*FlightPlan, hcl.Diagnostics) {
+	t.Helper()
+
+	files, err := FindFlightPlanFiles(dir)
+	require.NoError(t, err)
+
+	dec, err := NewDecoder(
+		WithDecoderBaseDir(dir),
+		WithDecoderFPFiles(files),
+		WithDecoderDecodeTarget(DecodeTargetAll),
+	)
+	require.NoError(t, err)
+	diags := dec.Parse()
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	fp, scenarioDecoder, diags := dec.Decode(context.Background())
+	if diags.HasErrors() {
+		return fp, diags
+	}
+
+	return fp, diags.Extend(scenarioDecoder.DecodeAll(context.Background(), fp))
+}
+
+func TestDecodeImports(t *testing.T) {
+	t.Parallel()
+
+	t.Run("local directory", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		testWriteIncludeFiles(t, dir, map[string]string{
+			"shared-enos/enos.hcl": testImportLibrary,
+			"project/enos.hcl": `
+import "shared" {
+  source = "../shared-enos"
+}
+` + testImportScenario,
+		})
+
+		fp, diags := testDecodeImports(t, filepath.Join(dir, "project"))
+		require.False(t, diags.HasErrors(), diags.Error())
+		require.Len(t, fp.Imports, 1)
+
+		imp := fp.Imports[0]
+		require.Equal(t, "shared", imp.Name)
+		require.Len(t, imp.Modules, 1)
+		require.Equal(t, "shared.create_vpc", imp.Modules[0].Name)
+		require.True(t, filepath.IsAbs(imp.Modules[0].Source))
+		require.Len(t, imp.Providers, 1)
+		require.Equal(t, "shared_east", imp.Providers[0].Alias)
+		require.Len(t, imp.Qualities, 1)
+		require.Equal(t, "shared.vpc_created", imp.Qualities[0].Name)
+
+		scenarios := fp.Scenarios()
+		require.Len(t, scenarios, 1)
+		require.Len(t, scenarios[0].Steps, 1)
+		require.Equal(t, "shared.create_vpc", scenarios[0].Steps[0].Module.Name)
+		require.Equal(t, "shared_east", scenarios[0].Providers[0].Alias)
+	})
+
+	t.Run("archive with checksum", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		archive := testImportArchive(t, map[string]string{"enos.hcl": testImportLibrary})
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "shared.tar.gz"), archive, 0o644))
+		testWriteIncludeFiles(t, dir, map[string]string{
+			"project/enos.hcl": `
+import "shared" {
+  source   = "../shared.tar.gz"
+  checksum = "sha256:` + sha256Hex(archive) + `"
+}
+` + testImportScenario,
+		})
+
+		fp, diags := testDecodeImports(t, filepath.Join(dir, "project"))
+		require.False(t, diags.HasErrors(), diags.Error())
+		require.Len(t, fp.Imports, 1)
+		require.Len(t, fp.Scenarios(), 1)
+	})
+
+	for desc, test := range map[string]struct {
+		files map[string]string
+		code  diagnostics.Code
+	}{
+		"archive with bad checksum": {
+			files: map[string]string{
+				"project/enos.hcl": `
+import "shared" {
+  source   = "../shared.tar.gz"
+  checksum = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+}
+`,
+			},
+			code: diagnostics.CodeInvalidImport,
+		},
+		"remote archive without checksum": {
+			files: map[string]string{
+				"project/enos.hcl": `
+import "shared" {
+  source = "https://example.com/shared.tar.gz"
+}
+`,
+			},
+			code: diagnostics.CodeInvalidImport,
+		},
+		"unknown source": {
+			files: map[string]string{
+				"project/enos.hcl": `
+import "shared" {
+  source = "../missing"
+}
+`,
+			},
+			code: diagnostics.CodeInvalidImport,
+		},
+		"cycle": {
+			files: map[string]string{
+				"project/enos.hcl": `
+import "one" {
+  source = "../one"
+}
+`,
+				"one/enos.hcl": `
+import "two" {
+  source = "../two"
+}
+`,
+				"two/enos.hcl": `
+import "one" {
+  source = "../one"
+}
+`,
+			},
+			code: diagnostics.CodeImportCycle,
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(
+				filepath.Join(dir, "shared.tar.gz"),
+				testImportArchive(t, map[string]string{"enos.hcl": testImportLibrary}),
+				0o644,
+			))
+			testWriteIncludeFiles(t, dir, test.files)
+
+			_, diags := testDecodeImports(t, filepath.Join(dir, "project"))
+			require.True(t, diags.HasErrors())
+			require.Equal(t, test.code, diags[0].Extra, diags.Error())
+		})
+	}
+}
+
+func TestExtractImportArchiveRejectsInvalidPaths(t *testing.T) {
+	t.Parallel()
+
+	archive := testImportArchive(t, map[string]string{"../escape.hcl": `module "bad" {}`})
+	require.Error(t, extractImportArchive(archive, t.TempDir(), importMaxExtractSize, importMaxExtractEntries))
+}
+
+func TestExtractImportArchiveLimits(t *testing.T) {
+	t.Parallel()
+
+	archive := testImportArchive(t, map[string]string{
+		"one.hcl": strings.Repeat("a", 10),
+		"two.hcl": strings.Repeat("b", 10),
+	})
+
+	for desc, test := range map[string]struct {
+		maxSize    int64
+		maxEntries int
+		err        string
+	}{
+		"within limits":  {20, 2, ""},
+		"too large":      {19, 2, "maximum extracted size"},
+		"too many files": {20, 1, "maximum of 1 entries"},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := extractImportArchive(archive, t.TempDir(), test.maxSize, test.maxEntries)
+			if test.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.err)
+			}
+		})
+	}
+}
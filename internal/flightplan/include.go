@@ -0,0 +1,353 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// blockTypeInclude is the top-level include block. It shares its name with the matrix include
+// block but is only valid at the top-level.
+const blockTypeInclude = "include"
+
+var includeSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "paths", Required: true},
+	},
+}
+
+// FindFlightPlanFiles scans a directory for flight plan files, along with any files that they
+// include, and returns the loaded raw files.
+//
+// Files can include other files with a top-level include block. Each path is a glob pattern
+// relative to the directory of the file that includes it. In addition to the usual glob
+// patterns, "**" matches any number of directories.
+//
+//	include {
+//	  paths = ["enos/scenarios/*.hcl", "enos/modules/**/*.hcl"]
+//	}
+func FindFlightPlanFiles(dir string) (RawFiles, error) {
+	files, err := FindRawFiles(dir, FlightPlanFileNamePattern)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := make([]string, 0, len(files))
+	for name := range files {
+		queue = append(queue, name)
+	}
+	slices.Sort(queue)
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		for _, pattern := range includePatterns(name, files[name]) {
+			if _, err := includePatternSegments(pattern); err != nil {
+				// Invalid patterns will be reported when the flight plan is decoded
+				continue
+			}
+
+			matches, err := findIncludedFiles(filepath.Dir(name), pattern)
+			if err != nil {
+				return nil, fmt.Errorf("including %s from %s: %w", pattern, name, err)
+			}
+
+			for _, match := range matches {
+				if _, ok := files[match]; ok {
+					continue
+				}
+
+				bytes, err := os.ReadFile(match)
+				if err != nil {
+					return nil, err
+				}
+				files[match] = bytes
+				queue = append(queue, match)
+			}
+		}
+	}
+
+	return files, nil
+}
+
+// includePatterns returns the include path patterns of a flight plan file. Any invalid
+// configuration is ignored here as it will be reported when the flight plan is decoded.
+func includePatterns(name string, src []byte) []string {
+	file, diags := hclsyntax.ParseConfig(src, name, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil
+	}
+
+	patterns := []string{}
+	for _, block := range body.Blocks {
+		if block.Type != blockTypeInclude {
+			continue
+		}
+
+		attr, ok := block.Body.Attributes["paths"]
+		if !ok {
+			continue
+		}
+
+		paths, diags := decodeIncludePaths(attr.Expr)
+		if diags.HasErrors() {
+			continue
+		}
+		patterns = append(patterns, paths...)
+	}
+
+	return patterns
+}
+
+// findIncludedFiles returns the paths of all files in the directory that match the pattern.
+func findIncludedFiles(dir string, pattern string) ([]string, error) {
+	segments, err := includePatternSegments(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start walking at the longest static prefix of the pattern.
+	root := dir
+	for len(segments) > 0 && !hasGlobMeta(segments[0]) {
+		root = filepath.Join(root, segments[0])
+		segments = segments[1:]
+	}
+
+	info, err := os.Stat(root)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		if len(segments) == 0 {
+			return []string{root}, nil
+		}
+
+		return nil, nil
+	}
+
+	matches := []string{}
+	err = filepath.WalkDir(root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if name == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, name)
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			// Don't descend into hidden directories, e.g. the .enos out directory.
+			if strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if matchIncludeSegments(segments, strings.Split(filepath.ToSlash(rel), "/")) {
+			matches = append(matches, name)
+		}
+
+		return nil
+	})
+
+	return matches, err
+}
+
+// includePatternSegments validates the pattern and splits it into path segments.
+func includePatternSegments(pattern string) ([]string, error) {
+	if pattern == "" {
+		return nil, errors.New("pattern cannot be empty")
+	}
+
+	if filepath.IsAbs(pattern) {
+		return nil, errors.New("pattern must be relative to the including file")
+	}
+
+	segments := strings.Split(path.Clean(filepath.ToSlash(pattern)), "/")
+	for _, segment := range segments {
+		if segment == "**" {
+			continue
+		}
+
+		if _, err := path.Match(segment, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	return segments, nil
+}
+
+// matchIncludeSegments matches path segments against pattern segments. A "**" pattern
+// segment matches zero or more path segments.
+func matchIncludeSegments(pattern []string, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(name); i++ {
+			if matchIncludeSegments(pattern[1:], name[i:]) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if len(name) == 0 {
+		return false
+	}
+
+	if ok, _ := path.Match(pattern[0], name[0]); !ok {
+		return false
+	}
+
+	return matchIncludeSegments(pattern[1:], name[1:])
+}
+
+func hasGlobMeta(segment string) bool {
+	return strings.ContainsAny(segment, `*?[\`)
+}
+
+// decodeIncludePaths decodes the paths attribute of an include block.
+func decodeIncludePaths(expr hcl.Expression) ([]string, hcl.Diagnostics) {
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() {
+		return nil, diagnostics.WithCode(diags, diagnostics.CodeInvalidInclude)
+	}
+
+	if val.IsNull() || !val.IsWhollyKnown() || !val.CanIterateElements() || val.Type().IsMapType() || val.Type().IsObjectType() {
+		return nil, diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "invalid include paths",
+			Detail:   "include paths must be a list of static glob patterns",
+			Subject:  expr.Range().Ptr(),
+			Extra:    diagnostics.CodeInvalidInclude,
+		})
+	}
+
+	paths := []string{}
+	for _, v := range val.AsValueSlice() {
+		if v.IsNull() || !v.Type().Equals(cty.String) {
+			return nil, diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "invalid include paths",
+				Detail:   "include paths must be a list of static glob patterns",
+				Subject:  expr.Range().Ptr(),
+				Extra:    diagnostics.CodeInvalidInclude,
+			})
+		}
+		paths = append(paths, v.AsString())
+	}
+
+	return paths, diags
+}
+
+// decodeIncludes validates the top-level include blocks. The included files have already been
+// loaded when the flight plan files were found, here we ensure that include blocks are valid and
+// that every pattern has matched at least one file.
+func (fp *FlightPlan) decodeIncludes() hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	names := make([]string, 0, len(fp.Files))
+	for name := range fp.Files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, block := range fp.BodyContent.Blocks.OfType(blockTypeInclude) {
+		content, moreDiags := block.Body.Content(includeSchema)
+		diags = diags.Extend(diagnostics.WithCode(moreDiags, diagnostics.CodeInvalidInclude))
+		if moreDiags.HasErrors() {
+			continue
+		}
+
+		attr := content.Attributes["paths"]
+		paths, moreDiags := decodeIncludePaths(attr.Expr)
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+
+		dir := filepath.Dir(block.DefRange.Filename)
+		for i, pattern := range paths {
+			subject := includePathRange(attr.Expr, i)
+			segments, err := includePatternSegments(pattern)
+			if err != nil {
+				diags = diags.Append(&hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "invalid include path",
+					Detail:   fmt.Sprintf("include path %s is not a valid pattern: %s", pattern, err),
+					Subject:  subject.Ptr(),
+					Context:  attr.Range.Ptr(),
+					Extra:    diagnostics.CodeInvalidInclude,
+				})
+
+				continue
+			}
+
+			matched := slices.ContainsFunc(names, func(name string) bool {
+				rel, err := filepath.Rel(dir, name)
+				if err != nil {
+					return false
+				}
+
+				return matchIncludeSegments(segments, strings.Split(filepath.ToSlash(rel), "/"))
+			})
+			if !matched {
+				diags = diags.Append(&hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "include path did not match any files",
+					Detail:   fmt.Sprintf("include path %s did not match any files relative to %s", pattern, dir),
+					Subject:  subject.Ptr(),
+					Context:  attr.Range.Ptr(),
+					Extra:    diagnostics.CodeInvalidInclude,
+				})
+			}
+		}
+	}
+
+	return diags
+}
+
+// includePathRange returns the range of the path at the index of the paths expression if
+// possible, otherwise the range of the expression.
+func includePathRange(expr hcl.Expression, idx int) hcl.Range {
+	tuple, ok := expr.(*hclsyntax.TupleConsExpr)
+	if !ok || idx >= len(tuple.Exprs) {
+		return expr.Range()
+	}
+
+	return tuple.Exprs[idx].Range()
+}
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+)
+
+func testWriteIncludeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, body := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(body), 0o644))
+	}
+}
+
+func TestFindFlightPlanFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	testWriteIncludeFiles(t, dir, map[string]string{
+		"enos.hcl": `
+include {
+  paths = ["enos/scenarios/*.hcl", "enos/modules/**/*.hcl"]
+}
+`,
+		"enos/scenarios/one.hcl": `scenario "one" {}`,
+		"enos/scenarios/two.hcl": `
+include {
+  paths = ["../shared/*.hcl"]
+}
+`,
+		"enos/scenarios/notes.txt":        `not included`,
+		"enos/modules/root.hcl":           `module "root" {}`,
+		"enos/modules/nested/deep.hcl":    `module "deep" {}`,
+		"enos/modules/.hidden/hidden.hcl": `module "hidden" {}`,
+		"enos/shared/globals.hcl":         `globals {}`,
+		"enos/unused/unused.hcl":          `module "unused" {}`,
+	})
+
+	files, err := FindFlightPlanFiles(dir)
+	require.NoError(t, err)
+
+	names := []string{}
+	for name := range files {
+		rel, err := filepath.Rel(dir, name)
+		require.NoError(t, err)
+		names = append(names, filepath.ToSlash(rel))
+	}
+	slices.Sort(names)
+
+	require.Equal(t, []string{
+		"enos.hcl",
+		"enos/modules/nested/deep.hcl",
+		"enos/modules/root.hcl",
+		"enos/scenarios/one.hcl",
+		"enos/scenarios/two.hcl",
+		"enos/shared/globals.hcl",
+	}, names)
+}
+
+func TestMatchIncludeSegments(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{"*.hcl", "foo.hcl", true},
+		{"*.hcl", "foo/bar.hcl", false},
+		{"**/*.hcl", "foo.hcl", true},
+		{"**/*.hcl", "foo/bar/baz.hcl", true},
+		{"foo/**/baz.hcl", "foo/baz.hcl", true},
+		{"foo/**/baz.hcl", "foo/bar/baz.hcl", true},
+		{"foo/**/baz.hcl", "bar/baz.hcl", false},
+		{"foo/?.hcl", "foo/a.hcl", true},
+		{"foo/[ab].hcl", "foo/c.hcl", false},
+	} {
+		t.Run(test.pattern+" "+test.name, func(t *testing.T) {
+			t.Parallel()
+
+			segments, err := includePatternSegments(test.pattern)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, matchIncludeSegments(segments, strings.Split(test.name, "/")))
+		})
+	}
+}
+
+func TestDecodeIncludes(t *testing.T) {
+	t.Parallel()
+
+	for desc, test := range map[string]struct {
+		files map[string]string
+		fail  bool
+	}{
+		"included": {
+			files: map[string]string{
+				"enos.hcl": `
+include {
+  paths = ["scenarios/*.hcl"]
+}
+`,
+				"scenarios/test.hcl": `
+scenario "test" {
+}
+`,
+			},
+		},
+		"no matches": {
+			files: map[string]string{
+				"enos.hcl": `
+include {
+  paths = ["scenarios/*.hcl"]
+}
+
+scenario "test" {
+}
+`,
+			},
+			fail: true,
+		},
+		"invalid pattern": {
+			files: map[string]string{
+				"enos.hcl": `
+include {
+  paths = ["scenarios/[.hcl"]
+}
+
+scenario "test" {
+}
+`,
+			},
+			fail: true,
+		},
+		"invalid paths": {
+			files: map[string]string{
+				"enos.hcl": `
+include {
+  paths = "scenarios/*.hcl"
+}
+
+scenario "test" {
+}
+`,
+			},
+			fail: true,
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			testWriteIncludeFiles(t, dir, test.files)
+			files, err := FindFlightPlanFiles(dir)
+			require.NoError(t, err)
+
+			dec, err := NewDecoder(
+				WithDecoderBaseDir(dir),
+				WithDecoderFPFiles(files),
+				WithDecoderDecodeTarget(DecodeTargetScenariosNamesNoVariants),
+			)
+			require.NoError(t, err)
+			diags := dec.Parse()
+			require.False(t, diags.HasErrors(), diags.Error())
+
+			fp, _, diags := dec.Decode(context.Background())
+			if !test.fail {
+				require.False(t, diags.HasErrors(), diags.Error())
+				require.Len(t, fp.BodyContent.Blocks.OfType(blockTypeScenario), 1)
+
+				return
+			}
+
+			require.True(t, diags.HasErrors())
+			require.Equal(t, diagnostics.CodeInvalidInclude, diags[0].Extra)
+			require.NotNil(t, diags[0].Subject)
+			require.Equal(t, filepath.Join(dir, "enos.hcl"), diags[0].Subject.Filename)
+		})
+	}
+}
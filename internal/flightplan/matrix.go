@@ -645,6 +645,16 @@ func (m *Matrix) Filter(filter *ScenarioFilter) *Matrix {
 		nm = nm.IntersectionContainsUnordered(filter.IntersectionMatrix)
 	}
 
+	if len(filter.Variants) > 0 && nm != nil {
+		vm := NewMatrix()
+		for _, vec := range nm.Vectors {
+			if filter.matchVariants(vec) {
+				vm.AddVector(vec)
+			}
+		}
+		nm = vm
+	}
+
 	return nm
 }
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/zclconf/go-cty/cty"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 	hcl "github.com/hashicorp/hcl/v2"
 )
@@ -119,6 +120,7 @@ func (md *matrixDecoder) decodeMatrix(
 			Detail:   fmt.Sprintf("a single matrix block can be set, found %d", len(mBlocks)),
 			Subject:  block.TypeRange.Ptr(),
 			Context:  block.DefRange.Ptr(),
+			Extra:    diagnostics.CodeInvalidMatrix,
 		})
 	}
 
@@ -194,6 +196,7 @@ func (md *matrixDecoder) decodeMatrix(
 						Detail:   err.Error(),
 						Subject:  hcl.RangeBetween(mBlock.LabelRanges[0], mBlock.LabelRanges[1]).Ptr(),
 						Context:  mBlock.DefRange.Ptr(),
+						Extra:    diagnostics.CodeInvalidMatrix,
 					})
 
 					continue
@@ -211,6 +214,7 @@ func (md *matrixDecoder) decodeMatrix(
 				Detail:   "blocks of type include and exclude are supported in matrix blocks, found " + mBlock.Type,
 				Subject:  mBlock.TypeRange.Ptr(),
 				Context:  mBlock.DefRange.Ptr(),
+				Extra:    diagnostics.CodeInvalidMatrix,
 			})
 
 			continue
@@ -323,6 +327,7 @@ func (md *matrixDecoder) decodeMatrixAttribute(
 			Detail:   fmt.Sprintf("expected value for %s to be a list of strings, found %s", attr.Name, val.Type().GoString()),
 			Subject:  attr.NameRange.Ptr(),
 			Context:  attr.Range.Ptr(),
+			Extra:    diagnostics.CodeInvalidMatrix,
 		})
 	}
 
@@ -332,6 +337,7 @@ func (md *matrixDecoder) decodeMatrixAttribute(
 			Summary:  "matrix attribute values cannot be empty lists",
 			Subject:  attr.NameRange.Ptr(),
 			Context:  attr.Range.Ptr(),
+			Extra:    diagnostics.CodeInvalidMatrix,
 		})
 	}
 
@@ -343,6 +349,7 @@ func (md *matrixDecoder) decodeMatrixAttribute(
 				Detail:   "found element with type " + elm.GoString(),
 				Subject:  attr.NameRange.Ptr(),
 				Context:  attr.Range.Ptr(),
+				Extra:    diagnostics.CodeInvalidMatrix,
 			})
 		}
 
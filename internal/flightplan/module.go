@@ -9,6 +9,7 @@ import (
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/convert"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
 	hcl "github.com/hashicorp/hcl/v2"
 )
 
@@ -54,6 +55,7 @@ func (m *Module) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagnostics
 			Summary:  "missing required module attribute source",
 			Detail:   "the 'source' attribute must be set for a module",
 			Subject:  block.Body.MissingItemRange().Ptr(),
+			Extra:    diagnostics.CodeInvalidAttributeValue,
 		})
 	} else {
 		val, moreDiags := src.Expr.Value(ctx)
@@ -73,6 +75,7 @@ func (m *Module) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagnostics
 					Detail:   "source must be a string value",
 					Subject:  src.Expr.Range().Ptr(),
 					Context:  hcl.RangeBetween(src.Expr.StartRange(), src.Expr.Range()).Ptr(),
+					Extra:    diagnostics.CodeInvalidAttributeValue,
 				})
 			} else {
 				diags = diags.Append(&hcl.Diagnostic{
@@ -81,6 +84,7 @@ func (m *Module) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagnostics
 					Detail:   "source should be a string value, consider changing it",
 					Subject:  src.Expr.Range().Ptr(),
 					Context:  hcl.RangeBetween(src.Expr.StartRange(), src.Expr.Range()).Ptr(),
+					Extra:    diagnostics.CodeInvalidAttributeValue,
 				})
 				m.Source = sourceVal.AsString()
 			}
@@ -107,6 +111,7 @@ func (m *Module) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagnostics
 					Detail:   "version must be a string value",
 					Subject:  version.Expr.Range().Ptr(),
 					Context:  hcl.RangeBetween(version.Expr.StartRange(), version.Expr.Range()).Ptr(),
+					Extra:    diagnostics.CodeInvalidAttributeValue,
 				})
 			} else {
 				diags = diags.Append(&hcl.Diagnostic{
@@ -115,6 +120,7 @@ func (m *Module) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagnostics
 					Detail:   "version should be a string value, consider changing it",
 					Subject:  version.Expr.Range().Ptr(),
 					Context:  hcl.RangeBetween(version.Expr.StartRange(), version.Expr.Range()).Ptr(),
+					Extra:    diagnostics.CodeInvalidAttributeValue,
 				})
 				m.Version = versionVal.AsString()
 			}
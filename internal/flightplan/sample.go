@@ -71,6 +71,7 @@ func (s *Sample) Decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagnostics
 			Detail:   "a sample must contain one-or-more subsets",
 			Subject:  block.TypeRange.Ptr(),
 			Context:  block.DefRange.Ptr(),
+			Extra:    diagnostics.CodeInvalidSample,
 		})
 	}
 
@@ -96,6 +97,7 @@ func (s *Sample) Decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagnostics
 				Detail:   fmt.Sprintf("A subset with the name %s has already been defined", ss.Name),
 				Subject:  subsets[i].DefRange.Ptr(),
 				Context:  subsets[i].TypeRange.Ptr(),
+				Extra:    diagnostics.CodeInvalidSample,
 			})
 		}
 
@@ -204,6 +206,7 @@ func decodeAndValidateSampleAttrs(attr *hcl.Attribute, ctx *hcl.EvalContext) (ct
 			Detail:   "all sample attributes must be knowable when decoding samples",
 			Subject:  attr.NameRange.Ptr(),
 			Context:  attr.Range.Ptr(),
+			Extra:    diagnostics.CodeInvalidSample,
 		})
 	}
 
@@ -214,6 +217,7 @@ func decodeAndValidateSampleAttrs(attr *hcl.Attribute, ctx *hcl.EvalContext) (ct
 			Detail:   "cannot iterate elements of type " + val.Type().GoString(),
 			Subject:  attr.NameRange.Ptr(),
 			Context:  attr.Range.Ptr(),
+			Extra:    diagnostics.CodeInvalidSample,
 		})
 	}
 
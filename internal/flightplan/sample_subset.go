@@ -92,6 +92,7 @@ func (s *SampleSubset) Frame(ctx context.Context, ws *pb.Workspace) (*SampleSubs
 			Severity: hcl.DiagError,
 			Summary:  "failed to decode a sample subset frame: ensure that sample subset refers to a scenario and that all specified subset variants exist in the scenario matrix",
 			Detail:   fmt.Sprintf("subset: %s, variants: %s", sf.String(), sf.IntersectionMatrix.String()),
+			Extra:    diagnostics.CodeInvalidSample,
 		})
 		decRes.Diagnostics = append(decRes.GetDiagnostics(), diagnostics.FromHCL(nil, hclDiags)...)
 
@@ -184,6 +185,7 @@ func (s *SampleSubset) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagno
 			Summary:  "A subset name, scenario_name, or scenario_filter is required but not defined",
 			Subject:  block.Body.MissingItemRange().Ptr(),
 			Context:  block.TypeRange.Ptr(),
+			Extra:    diagnostics.CodeInvalidSample,
 		})
 	}
 
@@ -193,6 +195,7 @@ func (s *SampleSubset) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagno
 			Summary:  "cannot filter scenarios from subset because the subset has beed configured with both a matrix and scenario filter",
 			Subject:  block.Body.MissingItemRange().Ptr(),
 			Context:  block.TypeRange.Ptr(),
+			Extra:    diagnostics.CodeInvalidSample,
 		})
 	}
 
@@ -202,6 +205,7 @@ func (s *SampleSubset) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagno
 			Summary:  "cannot filter scenarios from subset because a scenario_name and scenario_filter are both defined",
 			Subject:  block.Body.MissingItemRange().Ptr(),
 			Context:  block.TypeRange.Ptr(),
+			Extra:    diagnostics.CodeInvalidSample,
 		})
 	}
 
@@ -231,6 +235,7 @@ func decodeSampleSubsetFieldString(name string, attrs hcl.Attributes, ctx *hcl.E
 			Summary:  fmt.Sprintf("value of %s must be knowable", name),
 			Subject:  f.NameRange.Ptr(),
 			Context:  f.Range.Ptr(),
+			Extra:    diagnostics.CodeInvalidSample,
 		})
 	}
 
@@ -240,6 +245,7 @@ func decodeSampleSubsetFieldString(name string, attrs hcl.Attributes, ctx *hcl.E
 			Summary:  fmt.Sprintf("value of %s must be a string, got %s", name, val.Type().GoString()),
 			Subject:  f.NameRange.Ptr(),
 			Context:  f.Range.Ptr(),
+			Extra:    diagnostics.CodeInvalidSample,
 		})
 	}
 
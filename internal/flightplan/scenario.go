@@ -14,6 +14,7 @@ import (
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/gocty"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
@@ -25,10 +26,12 @@ var scenarioSchema = &hcl.BodySchema{
 		{Name: "terraform_cli", Required: false},
 		{Name: "terraform", Required: false},
 		{Name: "providers", Required: false},
+		{Name: "failure_policy", Required: false},
 	},
 	Blocks: []hcl.BlockHeaderSchema{
 		{Type: blockTypeScenarioStep, LabelNames: []string{attrLabelNameDefault}},
 		{Type: blockTypeOutput, LabelNames: []string{attrLabelNameDefault}},
+		{Type: blockTypeHook, LabelNames: []string{attrLabelNameDefault}},
 		// Matrix blocks are decoded before the rest of a scenario block, but are included here
 		// so we can decode without using partials.
 		{Type: blockTypeMatrix},
@@ -52,6 +55,8 @@ type Scenario struct {
 	Steps            []*ScenarioStep
 	Providers        []*Provider
 	Outputs          []*ScenarioOutput
+	Hooks            []*ScenarioHook
+	FailurePolicy    *pb.Operation_FailurePolicy
 }
 
 // NewScenario returns a new Scenario.
@@ -61,6 +66,7 @@ func NewScenario() *Scenario {
 		Steps:        []*ScenarioStep{},
 		Providers:    []*Provider{},
 		Outputs:      []*ScenarioOutput{},
+		Hooks:        []*ScenarioHook{},
 	}
 }
 
@@ -101,6 +107,27 @@ func (s *Scenario) FilterStr() string {
 	return str
 }
 
+// CtyVal returns the scenario metadata as an object value. It is exposed as "scenario" in the eval
+// context of the scenario.
+func (s *Scenario) CtyVal() cty.Value {
+	variants := cty.MapValEmpty(cty.String)
+	if s.Variants != nil && len(s.Variants.elements) > 0 {
+		vals := map[string]cty.Value{}
+		for _, elm := range s.Variants.elements {
+			vals[elm.Key] = cty.StringVal(elm.Val)
+		}
+		variants = cty.MapVal(vals)
+	}
+
+	return cty.ObjectVal(map[string]cty.Value{
+		"name":        cty.StringVal(s.Name),
+		"uid":         cty.StringVal(s.UID()),
+		"filter":      cty.StringVal(s.FilterStr()),
+		"variants":    variants,
+		"description": cty.StringVal(s.Description),
+	})
+}
+
 // FromRef takes a unmarshals a scenario reference into itself.
 func (s *Scenario) FromRef(ref *pb.Ref_Scenario) {
 	if ref == nil {
@@ -123,7 +150,7 @@ func (s *Scenario) Match(filter *ScenarioFilter) bool {
 	}
 
 	// Get scenarios that match our name
-	if filter.Name != "" && filter.Name != s.Name {
+	if !filter.matchName(s.Name) {
 		return false
 	}
 
@@ -153,6 +180,16 @@ func (s *Scenario) Match(filter *ScenarioFilter) bool {
 		}
 	}
 
+	if !filter.matchVariants(s.Variants) {
+		return false
+	}
+
+	// Scenarios must be decoded with the filter's decode target for step matching to work,
+	// otherwise a scenario without steps would satisfy any module or quality filter.
+	if !filter.matchSteps(s) {
+		return false
+	}
+
 	return true
 }
 
@@ -215,9 +252,17 @@ func (s *Scenario) decode(block *hcl.Block, ctx *hcl.EvalContext, target DecodeT
 			Summary:  "missing required step block",
 			Detail:   "scenarios require one or more step blocks",
 			Subject:  block.Body.MissingItemRange().Ptr(),
+			Extra:    diagnostics.CodeMissingStep,
 		})
 	}
 
+	// Make our scenario metadata available in the eval context. We'll update it after we've
+	// decoded the description.
+	if ctx.Variables == nil {
+		ctx.Variables = map[string]cty.Value{}
+	}
+	ctx.Variables["scenario"] = s.CtyVal()
+
 	// Decode our scenario description
 	desc, ok := content.Attributes["description"]
 	if ok {
@@ -227,6 +272,14 @@ func (s *Scenario) decode(block *hcl.Block, ctx *hcl.EvalContext, target DecodeT
 			return diags
 		}
 		s.Description = val.AsString()
+		ctx.Variables["scenario"] = s.CtyVal()
+	}
+
+	// Decode our failure policy
+	moreDiags = s.decodeAndValidateFailurePolicyAttribute(content, ctx)
+	diags = diags.Extend(moreDiags)
+	if moreDiags != nil && moreDiags.HasErrors() {
+		return diags
 	}
 
 	// Decode our locals
@@ -250,6 +303,15 @@ func (s *Scenario) decode(block *hcl.Block, ctx *hcl.EvalContext, target DecodeT
 		return diags
 	}
 
+	// Evaluate any backend attributes that refer to the scenario
+	if s.TerraformSetting != nil {
+		moreDiags = s.TerraformSetting.decodeScenarioBackendAttrs(ctx)
+		diags = diags.Extend(moreDiags)
+		if moreDiags != nil && moreDiags.HasErrors() {
+			return diags
+		}
+	}
+
 	// Decode the scenario providers
 	moreDiags = s.decodeAndValidateProvidersAttribute(content, ctx)
 	diags = diags.Extend(moreDiags)
@@ -271,6 +333,13 @@ func (s *Scenario) decode(block *hcl.Block, ctx *hcl.EvalContext, target DecodeT
 		return diags
 	}
 
+	// Decode our hooks
+	moreDiags = s.decodeAndValidateHookBlocks(content, ctx)
+	diags = diags.Extend(moreDiags)
+	if moreDiags != nil && moreDiags.HasErrors() {
+		return diags
+	}
+
 	return diags
 }
 
@@ -350,6 +419,7 @@ func (s *Scenario) decodeAndValidateTerraformCLIAttribute(
 			Summary:  "unable to determine terraform_cli",
 			Detail:   "no default terraform_cli's are available in the eval ctx",
 			Subject:  content.MissingItemRange.Ptr(),
+			Extra:    diagnostics.CodeInvalidTerraformCLI,
 		}
 
 		terraformClis, err := findEvalContextVariable("terraform_cli", ctx)
@@ -563,6 +633,7 @@ func (s *Scenario) decodeAndValidateProvidersAttribute(
 			Summary:  "providers value must be a known object",
 			Subject:  providers.Expr.Range().Ptr(),
 			Context:  providers.Range.Ptr(),
+			Extra:    diagnostics.CodeInvalidProviderReference,
 		})
 	}
 
@@ -575,6 +646,7 @@ func (s *Scenario) decodeAndValidateProvidersAttribute(
 			Detail:   err.Error(),
 			Subject:  providers.Expr.Range().Ptr(),
 			Context:  providers.Range.Ptr(),
+			Extra:    diagnostics.CodeUndefinedProvider,
 		})
 	}
 
@@ -586,6 +658,7 @@ func (s *Scenario) decodeAndValidateProvidersAttribute(
 			Summary:  "cannot set provider as no providers have been defined",
 			Subject:  providers.Expr.Range().Ptr(),
 			Context:  providers.Range.Ptr(),
+			Extra:    diagnostics.CodeUndefinedProvider,
 		})
 	}
 	for pType, pVals := range definedProviders.AsValueMap() {
@@ -607,6 +680,7 @@ func (s *Scenario) decodeAndValidateProvidersAttribute(
 				Summary:  fmt.Sprintf("provider type %s is not defined", pType),
 				Subject:  providers.Expr.Range().Ptr(),
 				Context:  providers.Range.Ptr(),
+				Extra:    diagnostics.CodeUndefinedProvider,
 			})
 		}
 
@@ -617,6 +691,7 @@ func (s *Scenario) decodeAndValidateProvidersAttribute(
 				Summary:  fmt.Sprintf("alias %s for provider type %s is not defined", pAlias, pType),
 				Subject:  providers.Expr.Range().Ptr(),
 				Context:  providers.Range.Ptr(),
+				Extra:    diagnostics.CodeUndefinedProvider,
 			})
 		}
 
@@ -640,6 +715,7 @@ func (s *Scenario) decodeAndValidateProvidersAttribute(
 					Detail:   fmt.Sprintf("provider value %s is not a valid provider address", providerVal.AsString()),
 					Subject:  providers.Expr.Range().Ptr(),
 					Context:  providers.Range.Ptr(),
+					Extra:    diagnostics.CodeInvalidProviderReference,
 				})
 
 				continue
@@ -663,6 +739,7 @@ func (s *Scenario) decodeAndValidateProvidersAttribute(
 					Detail:   err.Error(),
 					Subject:  providers.Expr.Range().Ptr(),
 					Context:  providers.Range.Ptr(),
+					Extra:    diagnostics.CodeInvalidProviderReference,
 				})
 
 				continue
@@ -683,6 +760,7 @@ func (s *Scenario) decodeAndValidateProvidersAttribute(
 				Detail:   err.Error(),
 				Subject:  providers.Expr.Range().Ptr(),
 				Context:  providers.Range.Ptr(),
+				Extra:    diagnostics.CodeInvalidProviderReference,
 			})
 
 			continue
@@ -702,6 +780,7 @@ func (s *Scenario) decodeAndValidateProvidersAttribute(
 				Summary:  "provider arguments don't match defined provider",
 				Subject:  providers.Expr.Range().Ptr(),
 				Context:  providers.Range.Ptr(),
+				Extra:    diagnostics.CodeInvalidProviderReference,
 			})
 		}
 		s.Providers = append(s.Providers, provider)
@@ -725,6 +804,7 @@ func (s *Scenario) decodeAndValidateStepBlocks(
 				Detail:   fmt.Sprintf("a step with name %s has already been declared", childBlock.Labels[0]),
 				Subject:  childBlock.TypeRange.Ptr(),
 				Context:  hcl.RangeBetween(childBlock.TypeRange, childBlock.DefRange).Ptr(),
+				Extra:    diagnostics.CodeRedeclaredStep,
 			})
 
 			continue
@@ -774,6 +854,7 @@ func (s *Scenario) decodeAndValidateOutputBlocks(
 				Detail:   fmt.Sprintf("an output with name %s has already been declared", childBlock.Labels[0]),
 				Subject:  childBlock.TypeRange.Ptr(),
 				Context:  hcl.RangeBetween(childBlock.TypeRange, childBlock.DefRange).Ptr(),
+				Extra:    diagnostics.CodeRedeclaredOutput,
 			})
 
 			continue
@@ -798,3 +879,84 @@ func (s *Scenario) decodeAndValidateOutputBlocks(
 
 	return diags
 }
+
+// decodeAndValidateHookBlocks decodes hook blocks. Multiple hooks of the same type are allowed
+// and will be run in the order in which they are defined.
+func (s *Scenario) decodeAndValidateHookBlocks(
+	content *hcl.BodyContent,
+	ctx *hcl.EvalContext,
+) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	for _, childBlock := range content.Blocks.OfType(blockTypeHook) {
+		hook := NewScenarioHook()
+		moreDiags := hook.decode(childBlock, ctx)
+		diags = diags.Extend(moreDiags)
+		if moreDiags != nil && moreDiags.HasErrors() {
+			continue
+		}
+
+		s.Hooks = append(s.Hooks, hook)
+	}
+
+	return diags
+}
+
+// decodeAndValidateFailurePolicyAttribute decodes the failure_policy attribute.
+func (s *Scenario) decodeAndValidateFailurePolicyAttribute(
+	content *hcl.BodyContent,
+	ctx *hcl.EvalContext,
+) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	policy, ok := content.Attributes["failure_policy"]
+	if !ok {
+		return diags
+	}
+
+	val, moreDiags := policy.Expr.Value(ctx)
+	diags = diags.Extend(moreDiags)
+	if moreDiags != nil && moreDiags.HasErrors() {
+		return diags
+	}
+
+	if val.IsNull() || !val.IsWhollyKnown() || val.Type() != cty.String {
+		return diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "failure_policy must be a known string",
+			Detail:   `failure_policy must be one of "keep", "destroy", or "keep-for=<duration>"`,
+			Subject:  policy.Expr.Range().Ptr(),
+			Extra:    diagnostics.CodeInvalidAttributeValue,
+		})
+	}
+
+	failurePolicy, err := ParseFailurePolicy(val.AsString())
+	if err != nil {
+		return diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "invalid failure_policy",
+			Detail:   err.Error(),
+			Subject:  policy.Expr.Range().Ptr(),
+			Extra:    diagnostics.CodeInvalidAttributeValue,
+		})
+	}
+	s.FailurePolicy = failurePolicy
+
+	return diags
+}
+
+// HooksOfType returns the scenario hooks of the given type in the order in which they were defined.
+func (s *Scenario) HooksOfType(hookType string) []*ScenarioHook {
+	if s == nil {
+		return nil
+	}
+
+	hooks := []*ScenarioHook{}
+	for _, hook := range s.Hooks {
+		if hook.Type == hookType {
+			hooks = append(hooks, hook)
+		}
+	}
+
+	return hooks
+}
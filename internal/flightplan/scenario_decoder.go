@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
 	hcl "github.com/hashicorp/hcl/v2"
 )
 
@@ -42,11 +43,12 @@ type ScenarioDecodeRequest struct {
 
 // ScenarioDecodeResponse is a response given from a scenario decoder. It contains a reference to
 // the ScenarioDecodeRequest, the decoded Scenario. Any HCL Diagnostics encountered along the way
-// are also included.
+// are also included, along with any local files that were referenced while decoding.
 type ScenarioDecodeResponse struct {
 	*Scenario
 	hcl.Diagnostics
 	*ScenarioDecodeRequest
+	Files []string
 }
 
 // ScenarioDecoderOpt is a scenario decoder option.
@@ -160,6 +162,7 @@ func (d *ScenarioDecoder) DecodeAll(ctx context.Context, fp *FlightPlan) hcl.Dia
 			return diags.Append(&hcl.Diagnostic{
 				Severity: hcl.DiagError,
 				Summary:  fmt.Sprintf("no scenario block with name %s could be found", scenarioResponse.Scenario.Name),
+				Extra:    diagnostics.CodeNoMatchingScenarios,
 			})
 		}
 
@@ -179,6 +182,7 @@ func (d *ScenarioDecoder) DecodeAll(ctx context.Context, fp *FlightPlan) hcl.Dia
 		return diags.Append(&hcl.Diagnostic{
 			Severity: hcl.DiagError,
 			Summary:  fmt.Sprintf("no scenarios matched filter criteria: %+v", iter.filter),
+			Extra:    diagnostics.CodeNoMatchingScenarios,
 		})
 	}
 
@@ -15,6 +15,7 @@ import (
 
 	"github.com/zclconf/go-cty/cty"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
 	hcl "github.com/hashicorp/hcl/v2"
 )
 
@@ -34,6 +35,7 @@ type ScenarioDecoderIterator struct {
 	hasFailed      bool
 	mustDispatch   int
 	haveReturned   int
+	haveSkipped    int
 	cancel         func()
 }
 
@@ -78,6 +80,7 @@ func (d *ScenarioDecoderIterator) Start(ctx context.Context) hcl.Diagnostics {
 		return d.diags.Append(&hcl.Diagnostic{
 			Severity: hcl.DiagError,
 			Summary:  "no scenarios were found",
+			Extra:    diagnostics.CodeNoScenarios,
 		})
 	}
 
@@ -166,8 +169,17 @@ func (d *ScenarioDecoderIterator) Next(ctx context.Context) bool {
 					d.diags = d.diags.Extend(res.Diagnostics)
 					d.hasFailed = true
 					d.cancel()
+
+					return true
 				}
 			}
+
+			// Step filters can only be evaluated on completely decoded scenarios.
+			if d.decodeTarget >= DecodeTargetScenariosComplete && !d.filter.matchSteps(res.Scenario) {
+				d.haveSkipped++
+
+				return d.Next(ctx)
+			}
 		}
 
 		return true
@@ -203,7 +215,7 @@ func (d *ScenarioDecoderIterator) Count() int {
 		return 0
 	}
 
-	return d.haveReturned
+	return d.haveReturned - d.haveSkipped
 }
 
 // Blocks returns the ScenarioBlocks that the iterator has been configured wit. Must be called
@@ -225,6 +237,7 @@ func (d *ScenarioDecoderIterator) decodeScenarioBlocks(ctx context.Context) hcl.
 		return diags.Append(&hcl.Diagnostic{
 			Severity: hcl.DiagError,
 			Summary:  "no scenario blocks have been defined",
+			Extra:    diagnostics.CodeNoScenarios,
 		})
 	}
 
@@ -439,7 +452,7 @@ func (d *ScenarioDecoderIterator) filterHCLBlocks() hcl.Diagnostics {
 	for i := range d.blocks {
 		// If we've got a filter that includes a name and our scenario block doesn't
 		// match we don't need to decode anything.
-		if d.filter != nil && d.filter.Name != "" && d.blocks[i].Labels[0] != d.filter.Name {
+		if !d.filter.matchName(d.blocks[i].Labels[0]) {
 			continue
 		}
 
@@ -640,7 +653,9 @@ func decodeScenario(req *ScenarioDecodeRequest) *ScenarioDecodeResponse {
 		ScenarioDecodeRequest: req,
 	}
 
+	refs := &fileRefs{}
 	evalCtx := req.ScenarioBlock.EvalContext.NewChild()
+	evalCtx.Functions = refs.recordingFuncs(evalCtx)
 	if req.Vector != nil {
 		res.Scenario.Variants = req.Vector
 		evalCtx.Variables = map[string]cty.Value{
@@ -649,6 +664,7 @@ func decodeScenario(req *ScenarioDecodeRequest) *ScenarioDecodeResponse {
 	}
 
 	res.Diagnostics = res.Scenario.decode(req.ScenarioBlock.Block, evalCtx, req.DecodeTarget)
+	res.Files = refs.Paths()
 
 	return res
 }
@@ -11,13 +11,21 @@ import (
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 )
 
+const (
+	filterKeyVerifies = "verifies"
+	filterKeyModule   = "module"
+)
+
 // ScenarioFilter is a filter for scenarios.
 type ScenarioFilter struct {
-	Name               string     // The scenario name
-	Include            *Vector    // A scenanario filter broken include a matrix vector
-	Exclude            []*Exclude // Explicit scenario/variant exclusions
-	SelectAll          bool       // Get all scenarios and variants
-	IntersectionMatrix *Matrix    // Like Include but can contain more than one Vector
+	Name               string         // The scenario name, glob or /regex/
+	Include            *Vector        // A scenanario filter broken include a matrix vector
+	Exclude            []*Exclude     // Explicit scenario/variant exclusions
+	SelectAll          bool           // Get all scenarios and variants
+	IntersectionMatrix *Matrix        // Like Include but can contain more than one Vector
+	Variants           []*FilterMatch // Variant alternatives, globs, and regexes
+	Verifies           []*FilterMatch // Qualities that a scenario step verifies
+	Modules            []*FilterMatch // Modules that a scenario step uses
 }
 
 // String returns the scenario filter as a string.
@@ -42,7 +50,19 @@ func (sf *ScenarioFilter) String() string {
 		}
 	}
 
-	return str
+	for _, m := range sf.Variants {
+		str = fmt.Sprintf("%s %s", str, m.String(m.Key, ":"))
+	}
+
+	for _, m := range sf.Verifies {
+		str = fmt.Sprintf("%s %s", str, m.String(filterKeyVerifies, "="))
+	}
+
+	for _, m := range sf.Modules {
+		str = fmt.Sprintf("%s %s", str, m.String(filterKeyModule, "="))
+	}
+
+	return strings.TrimSpace(str)
 }
 
 // RequiresVariants returns a boolean describing whether or not the filter
@@ -56,7 +76,7 @@ func (sf *ScenarioFilter) RequiresVariants() bool {
 		return true
 	}
 
-	if len(sf.Exclude) > 0 {
+	if len(sf.Exclude) > 0 || len(sf.Variants) > 0 {
 		return true
 	}
 
@@ -71,6 +91,113 @@ func (sf *ScenarioFilter) RequiresVariants() bool {
 	return false
 }
 
+// RequiresSteps returns a boolean describing whether or not the filter matches
+// on scenario steps, i.e. by verified quality or by module.
+func (sf *ScenarioFilter) RequiresSteps() bool {
+	if sf == nil {
+		return false
+	}
+
+	return len(sf.Verifies) > 0 || len(sf.Modules) > 0
+}
+
+// Validate validates that the filter matches have valid patterns.
+func (sf *ScenarioFilter) Validate() error {
+	if sf == nil {
+		return nil
+	}
+
+	if err := validatePattern(sf.Name); err != nil {
+		return err
+	}
+
+	for _, ms := range [][]*FilterMatch{sf.Variants, sf.Verifies, sf.Modules} {
+		for _, m := range ms {
+			if err := m.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchName returns whether or not the scenario name matches the filter.
+func (sf *ScenarioFilter) matchName(name string) bool {
+	if sf == nil || sf.Name == "" {
+		return true
+	}
+
+	return matchPattern(sf.Name, name)
+}
+
+// matchVariants returns whether or not the variant vector satisfies all of the
+// filters variant matches.
+func (sf *ScenarioFilter) matchVariants(vec *Vector) bool {
+	if sf == nil {
+		return true
+	}
+
+	for _, m := range sf.Variants {
+		vals := []string{}
+		for _, elm := range vec.Elements() {
+			if elm.Key == m.Key {
+				vals = append(vals, elm.Val)
+			}
+		}
+
+		if !m.Match(vals...) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchSteps returns whether or not the scenario steps satisfy all of the
+// filters verifies and module matches.
+func (sf *ScenarioFilter) matchSteps(s *Scenario) bool {
+	if !sf.RequiresSteps() {
+		return true
+	}
+
+	modules := []string{}
+	qualities := []string{}
+	for _, step := range s.Steps {
+		if step.Module != nil {
+			modules = append(modules, step.Module.Name)
+		}
+		for _, q := range step.Verifies {
+			qualities = append(qualities, q.Name)
+		}
+	}
+
+	for _, m := range sf.Modules {
+		if !m.Match(modules...) {
+			return false
+		}
+	}
+
+	for _, m := range sf.Verifies {
+		if !m.Match(qualities...) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// decodeTarget returns the decode target that is required to evaluate the filter.
+// Matching on verified qualities or modules requires fully decoded scenarios, so
+// we'll have to decode them completely when listing scenarios.
+func (sf *ScenarioFilter) decodeTarget(target DecodeTarget) DecodeTarget {
+	if sf.RequiresSteps() && target == DecodeTargetScenariosNamesExpandVariants {
+		return DecodeTargetScenariosComplete
+	}
+
+	return target
+}
+
 // ScenarioFilterOpt is a scenario filter constructor functional option.
 type ScenarioFilterOpt func(*ScenarioFilter) error
 
@@ -128,6 +255,9 @@ func WithScenarioFilterParse(args []string) ScenarioFilterOpt {
 		f.Include = nf.Include
 		f.Exclude = nf.Exclude
 		f.SelectAll = nf.SelectAll
+		f.Variants = nf.Variants
+		f.Verifies = nf.Verifies
+		f.Modules = nf.Modules
 
 		return nil
 	}
@@ -138,7 +268,7 @@ func WithScenarioFilterDecode(filter *pb.Scenario_Filter) ScenarioFilterOpt {
 	return func(f *ScenarioFilter) error {
 		f.FromProto(filter)
 
-		return nil
+		return f.Validate()
 	}
 }
 
@@ -160,7 +290,15 @@ func WithScenarioFilterFromSampleSubset(subset *SampleSubset) ScenarioFilterOpt
 }
 
 // ParseScenarioFilter takes command arguments that have been split by spaces
-// and validates that they are composed of a valid scenario filter.
+// and validates that they are composed of a valid scenario filter. A filter is
+// composed of:
+//   - An optional scenario name, which may be a glob or a /regex/
+//   - key:value variant pairs, where the value may be a comma separated list of
+//     alternatives, globs, or a /regex/
+//   - verifies=quality and module=name step filters, which also support
+//     alternatives, globs, and regexes
+//
+// Any of the pairs can be negated by prefixing them with '!'. All pairs must match.
 func ParseScenarioFilter(args []string) (*ScenarioFilter, error) {
 	f, err := NewScenarioFilter()
 	if err != nil {
@@ -176,28 +314,71 @@ func ParseScenarioFilter(args []string) (*ScenarioFilter, error) {
 
 	// Determine a name filer and/or variant vector for filtering
 	for _, arg := range args {
+		if arg == "" {
+			continue
+		}
+
+		negate := strings.HasPrefix(arg, "!")
+		term := strings.TrimPrefix(arg, "!")
+
+		// Step filters are checked first because quality names include colons.
+		if key, val, ok := strings.Cut(term, "="); ok && !strings.Contains(key, ":") {
+			m, err := NewFilterMatch(key, splitPatterns(val), negate)
+			if err != nil {
+				return f, fmt.Errorf("invalid scenario filter (%s): %w", arg, err)
+			}
+
+			switch key {
+			case filterKeyVerifies:
+				f.Verifies = append(f.Verifies, m)
+			case filterKeyModule:
+				f.Modules = append(f.Modules, m)
+			default:
+				return f, fmt.Errorf("invalid scenario filter (%s): unknown filter %s, expected %s or %s",
+					arg, key, filterKeyVerifies, filterKeyModule,
+				)
+			}
+
+			continue
+		}
+
 		if !strings.Contains(arg, ":") {
 			// It isn't a variant pair so it must be the name
 			if f.Name != "" {
 				// But we already have a name
 				return f, fmt.Errorf("invalid variant filter: already found variant name %s and given another %s", f.Name, arg)
 			}
+			if err := validatePattern(arg); err != nil {
+				return f, fmt.Errorf("invalid scenario filter (%s): %w", arg, err)
+			}
 			f.Name = arg
 
 			continue
 		}
 
-		parts := strings.Split(arg, ":")
-		if len(parts) != 2 {
+		key, val, _ := strings.Cut(term, ":")
+		if strings.Contains(val, ":") && !isRegexPattern(val) {
 			return f, fmt.Errorf("invalid variant filer (%s): filter must be a key:value pair", arg)
 		}
 
+		patterns := splitPatterns(val)
+		if len(patterns) > 1 || !isExactPattern(patterns[0]) {
+			// Alternatives, globs, and regexes can't be expressed as a vector
+			m, err := NewFilterMatch(key, patterns, negate)
+			if err != nil {
+				return f, fmt.Errorf("invalid variant filter (%s): %w", arg, err)
+			}
+			f.Variants = append(f.Variants, m)
+
+			continue
+		}
+
 		// Determine if it's an inclusive or exclusive filter
-		if strings.HasPrefix(parts[0], "!") {
+		if negate {
 			// It's an exclude filter
 
 			vec := NewVector()
-			vec.Add(NewElement(strings.TrimPrefix(parts[0], "!"), parts[1]))
+			vec.Add(NewElement(key, val))
 			ex, err := NewExclude(pb.Matrix_Exclude_MODE_CONTAINS, vec)
 			if err != nil {
 				return f, fmt.Errorf("invalid variant filter: %w", err)
@@ -211,7 +392,7 @@ func ParseScenarioFilter(args []string) (*ScenarioFilter, error) {
 		if f.Include == nil {
 			f.Include = NewVector()
 		}
-		f.Include.Add(NewElement(parts[0], parts[1]))
+		f.Include.Add(NewElement(key, val))
 	}
 
 	return f, nil
@@ -241,6 +422,10 @@ func (sf *ScenarioFilter) Proto() *pb.Scenario_Filter {
 		pbf.IntersectionMatrix = sf.IntersectionMatrix.Proto()
 	}
 
+	pbf.Variants = filterMatchesToProto(sf.Variants)
+	pbf.Verifies = filterMatchesToProto(sf.Verifies)
+	pbf.Modules = filterMatchesToProto(sf.Modules)
+
 	return pbf
 }
 
@@ -274,6 +459,10 @@ func (sf *ScenarioFilter) FromProto(filter *pb.Scenario_Filter) {
 		nm.FromProto(sim)
 		sf.IntersectionMatrix = nm
 	}
+
+	sf.Variants = filterMatchesFromProto(filter.GetVariants())
+	sf.Verifies = filterMatchesFromProto(filter.GetVerifies())
+	sf.Modules = filterMatchesFromProto(filter.GetModules())
 }
 
 // FromScenarioRef takes a reference to a scenario and returns a filter for it.
@@ -339,6 +528,9 @@ func (sf *ScenarioFilter) FromSampleSubset(subset *SampleSubset) error {
 		sf.Include = psf.Include
 		sf.Exclude = psf.Exclude
 		sf.SelectAll = psf.SelectAll
+		sf.Variants = psf.Variants
+		sf.Verifies = psf.Verifies
+		sf.Modules = psf.Modules
 
 		return nil
 	}
@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// filterRegexps caches compiled filter regular expressions as the same patterns are matched against
+// every variant in a matrix.
+var filterRegexps sync.Map
+
+// FilterMatch matches values against patterns. A pattern is either an exact value, a glob, or a
+// regular expression enclosed in slashes, e.g. /^ubuntu-2[24]/. A match succeeds if any of the
+// patterns match any of the values, or if none of them match when negated.
+type FilterMatch struct {
+	Key      string // The variant key. Unused for verifies and module matches.
+	Patterns []string
+	Negate   bool
+}
+
+// NewFilterMatch takes a key, patterns, and whether the match is negated, validates the patterns,
+// and returns a new FilterMatch.
+func NewFilterMatch(key string, patterns []string, negate bool) (*FilterMatch, error) {
+	m := &FilterMatch{Key: key, Patterns: patterns, Negate: negate}
+
+	return m, m.Validate()
+}
+
+// Validate validates that the match has valid patterns.
+func (m *FilterMatch) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.Patterns) == 0 {
+		return fmt.Errorf("invalid filter match %s: no patterns given", m.Key)
+	}
+
+	for _, pattern := range m.Patterns {
+		if pattern == "" {
+			return fmt.Errorf("invalid filter match %s: empty pattern", m.Key)
+		}
+
+		if err := validatePattern(pattern); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Match returns whether or not the values satisfy the match.
+func (m *FilterMatch) Match(vals ...string) bool {
+	if m == nil {
+		return true
+	}
+
+	matched := false
+	for _, val := range vals {
+		for _, pattern := range m.Patterns {
+			if matchPattern(pattern, val) {
+				matched = true
+
+				break
+			}
+		}
+		if matched {
+			break
+		}
+	}
+
+	return matched != m.Negate
+}
+
+// String returns the match as it would be written in a filter, using sep to separate the key and
+// the patterns.
+func (m *FilterMatch) String(key string, sep string) string {
+	if m == nil {
+		return ""
+	}
+
+	str := key + sep + strings.Join(m.Patterns, ",")
+	if m.Negate {
+		str = "!" + str
+	}
+
+	return str
+}
+
+// Proto returns the match as a proto match.
+func (m *FilterMatch) Proto() *pb.Scenario_Filter_Match {
+	return &pb.Scenario_Filter_Match{
+		Key:      m.Key,
+		Patterns: m.Patterns,
+		Negate:   m.Negate,
+	}
+}
+
+// FromProto unmarshals a proto match into itself.
+func (m *FilterMatch) FromProto(in *pb.Scenario_Filter_Match) {
+	if m == nil || in == nil {
+		return
+	}
+
+	m.Key = in.GetKey()
+	m.Patterns = in.GetPatterns()
+	m.Negate = in.GetNegate()
+}
+
+func filterMatchesToProto(in []*FilterMatch) []*pb.Scenario_Filter_Match {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := []*pb.Scenario_Filter_Match{}
+	for _, m := range in {
+		out = append(out, m.Proto())
+	}
+
+	return out
+}
+
+func filterMatchesFromProto(in []*pb.Scenario_Filter_Match) []*FilterMatch {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := []*FilterMatch{}
+	for _, pm := range in {
+		m := &FilterMatch{}
+		m.FromProto(pm)
+		out = append(out, m)
+	}
+
+	return out
+}
+
+// splitPatterns splits a filter value into patterns. A value that is a regular expression is
+// never split as it might include commas.
+func splitPatterns(val string) []string {
+	if isRegexPattern(val) {
+		return []string{val}
+	}
+
+	return strings.Split(val, ",")
+}
+
+// isRegexPattern returns whether the pattern is a regular expression.
+func isRegexPattern(pattern string) bool {
+	return len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/")
+}
+
+// isExactPattern returns whether the pattern matches only itself.
+func isExactPattern(pattern string) bool {
+	return !isRegexPattern(pattern) && !strings.ContainsAny(pattern, "*?[")
+}
+
+func validatePattern(pattern string) error {
+	if isRegexPattern(pattern) {
+		if _, err := filterRegexp(pattern); err != nil {
+			return fmt.Errorf("invalid filter regular expression %s: %w", pattern, err)
+		}
+
+		return nil
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid filter glob %s: %w", pattern, err)
+	}
+
+	return nil
+}
+
+// matchPattern returns whether the value matches the pattern.
+func matchPattern(pattern string, val string) bool {
+	switch {
+	case isRegexPattern(pattern):
+		re, err := filterRegexp(pattern)
+		if err != nil {
+			return false
+		}
+
+		return re.MatchString(val)
+	case isExactPattern(pattern):
+		return pattern == val
+	default:
+		ok, err := path.Match(pattern, val)
+
+		return err == nil && ok
+	}
+}
+
+func filterRegexp(pattern string) (*regexp.Regexp, error) {
+	if re, ok := filterRegexps.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+	if err != nil {
+		return nil, err
+	}
+	filterRegexps.Store(pattern, re)
+
+	return re, nil
+}
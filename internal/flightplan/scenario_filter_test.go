@@ -144,6 +144,9 @@ func Test_ScenarioFilter_Proto_RoundTrip(t *testing.T) {
 			NewVector(NewElement("backend", "raft"), NewElement("arch", "arm64")),
 			NewVector(NewElement("backend", "consul"), NewElement("arch", "arm64")),
 		}},
+		Variants: []*FilterMatch{{Key: "distro", Patterns: []string{"ubuntu*", "rhel"}}},
+		Verifies: []*FilterMatch{{Patterns: []string{"/^vault:/"}, Negate: true}},
+		Modules:  []*FilterMatch{{Patterns: []string{"create_vpc"}}},
 	}
 	got := &ScenarioFilter{}
 	got.FromProto(expected.Proto())
@@ -232,6 +235,47 @@ func Test_ScenarioFilter_ScenariosSelect(t *testing.T) {
 			},
 			[]*Scenario{},
 		},
+		{
+			"name glob",
+			scenarios,
+			&ScenarioFilter{Name: "*install"},
+			scenarios[0:4],
+		},
+		{
+			"name regex",
+			scenarios,
+			&ScenarioFilter{Name: "/^(upgrade|no-variant)$/"},
+			scenarios[4:9],
+		},
+		{
+			"variant alternatives",
+			scenarios,
+			&ScenarioFilter{
+				Name:     "upgrade",
+				Variants: []*FilterMatch{{Key: "arch", Patterns: []string{"arm64", "amd64"}}},
+			},
+			scenarios[4:8],
+		},
+		{
+			"variant glob and negated regex",
+			scenarios,
+			&ScenarioFilter{
+				Variants: []*FilterMatch{
+					{Key: "backend", Patterns: []string{"c*"}},
+					{Key: "arch", Patterns: []string{"/64$/", "/arm/"}, Negate: true},
+				},
+			},
+			[]*Scenario{},
+		},
+		{
+			"negated variant alternatives",
+			scenarios,
+			&ScenarioFilter{
+				Name:     "fresh-install",
+				Variants: []*FilterMatch{{Key: "arch", Patterns: []string{"arm64", "s390x"}, Negate: true}},
+			},
+			[]*Scenario{scenarios[1], scenarios[3]},
+		},
 	} {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
@@ -293,6 +337,40 @@ func Test_ScenarioFilter_Parse(t *testing.T) {
 				},
 			},
 		},
+		{
+			"filter with name glob and variant alternatives",
+			[]string{"upgrade_*", "backend:raft,consul", "!arch:arm64,s390x"},
+			&ScenarioFilter{
+				Name: "upgrade_*",
+				Variants: []*FilterMatch{
+					{Key: "backend", Patterns: []string{"raft", "consul"}},
+					{Key: "arch", Patterns: []string{"arm64", "s390x"}, Negate: true},
+				},
+			},
+		},
+		{
+			"filter with variant glob and regex",
+			[]string{"distro:ubuntu*", "edition:/^ent(\\.hsm)?,?$/"},
+			&ScenarioFilter{
+				Variants: []*FilterMatch{
+					{Key: "distro", Patterns: []string{"ubuntu*"}},
+					{Key: "edition", Patterns: []string{"/^ent(\\.hsm)?,?$/"}},
+				},
+			},
+		},
+		{
+			"filter with verifies and modules",
+			[]string{"verifies=vault:read,vault:write", "!module=create_*", "backend:raft"},
+			&ScenarioFilter{
+				Include: NewVector(NewElement("backend", "raft")),
+				Verifies: []*FilterMatch{
+					{Key: "verifies", Patterns: []string{"vault:read", "vault:write"}},
+				},
+				Modules: []*FilterMatch{
+					{Key: "module", Patterns: []string{"create_*"}, Negate: true},
+				},
+			},
+		},
 	} {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
@@ -304,6 +382,72 @@ func Test_ScenarioFilter_Parse(t *testing.T) {
 	}
 }
 
+// Test_ScenarioFilter_Parse_Invalid tests that invalid filters return an error.
+func Test_ScenarioFilter_Parse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for desc, args := range map[string][]string{
+		"two names":         {"foo", "bar"},
+		"too many colons":   {"backend:raft:consul"},
+		"bad glob":          {"distro:ubuntu["},
+		"bad regex":         {"distro:/ubuntu(/"},
+		"unknown step key":  {"step=foo"},
+		"empty alternative": {"verifies="},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseScenarioFilter(args)
+			require.Error(t, err)
+		})
+	}
+}
+
+// Test_ScenarioFilter_MatchSteps tests matching scenarios by verified qualities and modules.
+func Test_ScenarioFilter_MatchSteps(t *testing.T) {
+	t.Parallel()
+
+	scenario := &Scenario{
+		Name: "upgrade",
+		Steps: []*ScenarioStep{
+			{Module: &Module{Name: "create_vpc"}},
+			{
+				Module:   &Module{Name: "vault_upgrade"},
+				Verifies: []*Quality{{Name: "vault:version"}, {Name: "vault:raft:healthy"}},
+			},
+		},
+	}
+
+	// A scenario without steps should never satisfy a positive module or quality match.
+	stepless := &Scenario{Name: "smoke"}
+
+	for desc, test := range map[string]struct {
+		args     []string
+		expected bool
+		stepless bool
+	}{
+		"module":              {[]string{"module=vault_upgrade"}, true, false},
+		"module glob":         {[]string{"module=vault_*"}, true, false},
+		"negated module":      {[]string{"!module=create_vpc"}, false, true},
+		"missing module":      {[]string{"module=vault_install"}, false, false},
+		"verifies":            {[]string{"verifies=vault:version"}, true, false},
+		"verifies regex":      {[]string{"verifies=/^vault:raft:/"}, true, false},
+		"verifies alternates": {[]string{"verifies=consul:healthy,vault:version"}, true, false},
+		"verifies and module": {[]string{"verifies=vault:version", "!module=create_*"}, false, false},
+		"negated verifies":    {[]string{"!verifies=consul:*"}, true, true},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := ParseScenarioFilter(test.args)
+			require.NoError(t, err)
+			require.True(t, filter.RequiresSteps())
+			require.Equal(t, test.expected, scenario.Match(filter))
+			require.Equal(t, test.stepless, stepless.Match(filter))
+		})
+	}
+}
+
 func Test_ScenarioFilter_RequiresVariants(t *testing.T) {
 	t.Parallel()
 	for name, test := range map[string]struct {
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hcldec"
+)
+
+// Scenario hook types.
+const (
+	HookBeforeLaunch  = "before_launch"
+	HookAfterLaunch   = "after_launch"
+	HookBeforeDestroy = "before_destroy"
+	HookAfterDestroy  = "after_destroy"
+	HookOnFailure     = "on_failure"
+)
+
+// HookTypes are all supported scenario hook types.
+var HookTypes = []string{
+	HookBeforeLaunch,
+	HookAfterLaunch,
+	HookBeforeDestroy,
+	HookAfterDestroy,
+	HookOnFailure,
+}
+
+// ScenarioHook represents a "hook" block in a scenario. Hooks run a local command at a point in
+// the lifecycle of a scenario.
+type ScenarioHook struct {
+	Type    string
+	Command []string
+	Env     map[string]string
+}
+
+// NewScenarioHook returns a new ScenarioHook.
+func NewScenarioHook() *ScenarioHook {
+	return &ScenarioHook{
+		Command: []string{},
+		Env:     map[string]string{},
+	}
+}
+
+// decode takes in an HCL block of a hook and unmarshal the value into itself.
+func (h *ScenarioHook) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+	h.Type = block.Labels[0]
+
+	if !slices.Contains(HookTypes, h.Type) {
+		return diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "invalid hook type",
+			Detail: fmt.Sprintf("hook type must be one of %s, got %s",
+				strings.Join(HookTypes, ", "), h.Type,
+			),
+			Subject: block.LabelRanges[0].Ptr(),
+			Context: hcl.RangeBetween(block.TypeRange, block.DefRange).Ptr(),
+			Extra:   diagnostics.CodeInvalidBlockLabel,
+		})
+	}
+
+	scenarioHookSpec := hcldec.ObjectSpec{
+		"command": &hcldec.AttrSpec{
+			Name:     "command",
+			Type:     cty.List(cty.String),
+			Required: true,
+		},
+		"env": &hcldec.AttrSpec{
+			Name:     "env",
+			Type:     cty.Map(cty.String),
+			Required: false,
+		},
+	}
+
+	val, moreDiags := hcldec.Decode(block.Body, scenarioHookSpec, ctx)
+	diags = diags.Extend(moreDiags)
+	if moreDiags != nil && moreDiags.HasErrors() {
+		return diags
+	}
+
+	if !val.IsWhollyKnown() {
+		return diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "hook attributes must be known values",
+			Detail:   "hook attributes cannot refer to step outputs, use the ENOS_OUTPUT_* environment variables instead",
+			Subject:  block.DefRange.Ptr(),
+			Extra:    diagnostics.CodeInvalidAttributeValue,
+		})
+	}
+
+	for attr, val := range val.AsValueMap() {
+		if val.IsNull() {
+			continue
+		}
+
+		switch attr {
+		case "command":
+			for _, arg := range val.AsValueSlice() {
+				if !arg.IsNull() {
+					h.Command = append(h.Command, arg.AsString())
+				}
+			}
+		case "env":
+			for k, v := range val.AsValueMap() {
+				if !v.IsNull() {
+					h.Env[k] = v.AsString()
+				}
+			}
+		default:
+		}
+	}
+
+	if len(h.Command) == 0 || h.Command[0] == "" {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "hook command cannot be empty",
+			Subject:  block.DefRange.Ptr(),
+			Extra:    diagnostics.CodeInvalidAttributeValue,
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestScenarioHookDecode tests decoding of scenario hook blocks.
+func TestScenarioHookDecode(t *testing.T) {
+	t.Parallel()
+
+	modulePath, err := filepath.Abs("./tests/simple_module")
+	require.NoError(t, err)
+
+	for desc, test := range map[string]struct {
+		hcl      string
+		expected []*ScenarioHook
+		fail     bool
+	}{
+		"valid": {
+			hcl: `
+  hook "before_launch" {
+    command = ["./setup.sh", "--verbose"]
+  }
+
+  hook "on_failure" {
+    command = ["./collect.sh"]
+    env = {
+      LEVEL = "debug"
+    }
+  }
+`,
+			expected: []*ScenarioHook{
+				{
+					Type:    HookBeforeLaunch,
+					Command: []string{"./setup.sh", "--verbose"},
+					Env:     map[string]string{},
+				},
+				{
+					Type:    HookOnFailure,
+					Command: []string{"./collect.sh"},
+					Env:     map[string]string{"LEVEL": "debug"},
+				},
+			},
+		},
+		"invalid type": {
+			hcl: `
+  hook "after_everything" {
+    command = ["./setup.sh"]
+  }
+`,
+			fail: true,
+		},
+		"empty command": {
+			hcl: `
+  hook "after_launch" {
+    command = []
+  }
+`,
+			fail: true,
+		},
+		"missing command": {
+			hcl: `
+  hook "after_launch" {
+    env = {
+      LEVEL = "debug"
+    }
+  }
+`,
+			fail: true,
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			fp, err := testDecodeHCL(t, []byte(fmt.Sprintf(`
+module "simple" {
+  source = "%s"
+}
+
+scenario "test" {
+  step "infra" {
+    module = module.simple
+  }
+%s
+}
+`, modulePath, test.hcl)), DecodeTargetAll)
+			if test.fail {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, fp.Scenarios(), 1)
+			require.Equal(t, test.expected, fp.Scenarios()[0].Hooks)
+			require.Len(t, fp.Scenarios()[0].HooksOfType(HookOnFailure), 1)
+		})
+	}
+}
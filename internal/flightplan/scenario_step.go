@@ -9,10 +9,12 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/convert"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hcldec"
@@ -26,6 +28,7 @@ var scenarioStepSchema = &hcl.BodySchema{
 		{Name: "providers", Required: false},
 		{Name: "depends_on", Required: false},
 		{Name: "skip_step", Required: false},
+		{Name: "timeout", Required: false},
 		{Name: "verifies", Required: false},
 	},
 	Blocks: []hcl.BlockHeaderSchema{
@@ -42,6 +45,7 @@ type ScenarioStep struct {
 	DependsOn   []string
 	Verifies    []*Quality
 	Skip        bool
+	Timeout     time.Duration
 }
 
 // NewScenarioStep returns a new Scenario step.
@@ -104,6 +108,13 @@ func (ss *ScenarioStep) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagn
 		return diags
 	}
 
+	// Decode timeout
+	moreDiags = ss.decodeTimeout(content, ctx)
+	diags = diags.Extend(moreDiags)
+	if moreDiags != nil && moreDiags.HasErrors() {
+		return diags
+	}
+
 	// Decode the step module reference
 	moduleAttr, moreDiags := ss.decodeModuleAttribute(block, content, ctx)
 	diags = diags.Extend(moreDiags)
@@ -163,6 +174,7 @@ func (ss *ScenarioStep) decodeSkip(
 			Severity: hcl.DiagError,
 			Summary:  "skip_step must be a known value",
 			Subject:  skip.Expr.Range().Ptr(),
+			Extra:    diagnostics.CodeInvalidSkipStep,
 		})
 	}
 
@@ -172,6 +184,7 @@ func (ss *ScenarioStep) decodeSkip(
 			Summary:  "skip_step must be a bool",
 			Detail:   "skip_step must be a bool, not " + val.Type().FriendlyName(),
 			Subject:  skip.Expr.Range().Ptr(),
+			Extra:    diagnostics.CodeInvalidSkipStep,
 		})
 	}
 
@@ -182,6 +195,50 @@ func (ss *ScenarioStep) decodeSkip(
 	return diags, val.True()
 }
 
+// decodeTimeout decodes the "timeout" attribute. The timeout is a duration string, e.g. "10m", of
+// how long the step is allowed to apply.
+func (ss *ScenarioStep) decodeTimeout(content *hcl.BodyContent, ctx *hcl.EvalContext) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	timeout, ok := content.Attributes["timeout"]
+	if !ok {
+		return diags
+	}
+
+	val, moreDiags := timeout.Expr.Value(ctx)
+	diags = diags.Extend(moreDiags)
+	if moreDiags != nil && moreDiags.HasErrors() {
+		return diags
+	}
+
+	if val.IsNull() || !val.IsWhollyKnown() || val.Type() != cty.String {
+		return diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "timeout must be a known duration string",
+			Detail:   "timeout must be a duration string like \"10m\" that does not refer to step outputs",
+			Subject:  timeout.Expr.Range().Ptr(),
+			Extra:    diagnostics.CodeInvalidAttributeValue,
+		})
+	}
+
+	dur, err := time.ParseDuration(val.AsString())
+	if err == nil && dur <= 0 {
+		err = errors.New("timeout must be greater than zero")
+	}
+	if err != nil {
+		return diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "invalid timeout",
+			Detail:   err.Error(),
+			Subject:  timeout.Expr.Range().Ptr(),
+			Extra:    diagnostics.CodeInvalidAttributeValue,
+		})
+	}
+	ss.Timeout = dur
+
+	return diags
+}
+
 // decodeModuleAttribute decodes the module attribute from the content and ensures
 // that it has the required source and name fields. It returns the HCL attribute
 // for further validation later.
@@ -202,6 +259,7 @@ func (ss *ScenarioStep) decodeModuleAttribute(
 			Summary:  "scenario step missing module",
 			Detail:   "scenario step missing module",
 			Subject:  block.Body.MissingItemRange().Ptr(),
+			Extra:    diagnostics.CodeUnknownModule,
 		})
 
 		return module, diags
@@ -226,6 +284,7 @@ func (ss *ScenarioStep) decodeModuleAttribute(
 			Detail:   "module must be a known module object",
 			Subject:  module.Expr.Range().Ptr(),
 			Context:  hcl.RangeBetween(module.NameRange, module.Expr.Range()).Ptr(),
+			Extra:    diagnostics.CodeUnknownModule,
 		})
 
 		return module, diags
@@ -242,6 +301,7 @@ func (ss *ScenarioStep) decodeModuleAttribute(
 				Detail:   "no modules have been defined",
 				Subject:  module.Expr.Range().Ptr(),
 				Context:  hcl.RangeBetween(module.NameRange, module.Expr.Range()).Ptr(),
+				Extra:    diagnostics.CodeUnknownModule,
 			})
 		}
 
@@ -256,6 +316,7 @@ func (ss *ScenarioStep) decodeModuleAttribute(
 				Detail:   fmt.Sprintf("no modules with name %s have been defined", val.AsString()),
 				Subject:  module.Expr.Range().Ptr(),
 				Context:  hcl.RangeBetween(module.NameRange, module.Expr.Range()).Ptr(),
+				Extra:    diagnostics.CodeUnknownModule,
 			})
 		}
 
@@ -271,6 +332,7 @@ func (ss *ScenarioStep) decodeModuleAttribute(
 			Detail:   "module must be a string name or module value",
 			Subject:  module.Expr.Range().Ptr(),
 			Context:  hcl.RangeBetween(module.NameRange, module.Expr.Range()).Ptr(),
+			Extra:    diagnostics.CodeUnknownModule,
 		})
 
 		return module, diags
@@ -284,6 +346,7 @@ func (ss *ScenarioStep) decodeModuleAttribute(
 			Detail:   "missing module name",
 			Subject:  module.NameRange.Ptr(),
 			Context:  hcl.RangeBetween(module.NameRange, module.Expr.Range()).Ptr(),
+			Extra:    diagnostics.CodeUnknownModule,
 		})
 
 		return module, diags
@@ -298,6 +361,7 @@ func (ss *ScenarioStep) decodeModuleAttribute(
 			Detail:   "missing module source",
 			Subject:  module.Expr.Range().Ptr(),
 			Context:  hcl.RangeBetween(module.Range, module.Expr.Range()).Ptr(),
+			Extra:    diagnostics.CodeUnknownModule,
 		})
 
 		return module, diags
@@ -313,6 +377,7 @@ func (ss *ScenarioStep) decodeModuleAttribute(
 				Detail:   "module source value must be a string",
 				Subject:  module.Expr.Range().Ptr(),
 				Context:  hcl.RangeBetween(module.Range, module.Expr.Range()).Ptr(),
+				Extra:    diagnostics.CodeInvalidAttributeValue,
 			})
 		} else {
 			diags = diags.Append(&hcl.Diagnostic{
@@ -321,6 +386,7 @@ func (ss *ScenarioStep) decodeModuleAttribute(
 				Detail:   "module source value should be a string, consider updating it",
 				Subject:  module.Expr.Range().Ptr(),
 				Context:  hcl.RangeBetween(module.Range, module.Expr.Range()).Ptr(),
+				Extra:    diagnostics.CodeInvalidAttributeValue,
 			})
 			ss.Module.Source = sourceVal.AsString()
 		}
@@ -340,6 +406,7 @@ func (ss *ScenarioStep) decodeModuleAttribute(
 					Detail:   "module version value must be a string",
 					Subject:  module.Expr.Range().Ptr(),
 					Context:  hcl.RangeBetween(module.Range, module.Expr.Range()).Ptr(),
+					Extra:    diagnostics.CodeInvalidAttributeValue,
 				})
 			} else {
 				diags = diags.Append(&hcl.Diagnostic{
@@ -348,6 +415,7 @@ func (ss *ScenarioStep) decodeModuleAttribute(
 					Detail:   "module version value should be a string, consider updating it",
 					Subject:  module.Expr.Range().Ptr(),
 					Context:  hcl.RangeBetween(module.Range, module.Expr.Range()).Ptr(),
+					Extra:    diagnostics.CodeInvalidAttributeValue,
 				})
 				ss.Module.Version = versionVal.AsString()
 			}
@@ -463,6 +531,7 @@ func (ss *ScenarioStep) decodeAndValidateDependsOn(content *hcl.BodyContent, ctx
 			Summary:  "depends value must be a known object",
 			Subject:  depends.Expr.Range().Ptr(),
 			Context:  depends.Range.Ptr(),
+			Extra:    diagnostics.CodeInvalidAttributeValue,
 		})
 	}
 
@@ -475,6 +544,7 @@ func (ss *ScenarioStep) decodeAndValidateDependsOn(content *hcl.BodyContent, ctx
 			Detail:   err.Error(),
 			Subject:  depends.Expr.Range().Ptr(),
 			Context:  depends.Range.Ptr(),
+			Extra:    diagnostics.CodeUnknownStepReference,
 		})
 	}
 
@@ -493,6 +563,7 @@ func (ss *ScenarioStep) decodeAndValidateDependsOn(content *hcl.BodyContent, ctx
 					Detail:   fmt.Sprintf("cannot depend_on step %s as it has not been defined", depName),
 					Subject:  depends.Expr.Range().Ptr(),
 					Context:  depends.Range.Ptr(),
+					Extra:    diagnostics.CodeUnknownStepReference,
 				})
 
 				continue
@@ -505,6 +576,7 @@ func (ss *ScenarioStep) decodeAndValidateDependsOn(content *hcl.BodyContent, ctx
 					Detail:   fmt.Sprintf("cannot depend_on step %s more than once", depName),
 					Subject:  depends.Expr.Range().Ptr(),
 					Context:  depends.Range.Ptr(),
+					Extra:    diagnostics.CodeDuplicateStepDependency,
 				})
 
 				continue
@@ -526,6 +598,7 @@ func (ss *ScenarioStep) decodeAndValidateDependsOn(content *hcl.BodyContent, ctx
 				Detail:   err.Error(),
 				Subject:  depends.Expr.Range().Ptr(),
 				Context:  depends.Range.Ptr(),
+				Extra:    diagnostics.CodeUnknownStepReference,
 			})
 
 			continue
@@ -538,6 +611,7 @@ func (ss *ScenarioStep) decodeAndValidateDependsOn(content *hcl.BodyContent, ctx
 				Detail:   fmt.Sprintf("cannot depend_on step %s more than once", step.Name),
 				Subject:  depends.Expr.Range().Ptr(),
 				Context:  depends.Range.Ptr(),
+				Extra:    diagnostics.CodeDuplicateStepDependency,
 			})
 
 			continue
@@ -581,6 +655,7 @@ func (ss *ScenarioStep) decodeAndValidateVerifies(content *hcl.BodyContent, ctx
 			Summary:  "verifies value must be knowable at compile time",
 			Subject:  verifies.Expr.Range().Ptr(),
 			Context:  verifies.Range.Ptr(),
+			Extra:    diagnostics.CodeInvalidVerifies,
 		})
 	}
 
@@ -592,6 +667,7 @@ func (ss *ScenarioStep) decodeAndValidateVerifies(content *hcl.BodyContent, ctx
 			Summary:  "invalid input for 'verifies' attribute. Must be one-or-more qualities",
 			Subject:  verifies.Expr.Range().Ptr(),
 			Context:  verifies.Range.Ptr(),
+			Extra:    diagnostics.CodeInvalidVerifies,
 		})
 
 		return diags
@@ -607,6 +683,7 @@ func (ss *ScenarioStep) decodeAndValidateVerifies(content *hcl.BodyContent, ctx
 				Detail:   err.Error(),
 				Subject:  verifies.Expr.Range().Ptr(),
 				Context:  verifies.Range.Ptr(),
+				Extra:    diagnostics.CodeInvalidVerifies,
 			})
 		}
 
@@ -628,6 +705,7 @@ func (ss *ScenarioStep) decodeAndValidateVerifies(content *hcl.BodyContent, ctx
 				Detail:   err.Error(),
 				Subject:  verifies.Expr.Range().Ptr(),
 				Context:  verifies.Range.Ptr(),
+				Extra:    diagnostics.CodeInvalidVerifies,
 			})
 
 			continue
@@ -640,6 +718,7 @@ func (ss *ScenarioStep) decodeAndValidateVerifies(content *hcl.BodyContent, ctx
 				Summary:  "quality verification of " + quality.Name + " cannot be specified more than once",
 				Subject:  verifies.Expr.Range().Ptr(),
 				Context:  verifies.Range.Ptr(),
+				Extra:    diagnostics.CodeInvalidVerifies,
 			})
 
 			continue
@@ -679,6 +758,7 @@ func (ss *ScenarioStep) decodeAndValidateProvidersAttribute(content *hcl.BodyCon
 			Summary:  "providers value must be a known object",
 			Subject:  providers.Expr.Range().Ptr(),
 			Context:  providers.Range.Ptr(),
+			Extra:    diagnostics.CodeInvalidProviderReference,
 		})
 	}
 
@@ -691,6 +771,7 @@ func (ss *ScenarioStep) decodeAndValidateProvidersAttribute(content *hcl.BodyCon
 			Detail:   err.Error(),
 			Subject:  providers.Expr.Range().Ptr(),
 			Context:  providers.Range.Ptr(),
+			Extra:    diagnostics.CodeUndefinedProvider,
 		})
 	}
 
@@ -702,6 +783,7 @@ func (ss *ScenarioStep) decodeAndValidateProvidersAttribute(content *hcl.BodyCon
 			Summary:  "cannot set provider as no providers have been defined",
 			Subject:  providers.Expr.Range().Ptr(),
 			Context:  providers.Range.Ptr(),
+			Extra:    diagnostics.CodeUndefinedProvider,
 		})
 	}
 	for pType, pVals := range definedProviders.AsValueMap() {
@@ -723,6 +805,7 @@ func (ss *ScenarioStep) decodeAndValidateProvidersAttribute(content *hcl.BodyCon
 				Summary:  fmt.Sprintf("provider type %s is not defined", pType),
 				Subject:  providers.Expr.Range().Ptr(),
 				Context:  providers.Range.Ptr(),
+				Extra:    diagnostics.CodeUndefinedProvider,
 			})
 		}
 
@@ -733,6 +816,7 @@ func (ss *ScenarioStep) decodeAndValidateProvidersAttribute(content *hcl.BodyCon
 				Summary:  fmt.Sprintf("alias %s for provider type %s is not defined", pAlias, pType),
 				Subject:  providers.Expr.Range().Ptr(),
 				Context:  providers.Range.Ptr(),
+				Extra:    diagnostics.CodeUndefinedProvider,
 			})
 		}
 
@@ -756,6 +840,7 @@ func (ss *ScenarioStep) decodeAndValidateProvidersAttribute(content *hcl.BodyCon
 					Detail:   fmt.Sprintf("provider value %s is not a valid provider address", providerVal.AsString()),
 					Subject:  providers.Expr.Range().Ptr(),
 					Context:  providers.Range.Ptr(),
+					Extra:    diagnostics.CodeInvalidProviderReference,
 				})
 
 				continue
@@ -781,6 +866,7 @@ func (ss *ScenarioStep) decodeAndValidateProvidersAttribute(content *hcl.BodyCon
 					Detail:   fmt.Sprintf(`provider alias %s has been set to %s.%s. Providers that have been labeled as "default" cannot be used as aliases because they are always available to scenario`, providerImportName, parts[0], parts[1]),
 					Subject:  providers.Expr.Range().Ptr(),
 					Context:  providers.Range.Ptr(),
+					Extra:    diagnostics.CodeDefaultProviderAlias,
 				})
 
 				continue
@@ -796,6 +882,7 @@ func (ss *ScenarioStep) decodeAndValidateProvidersAttribute(content *hcl.BodyCon
 					Detail:   err.Error(),
 					Subject:  providers.Expr.Range().Ptr(),
 					Context:  providers.Range.Ptr(),
+					Extra:    diagnostics.CodeInvalidProviderReference,
 				})
 
 				continue
@@ -814,6 +901,7 @@ func (ss *ScenarioStep) decodeAndValidateProvidersAttribute(content *hcl.BodyCon
 				Detail:   err.Error(),
 				Subject:  providers.Expr.Range().Ptr(),
 				Context:  providers.Range.Ptr(),
+				Extra:    diagnostics.CodeInvalidProviderReference,
 			})
 
 			continue
@@ -837,6 +925,7 @@ func (ss *ScenarioStep) decodeAndValidateProvidersAttribute(content *hcl.BodyCon
 				Detail:   fmt.Sprintf(`provider alias %s has been set to %s.%s. Providers that have been labeled as "default" cannot be used as aliases because they are always available to scenario`, providerImportName, provider.Type, provider.Alias),
 				Subject:  providers.Expr.Range().Ptr(),
 				Context:  providers.Range.Ptr(),
+				Extra:    diagnostics.CodeDefaultProviderAlias,
 			})
 
 			continue
@@ -848,6 +937,7 @@ func (ss *ScenarioStep) decodeAndValidateProvidersAttribute(content *hcl.BodyCon
 				Summary:  "provider arguments don't match defined provider",
 				Subject:  providers.Expr.Range().Ptr(),
 				Context:  providers.Range.Ptr(),
+				Extra:    diagnostics.CodeInvalidProviderReference,
 			})
 		}
 
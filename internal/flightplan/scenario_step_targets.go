@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// Dependencies returns the names of the steps that the step depends on, either explicitly with
+// depends_on or implicitly by referring to step outputs in its variables.
+func (ss *ScenarioStep) Dependencies() []string {
+	deps := map[string]struct{}{}
+	for _, dep := range ss.DependsOn {
+		deps[dep] = struct{}{}
+	}
+
+	if ss.Module != nil {
+		for _, attr := range ss.Module.Attrs {
+			stepVar, diags := StepVariableFromVal(attr)
+			if diags.HasErrors() || len(stepVar.Traversal) < 2 {
+				continue
+			}
+
+			// The traversal root is renamed to "module" when the module is generated.
+			switch stepVar.Traversal.RootName() {
+			case "step", "module":
+			default:
+				continue
+			}
+
+			if name, ok := stepVar.Traversal[1].(hcl.TraverseAttr); ok {
+				deps[name.Name] = struct{}{}
+			}
+		}
+	}
+
+	res := []string{}
+	for dep := range deps {
+		res = append(res, dep)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// TargetSteps takes the names of steps to target and steps to start from and returns the names
+// of every step that has to be applied in scenario step order. Targeting a step includes every
+// step that it depends upon. Starting from a step includes every step that depends on it.
+func (s *Scenario) TargetSteps(targets []string, from []string) ([]string, error) {
+	deps := map[string][]string{}
+	dependents := map[string][]string{}
+	for _, step := range s.Steps {
+		deps[step.Name] = step.Dependencies()
+		for _, dep := range deps[step.Name] {
+			dependents[dep] = append(dependents[dep], step.Name)
+		}
+	}
+
+	selected := map[string]struct{}{}
+	var walk func(name string, graph map[string][]string)
+	walk = func(name string, graph map[string][]string) {
+		if _, ok := selected[name]; ok {
+			return
+		}
+		selected[name] = struct{}{}
+		for _, next := range graph[name] {
+			walk(next, graph)
+		}
+	}
+
+	for _, name := range append(slices.Clone(targets), from...) {
+		if _, ok := deps[name]; !ok {
+			return nil, fmt.Errorf("scenario %s has no step named %s", s.String(), name)
+		}
+	}
+	for _, name := range targets {
+		walk(name, deps)
+	}
+	for _, name := range from {
+		walk(name, dependents)
+	}
+
+	res := []string{}
+	for _, step := range s.Steps {
+		if _, ok := selected[step.Name]; !ok {
+			continue
+		}
+
+		// Skipped steps are not part of the generated module
+		if step.Skip {
+			continue
+		}
+
+		res = append(res, step.Name)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flightplan
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestScenarioTargetSteps tests that targeted steps include their dependency closure.
+func TestScenarioTargetSteps(t *testing.T) {
+	t.Parallel()
+
+	modulePath, err := filepath.Abs("./tests/simple_module")
+	require.NoError(t, err)
+
+	fp, err := testDecodeHCL(t, []byte(fmt.Sprintf(`
+module "simple" {
+  source = "%s"
+}
+
+scenario "test" {
+  step "infra" {
+    module = module.simple
+  }
+
+  step "artifact" {
+    module = module.simple
+  }
+
+  step "cluster" {
+    module = module.simple
+
+    variables {
+      vpc_id = step.infra.id
+    }
+  }
+
+  step "install" {
+    module     = module.simple
+    depends_on = [step.artifact, step.cluster]
+  }
+
+  step "verify" {
+    module = module.simple
+
+    variables {
+      addr = step.install.addr
+    }
+  }
+
+  step "verify_other" {
+    module = module.simple
+
+    variables {
+      addr = step.cluster.addr
+    }
+  }
+}
+`, modulePath)), DecodeTargetAll)
+	require.NoError(t, err)
+	require.Len(t, fp.Scenarios(), 1)
+	scenario := fp.Scenarios()[0]
+
+	for desc, test := range map[string]struct {
+		targets  []string
+		from     []string
+		expected []string
+		fail     bool
+	}{
+		"target": {
+			targets:  []string{"verify"},
+			expected: []string{"infra", "artifact", "cluster", "install", "verify"},
+		},
+		"target implicit dependency": {
+			targets:  []string{"verify_other"},
+			expected: []string{"infra", "cluster", "verify_other"},
+		},
+		"from": {
+			from:     []string{"install"},
+			expected: []string{"install", "verify"},
+		},
+		"from and target": {
+			targets:  []string{"artifact"},
+			from:     []string{"cluster"},
+			expected: []string{"artifact", "cluster", "install", "verify", "verify_other"},
+		},
+		"unknown step": {
+			targets: []string{"nope"},
+			fail:    true,
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			steps, err := scenario.TargetSteps(test.targets, test.from)
+			if test.fail {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, steps)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zclconf/go-cty/cty"
@@ -358,6 +359,69 @@ scenario "skipper" {
 				},
 			},
 		},
+		{
+			desc: "step timeout invalid value",
+			fail: true,
+			hcl: fmt.Sprintf(`
+module "one" {
+  source = "%s"
+}
+
+scenario "timeout" {
+  step "one" {
+    module  = module.one
+    timeout = "ten minutes"
+  }
+}
+`, modulePath),
+		},
+		{
+			desc: "step timeout valid",
+			hcl: fmt.Sprintf(`
+module "one" {
+  source = "%s"
+}
+
+scenario "timeout" {
+  step "one" {
+    module  = module.one
+    timeout = "10m"
+  }
+}
+`, modulePath),
+			expected: &FlightPlan{
+				TerraformCLIs: []*TerraformCLI{
+					DefaultTerraformCLI(),
+				},
+				Modules: []*Module{
+					{
+						Name:   "one",
+						Source: modulePath,
+					},
+				},
+				ScenarioBlocks: ScenarioBlocks{
+					{
+						Name: "timeout",
+						Scenarios: []*Scenario{
+							{
+								Name:         "timeout",
+								TerraformCLI: DefaultTerraformCLI(),
+								Steps: []*ScenarioStep{
+									{
+										Name:    "one",
+										Timeout: 10 * time.Minute,
+										Module: &Module{
+											Name:   "one",
+											Source: modulePath,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			desc: "step depends_on invalid string",
 			fail: true,
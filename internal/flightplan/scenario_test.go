@@ -7,9 +7,13 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 )
 
 // Test_Decode_Scenario tests decoding a scenario.
@@ -205,6 +209,76 @@ scenario "backend" {
 				},
 			},
 		},
+		{
+			desc: "failure policy",
+			hcl: fmt.Sprintf(`
+module "backend" {
+  source = "%s"
+}
+
+scenario "backend" {
+  failure_policy = "keep-for=30m"
+
+  step "first" {
+    module = module.backend
+  }
+}
+`, modulePath),
+			expected: &FlightPlan{
+				TerraformCLIs: []*TerraformCLI{
+					DefaultTerraformCLI(),
+				},
+				Modules: []*Module{
+					{
+						Name:   "backend",
+						Source: modulePath,
+						Attrs:  map[string]cty.Value{},
+					},
+				},
+				ScenarioBlocks: ScenarioBlocks{
+					{
+						Name: "backend",
+						Scenarios: []*Scenario{
+							{
+								Name:         "backend",
+								TerraformCLI: DefaultTerraformCLI(),
+								FailurePolicy: &pb.Operation_FailurePolicy{
+									Type:    pb.Operation_FailurePolicy_TYPE_KEEP_FOR,
+									KeepFor: durationpb.New(30 * time.Minute),
+								},
+								Steps: []*ScenarioStep{
+									{
+										Name: "first",
+										Module: &Module{
+											Name:   "backend",
+											Source: modulePath,
+											Attrs:  map[string]cty.Value{},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "invalid failure policy",
+			fail: true,
+			hcl: fmt.Sprintf(`
+module "backend" {
+  source = "%s"
+}
+
+scenario "backend" {
+  failure_policy = "explode"
+
+  step "first" {
+    module = module.backend
+  }
+}
+`, modulePath),
+		},
 	} {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
@@ -10,6 +10,7 @@ import (
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/convert"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/ext/customdecode"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
@@ -95,6 +96,7 @@ func absTraversalForExpr(expr hcl.Expression, ctx *hcl.EvalContext) (hcl.Travers
 					Detail:   err.Error(),
 					Subject:  t.StartRange().Ptr(),
 					Context:  t.SrcRange.Ptr(),
+					Extra:    diagnostics.CodeUnknowableStepVariable,
 				}}
 			}
 			// Add our known index value to the traversal and set the next
@@ -231,6 +233,7 @@ func init() {
 								Context:  expr.Range().Ptr(),
 								Summary:  "step variable is unknowable",
 								Detail:   "step variables can only be unknown if the value is a reference to a step module output",
+								Extra:    diagnostics.CodeUnknowableStepVariable,
 							})
 						}
 
@@ -242,6 +245,7 @@ func init() {
 								Summary:  "no previous steps have been defined",
 								Subject:  traversal.SourceRange().Ptr(),
 								Context:  expr.Range().Ptr(),
+								Extra:    diagnostics.CodeUnknownStepReference,
 							})
 						}
 
@@ -252,6 +256,7 @@ func init() {
 								Summary:  "invalid step traversal",
 								Subject:  traversal.SourceRange().Ptr(),
 								Context:  expr.Range().Ptr(),
+								Extra:    diagnostics.CodeUnknownStepReference,
 							})
 						}
 
@@ -262,6 +267,7 @@ func init() {
 								Summary:  fmt.Sprintf("no step named %s has been previously defined", stepName.Name),
 								Subject:  stepName.SourceRange().Ptr(),
 								Context:  traversal.SourceRange().Ptr(),
+								Extra:    diagnostics.CodeUnknownStepReference,
 							})
 						}
 
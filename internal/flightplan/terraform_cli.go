@@ -9,6 +9,7 @@ import (
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/convert"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hcldec"
 )
@@ -192,6 +193,7 @@ func (m *TerraformCLI) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagno
 					Detail:   "terraform_cli path must be a string value",
 					Subject:  path.Expr.Range().Ptr(),
 					Context:  hcl.RangeBetween(path.Expr.StartRange(), path.Expr.Range()).Ptr(),
+					Extra:    diagnostics.CodeInvalidTerraformCLI,
 				})
 			} else {
 				diags = diags.Append(&hcl.Diagnostic{
@@ -200,6 +202,7 @@ func (m *TerraformCLI) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagno
 					Detail:   "terraform_cli should be a string value, consider changing it",
 					Subject:  path.Expr.Range().Ptr(),
 					Context:  hcl.RangeBetween(path.Expr.StartRange(), path.Expr.Range()).Ptr(),
+					Extra:    diagnostics.CodeInvalidTerraformCLI,
 				})
 				m.Path = pathVal.AsString()
 			}
@@ -228,6 +231,7 @@ func (m *TerraformCLI) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagno
 						Detail:   "terraform_cli env must be a map of strings",
 						Subject:  env.Range.Ptr(),
 						Context:  hcl.RangeBetween(env.Expr.StartRange(), env.Expr.Range()).Ptr(),
+						Extra:    diagnostics.CodeInvalidTerraformCLI,
 					})
 				} else {
 					diags = diags.Append(&hcl.Diagnostic{
@@ -236,6 +240,7 @@ func (m *TerraformCLI) decode(block *hcl.Block, ctx *hcl.EvalContext) hcl.Diagno
 						Detail:   "terraform_cli env should be a map of strings, consider changing them",
 						Subject:  env.Range.Ptr(),
 						Context:  hcl.RangeBetween(env.Expr.StartRange(), env.Expr.Range()).Ptr(),
+						Extra:    diagnostics.CodeInvalidTerraformCLI,
 					})
 					m.Env[k] = vVal.AsString()
 				}
@@ -6,9 +6,12 @@ package flightplan
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"sort"
 
 	"github.com/zclconf/go-cty/cty"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hcldec"
 )
@@ -33,6 +36,13 @@ type TerraformSettingBackend struct {
 	Workspaces cty.Value
 }
 
+// scenarioBackendAttrType is a cty capsule type that carries a backend attribute expression that
+// refers to the "scenario" object. As terraform blocks are decoded before any scenario, these
+// attributes are evaluated when a scenario that uses the terraform settings is decoded.
+var scenarioBackendAttrType = cty.Capsule(
+	"scenario_backend_attr", reflect.TypeOf((*hcl.Expression)(nil)).Elem(),
+)
+
 // NewTerraformSetting returns a new TerraformSetting.
 func NewTerraformSetting() *TerraformSetting {
 	return &TerraformSetting{
@@ -123,6 +133,7 @@ func (t *TerraformSetting) ensureOnlyCloudOrBackendDefined(body hcl.Body) hcl.Di
 				Detail:   "cloud blocks are not supported if backend blocks have been defined",
 				Subject:  cloud.TypeRange.Ptr(),
 				Context:  cloud.DefRange.Ptr(),
+				Extra:    diagnostics.CodeInvalidTerraformSetting,
 			})
 		}
 
@@ -133,6 +144,7 @@ func (t *TerraformSetting) ensureOnlyCloudOrBackendDefined(body hcl.Body) hcl.Di
 				Detail:   "backend blocks are not supported if a cloud block has been defined",
 				Subject:  backend.TypeRange.Ptr(),
 				Context:  backend.DefRange.Ptr(),
+				Extra:    diagnostics.CodeInvalidTerraformSetting,
 			})
 		}
 	}
@@ -250,6 +262,7 @@ func (t *TerraformSetting) decodeRequiredProviders(ctx *hcl.EvalContext, content
 					Detail:   attr.Name + " required_providers value is not fully known",
 					Subject:  attr.Expr.Range().Ptr(),
 					Context:  attr.Range.Ptr(),
+					Extra:    diagnostics.CodeInvalidTerraformSetting,
 				})
 
 				continue
@@ -262,6 +275,7 @@ func (t *TerraformSetting) decodeRequiredProviders(ctx *hcl.EvalContext, content
 					Detail:   attr.Name + " value must be an object",
 					Subject:  attr.Expr.Range().Ptr(),
 					Context:  attr.Range.Ptr(),
+					Extra:    diagnostics.CodeInvalidTerraformSetting,
 				})
 
 				continue
@@ -277,6 +291,7 @@ func (t *TerraformSetting) decodeRequiredProviders(ctx *hcl.EvalContext, content
 						Detail:   attr.Name + " is not an allowed required_providers attribute",
 						Subject:  attr.Expr.Range().Ptr(),
 						Context:  attr.Range.Ptr(),
+						Extra:    diagnostics.CodeInvalidTerraformSetting,
 					})
 
 					continue
@@ -332,6 +347,7 @@ func (t *TerraformSetting) decodeBackend(ctx *hcl.EvalContext, content *hcl.Body
 				Detail:   "only one backend block is allowed to be defined",
 				Subject:  block.TypeRange.Ptr(),
 				Context:  block.DefRange.Ptr(),
+				Extra:    diagnostics.CodeInvalidTerraformSetting,
 			})
 
 			continue
@@ -373,6 +389,13 @@ func (t *TerraformSetting) decodeBackend(ctx *hcl.EvalContext, content *hcl.Body
 		if remain != nil {
 			attrs, _ := remain.JustAttributes()
 			for _, attr := range attrs {
+				if referencesScenario(attr.Expr) {
+					expr := attr.Expr
+					backend.Attrs[attr.Name] = cty.CapsuleVal(scenarioBackendAttrType, &expr)
+
+					continue
+				}
+
 				val, moreDiags := attr.Expr.Value(ctx)
 				diags = diags.Extend(moreDiags)
 				if moreDiags != nil && moreDiags.HasErrors() {
@@ -388,6 +411,72 @@ func (t *TerraformSetting) decodeBackend(ctx *hcl.EvalContext, content *hcl.Body
 	return diags
 }
 
+// decodeScenarioBackendAttrs evaluates backend attributes that refer to the "scenario" object with
+// the scenario eval context.
+func (t *TerraformSetting) decodeScenarioBackendAttrs(ctx *hcl.EvalContext) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	if t.Backend == nil {
+		return diags
+	}
+
+	names := []string{}
+	for name := range t.Backend.Attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		val := t.Backend.Attrs[name]
+		if !val.Type().Equals(scenarioBackendAttrType) {
+			continue
+		}
+
+		expr, ok := val.EncapsulatedValue().(*hcl.Expression)
+		if !ok {
+			return diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "invalid backend attribute",
+				Detail:   fmt.Sprintf("backend attribute %s is not an expression", name),
+				Extra:    diagnostics.CodeInvalidTerraformSetting,
+			})
+		}
+
+		val, moreDiags := (*expr).Value(ctx)
+		diags = diags.Extend(moreDiags)
+		if moreDiags != nil && moreDiags.HasErrors() {
+			continue
+		}
+
+		if !val.IsWhollyKnown() {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "backend attribute must be known",
+				Detail:   fmt.Sprintf("backend attribute %s must be a known value", name),
+				Subject:  (*expr).Range().Ptr(),
+				Extra:    diagnostics.CodeInvalidTerraformSetting,
+			})
+
+			continue
+		}
+
+		t.Backend.Attrs[name] = val
+	}
+
+	return diags
+}
+
+// referencesScenario returns whether or not the expression refers to the "scenario" object.
+func referencesScenario(expr hcl.Expression) bool {
+	for _, traversal := range expr.Variables() {
+		if traversal.RootName() == "scenario" {
+			return true
+		}
+	}
+
+	return false
+}
+
 // FromCtyValue takes a cty.Value and unmasharls the value onto itself. Any
 // errors that are encountered are returned. It is expected that the cty.Value
 // is the cty.Value in the eval context.
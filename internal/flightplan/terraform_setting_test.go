@@ -998,3 +998,74 @@ func Test_TerraformSettings_Cty_RoundTrip(t *testing.T) {
 	require.NoError(t, clone.FromCtyValue(setting.ToCtyValue()))
 	require.Equal(t, setting, clone)
 }
+
+// Test_Decode_TerraformSettings_ScenarioBackend tests that backend attributes can refer to the
+// scenario that uses the terraform settings.
+func Test_Decode_TerraformSettings_ScenarioBackend(t *testing.T) {
+	t.Parallel()
+
+	modulePath, err := filepath.Abs("./tests/simple_module")
+	require.NoError(t, err)
+
+	fp, err := testDecodeHCL(t, []byte(fmt.Sprintf(`
+terraform "default" {
+  backend "s3" {
+    bucket = "enos-state"
+    key    = "${scenario.name}/${scenario.variants.arch}/${scenario.uid}.tfstate"
+  }
+}
+
+module "backend" {
+  source = "%s"
+}
+
+scenario "backend" {
+  matrix {
+    arch = ["amd64", "arm64"]
+  }
+
+  description = "backend on ${matrix.arch}"
+
+  step "first" {
+    module = module.backend
+
+    variables {
+      name = "${scenario.filter}: ${scenario.description}"
+    }
+  }
+}
+`, modulePath)), DecodeTargetAll)
+	require.NoError(t, err)
+	require.Len(t, fp.Scenarios(), 2)
+
+	for _, scenario := range fp.Scenarios() {
+		arch := scenario.Variants.Elements()[0].Val
+		require.Equal(t,
+			cty.StringVal(fmt.Sprintf("backend/%s/%s.tfstate", arch, scenario.UID())),
+			scenario.TerraformSetting.Backend.Attrs["key"],
+		)
+		require.Equal(t, cty.StringVal("enos-state"), scenario.TerraformSetting.Backend.Attrs["bucket"])
+
+		stepVar, diags := StepVariableFromVal(scenario.Steps[0].Module.Attrs["name"])
+		require.False(t, diags.HasErrors(), diags.Error())
+		require.Equal(t,
+			cty.StringVal(fmt.Sprintf("backend arch:%s: backend on %s", arch, arch)),
+			stepVar.Value,
+		)
+	}
+
+	// The scenario object is only available in scenarios
+	_, err = testDecodeHCL(t, []byte(fmt.Sprintf(`
+module "backend" {
+  source = "%s"
+  name   = scenario.name
+}
+
+scenario "backend" {
+  step "first" {
+    module = module.backend
+  }
+}
+`, modulePath)), DecodeTargetAll)
+	require.Error(t, err)
+}
@@ -5,14 +5,18 @@ package flightplan
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/convert"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/ext/typeexpr"
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
 )
 
 var variableSchema = &hcl.BodySchema{
@@ -27,6 +31,13 @@ var variableSchema = &hcl.BodySchema{
 	},
 }
 
+var variableValidationSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "condition", Required: true},
+		{Name: "error_message", Required: true},
+	},
+}
+
 // Variable represents a "variable" block in a module or file.
 type Variable struct {
 	Name           string
@@ -34,8 +45,20 @@ type Variable struct {
 	Sensitive      bool
 	Default        cty.Value
 	SetValue       cty.Value
+	SetValueSource VariableValueSource
+	SetValueRange  hcl.Range
 	Type           cty.Type
 	ConstraintType cty.Type
+	Validations    []*VariableValidation
+	defaultRange   hcl.Range
+}
+
+// VariableValidation is a "validation" block of a variable. The condition must only refer to the
+// variable that it validates and must be true for the variable value to be valid.
+type VariableValidation struct {
+	Condition    hcl.Expression
+	ErrorMessage hcl.Expression
+	DeclRange    hcl.Range
 }
 
 // VariableValue is a user supplied variable value.
@@ -106,15 +129,29 @@ func (v *Variable) decode(block *hcl.Block, values map[string]*VariableValue) hc
 					Summary:  "Invalid default value for variable",
 					Detail:   fmt.Sprintf("This default value is not compatible with the variable's type constraint: %s.", err),
 					Subject:  attr.Expr.Range().Ptr(),
+					Extra:    diagnostics.CodeInvalidVariableDefault,
 				})
 				val = cty.DynamicVal
 			}
 		}
 
 		v.Default = val
+		v.defaultRange = attr.Expr.Range()
+	}
+
+	for _, block := range content.Blocks.OfType(blockTypeValidation) {
+		validation, moreDiags := v.decodeValidation(block)
+		diags = diags.Extend(moreDiags)
+		if moreDiags != nil && moreDiags.HasErrors() {
+			continue
+		}
+		v.Validations = append(v.Validations, validation)
 	}
 
 	if setVal, ok := values[v.Name]; ok {
+		v.SetValueSource = setVal.Source
+		v.SetValueRange = setVal.Range
+
 		switch setVal.Source {
 		case VariableValueSourceEnvVar:
 			// Env vars are tricky. They might be a string or a complex type.
@@ -179,6 +216,7 @@ func (v *Variable) decode(block *hcl.Block, values map[string]*VariableValue) hc
 					Summary:  "Invalid value for variable",
 					Detail:   fmt.Sprintf("This default value is not compatible with the variable's type constraint: %s.", err),
 					Subject:  setVal.Range.Ptr(),
+					Extra:    diagnostics.CodeInvalidVariableValue,
 				})
 				v.SetValue = cty.DynamicVal
 			}
@@ -188,6 +226,116 @@ func (v *Variable) decode(block *hcl.Block, values map[string]*VariableValue) hc
 	return diags
 }
 
+// decodeValidation decodes a "validation" block and ensures that the condition only refers to the
+// variable.
+func (v *Variable) decodeValidation(block *hcl.Block) (*VariableValidation, hcl.Diagnostics) {
+	content, diags := block.Body.Content(variableValidationSchema)
+	diags = diagnostics.WithCode(diags, diagnostics.CodeInvalidVariableValidation)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	validation := &VariableValidation{
+		Condition:    content.Attributes["condition"].Expr,
+		ErrorMessage: content.Attributes["error_message"].Expr,
+		DeclRange:    block.DefRange,
+	}
+
+	for _, traversal := range validation.Condition.Variables() {
+		if traversal.RootName() == "var" && len(traversal) > 1 {
+			if attr, ok := traversal[1].(hcl.TraverseAttr); ok && attr.Name == v.Name {
+				continue
+			}
+		}
+
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid reference in variable validation",
+			Detail:   fmt.Sprintf("The condition for variable %q can only refer to the variable itself, using var.%s.", v.Name, v.Name),
+			Subject:  traversal.SourceRange().Ptr(),
+			Context:  validation.Condition.Range().Ptr(),
+			Extra:    diagnostics.CodeInvalidVariableValidation,
+		})
+	}
+
+	return validation, diags
+}
+
+// validate evaluates the validation conditions against the variable value. Failures refer to the
+// source of the value, either the variables file, the environment variable, or the default.
+func (v *Variable) validate(ctx *hcl.EvalContext, setVal *VariableValue) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	val := v.Value()
+	if len(v.Validations) == 0 || val == cty.NilVal || !val.IsWhollyKnown() {
+		return diags
+	}
+
+	subject := v.defaultRange
+	source := "default value"
+	if setVal != nil {
+		subject = setVal.Range
+		switch setVal.Source {
+		case VariableValueSourceEnvVar:
+			source = "value set by " + EnvVarPrefix + v.Name
+		case VariableValueSourceVarsFile:
+			source = "value set in " + setVal.Range.Filename
+		case VariableValueSourceUnknown:
+			source = "value"
+		default:
+			source = "value"
+		}
+	}
+
+	valCtx := ctx.NewChild()
+	valCtx.Variables = map[string]cty.Value{
+		"var": cty.ObjectVal(map[string]cty.Value{v.Name: val}),
+	}
+
+	for _, validation := range v.Validations {
+		result, moreDiags := validation.Condition.Value(valCtx)
+		diags = diags.Extend(moreDiags)
+		if moreDiags != nil && moreDiags.HasErrors() {
+			continue
+		}
+
+		result, err := convert.Convert(result, cty.Bool)
+		if err != nil || result.IsNull() || !result.IsKnown() {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid variable validation result",
+				Detail:   "The validation condition must return a boolean value.",
+				Subject:  validation.Condition.Range().Ptr(),
+				Context:  validation.DeclRange.Ptr(),
+				Extra:    diagnostics.CodeInvalidVariableValidation,
+			})
+
+			continue
+		}
+
+		if result.True() {
+			continue
+		}
+
+		msg := fmt.Sprintf("The %s for variable %q is not valid.", source, v.Name)
+		errMsg, moreDiags := validation.ErrorMessage.Value(valCtx)
+		if !moreDiags.HasErrors() && !errMsg.IsNull() && errMsg.IsKnown() && errMsg.Type() == cty.String {
+			msg = fmt.Sprintf("%s\n\n%s", errMsg.AsString(), msg)
+		}
+
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid value for variable",
+			Detail:   msg,
+			Subject:  subject.Ptr(),
+			Context:  validation.DeclRange.Ptr(),
+			Extra:    diagnostics.CodeVariableValidationFailed,
+		})
+	}
+
+	return diags
+}
+
 // Value returns either the user-supplied value or the default. If no values have
 // been set it will always return a NilVal.
 func (v *Variable) Value() cty.Value {
@@ -201,3 +349,110 @@ func (v *Variable) Value() cty.Value {
 
 	return cty.NilVal
 }
+
+// ToProto returns the variable as a proto message. The value of sensitive variables is redacted.
+func (v *Variable) ToProto() *pb.Variable {
+	pv := &pb.Variable{
+		Name:        v.Name,
+		Description: v.Description,
+		Sensitive:   v.Sensitive,
+		Type:        "any",
+	}
+
+	if v.ConstraintType != cty.NilType {
+		pv.Type = typeexpr.TypeString(v.ConstraintType)
+	}
+
+	switch {
+	case v.SetValue != cty.NilVal:
+		pv.Range = variableRangeToProto(v.SetValueRange)
+		switch v.SetValueSource {
+		case VariableValueSourceEnvVar:
+			pv.Source = pb.Variable_SOURCE_ENV_VAR
+		case VariableValueSourceVarsFile:
+			pv.Source = pb.Variable_SOURCE_VARS_FILE
+		case VariableValueSourceUnknown:
+			pv.Source = pb.Variable_SOURCE_UNSPECIFIED
+		default:
+			pv.Source = pb.Variable_SOURCE_UNSPECIFIED
+		}
+	case v.Default != cty.NilVal:
+		pv.Source = pb.Variable_SOURCE_DEFAULT
+		pv.Range = variableRangeToProto(v.defaultRange)
+	default:
+		pv.Source = pb.Variable_SOURCE_UNSET
+
+		return pv
+	}
+
+	if !v.Sensitive {
+		pv.Value = variableValueString(v.Value())
+	}
+
+	return pv
+}
+
+// ToProto returns the variable value of an undeclared variable as a proto message.
+func (v *VariableValue) ToProto(name string) *pb.Variable {
+	pv := &pb.Variable{
+		Name:   name,
+		Source: pb.Variable_SOURCE_UNDECLARED,
+		Range:  variableRangeToProto(v.Range),
+	}
+
+	if v.Source == VariableValueSourceEnvVar {
+		pv.Value = v.EnvVarRaw
+	} else if v.Expr != nil {
+		if val, diags := v.Expr.Value(nil); !diags.HasErrors() {
+			pv.Value = variableValueString(val)
+		}
+	}
+
+	return pv
+}
+
+// variableValueString returns the HCL representation of a variable value on a single line.
+func variableValueString(val cty.Value) string {
+	if val == cty.NilVal || !val.IsWhollyKnown() {
+		return ""
+	}
+
+	lines := strings.Split(string(hclwrite.TokensForValue(val).Bytes()), "\n")
+	out := strings.Builder{}
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if i > 0 {
+			prev := strings.TrimSpace(lines[i-1])
+			switch {
+			case strings.HasSuffix(prev, "{"), strings.HasSuffix(prev, "["),
+				strings.HasPrefix(line, "}"), strings.HasPrefix(line, "]"):
+				out.WriteString(" ")
+			default:
+				out.WriteString(", ")
+			}
+		}
+		out.WriteString(strings.TrimSuffix(line, ","))
+	}
+
+	return out.String()
+}
+
+func variableRangeToProto(rng hcl.Range) *pb.Range {
+	if rng.Filename == "" {
+		return nil
+	}
+
+	return &pb.Range{
+		Filename: rng.Filename,
+		Start: &pb.Range_Pos{
+			Line:   int64(rng.Start.Line),
+			Column: int64(rng.Start.Column),
+			Byte:   int64(rng.Start.Byte),
+		},
+		End: &pb.Range_Pos{
+			Line:   int64(rng.End.Line),
+			Column: int64(rng.End.Column),
+			Byte:   int64(rng.End.Byte),
+		},
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/zclconf/go-cty/cty"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclparse"
 )
@@ -180,3 +182,237 @@ variable "complex" {
 		})
 	}
 }
+
+func Test_Validate_Variable(t *testing.T) {
+	t.Parallel()
+
+	fileRng := hcl.Range{
+		Filename: "enos.vars.hcl",
+		Start:    hcl.Pos{Line: 1, Column: 1},
+		End:      hcl.Pos{Line: 1, Column: 20},
+	}
+
+	enosCfg := `
+variable "region" {
+  type    = string
+  default = "us-east-1"
+
+  validation {
+    condition     = contains(["us-east-1", "us-west-2"], var.region)
+    error_message = "The region must be us-east-1 or us-west-2."
+  }
+
+  validation {
+    condition     = startswith(var.region, "us-")
+    error_message = "The region must be in the US."
+  }
+}
+`
+
+	for _, test := range []struct {
+		desc     string
+		cfg      string
+		vars     map[string]*VariableValue
+		failures int
+		code     diagnostics.Code
+	}{
+		{
+			desc: "valid default",
+		},
+		{
+			desc: "valid from file",
+			vars: map[string]*VariableValue{
+				"region": {
+					Source: VariableValueSourceVarsFile,
+					Expr:   hcl.StaticExpr(cty.StringVal("us-west-2"), fileRng),
+					Range:  fileRng,
+				},
+			},
+		},
+		{
+			desc: "invalid from file",
+			vars: map[string]*VariableValue{
+				"region": {
+					Source: VariableValueSourceVarsFile,
+					Expr:   hcl.StaticExpr(cty.StringVal("us-wset-2"), fileRng),
+					Range:  fileRng,
+				},
+			},
+			failures: 1,
+			code:     diagnostics.CodeVariableValidationFailed,
+		},
+		{
+			desc: "invalid from env",
+			vars: map[string]*VariableValue{
+				"region": {
+					Source:    VariableValueSourceEnvVar,
+					EnvVarRaw: "eu-west-1",
+					Range:     fileRng,
+				},
+			},
+			failures: 2,
+			code:     diagnostics.CodeVariableValidationFailed,
+		},
+		{
+			desc: "condition refers to another variable",
+			cfg: `
+variable "region" {
+  type = string
+
+  validation {
+    condition     = var.region != var.other
+    error_message = "The region must not be the other region."
+  }
+}
+`,
+			failures: 1,
+			code:     diagnostics.CodeInvalidVariableValidation,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := enosCfg
+			if test.cfg != "" {
+				cfg = test.cfg
+			}
+
+			parser := hclparse.NewParser()
+			f, diags := parser.ParseHCL([]byte(cfg), "variable.hcl")
+			require.False(t, diags.HasErrors(), diags.Error())
+			content, diags := f.Body.Content(&hcl.BodySchema{
+				Blocks: []hcl.BlockHeaderSchema{
+					{Type: blockTypeVariable, LabelNames: []string{attrLabelNameDefault}},
+				},
+			})
+			require.False(t, diags.HasErrors(), diags.Error())
+
+			variable := NewVariable()
+			diags = variable.decode(content.Blocks.OfType(blockTypeVariable)[0], test.vars)
+			if !diags.HasErrors() {
+				diags = variable.validate((&Decoder{}).baseEvalContext(), test.vars["region"])
+			}
+
+			require.Len(t, diags, test.failures, diags.Error())
+			for _, diag := range diags {
+				require.Equal(t, test.code, diag.Extra)
+				if test.code == diagnostics.CodeVariableValidationFailed {
+					require.Equal(t, fileRng, *diag.Subject)
+				}
+			}
+		})
+	}
+}
+
+func Test_Variable_ToProto(t *testing.T) {
+	t.Parallel()
+
+	fileRng := hcl.Range{
+		Filename: "enos.vars.hcl",
+		Start:    hcl.Pos{Line: 2, Column: 1},
+		End:      hcl.Pos{Line: 2, Column: 20},
+	}
+
+	for _, test := range []struct {
+		desc     string
+		cfg      string
+		vars     map[string]*VariableValue
+		expected *pb.Variable
+	}{
+		{
+			desc: "default",
+			cfg: `
+variable "tags" {
+  type    = map(string)
+  default = { a = "b", c = "d" }
+}
+`,
+			expected: &pb.Variable{
+				Name:   "tags",
+				Type:   "map(string)",
+				Value:  `{ a = "b", c = "d" }`,
+				Source: pb.Variable_SOURCE_DEFAULT,
+			},
+		},
+		{
+			desc: "sensitive from file",
+			cfg: `
+variable "token" {
+  type      = string
+  sensitive = true
+}
+`,
+			vars: map[string]*VariableValue{
+				"token": {
+					Source: VariableValueSourceVarsFile,
+					Expr:   hcl.StaticExpr(cty.StringVal("secret"), fileRng),
+					Range:  fileRng,
+				},
+			},
+			expected: &pb.Variable{
+				Name:      "token",
+				Type:      "string",
+				Sensitive: true,
+				Source:    pb.Variable_SOURCE_VARS_FILE,
+			},
+		},
+		{
+			desc: "from env",
+			cfg: `
+variable "region" {
+  default = "us-east-1"
+}
+`,
+			vars: map[string]*VariableValue{
+				"region": {
+					Source:    VariableValueSourceEnvVar,
+					EnvVarRaw: "us-west-2",
+				},
+			},
+			expected: &pb.Variable{
+				Name:   "region",
+				Type:   "any",
+				Value:  `"us-west-2"`,
+				Source: pb.Variable_SOURCE_ENV_VAR,
+			},
+		},
+		{
+			desc: "unset",
+			cfg: `
+variable "region" {
+  type = string
+}
+`,
+			expected: &pb.Variable{
+				Name:   "region",
+				Type:   "string",
+				Source: pb.Variable_SOURCE_UNSET,
+			},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			parser := hclparse.NewParser()
+			f, diags := parser.ParseHCL([]byte(test.cfg), "variable.hcl")
+			require.False(t, diags.HasErrors(), diags.Error())
+			content, diags := f.Body.Content(&hcl.BodySchema{
+				Blocks: []hcl.BlockHeaderSchema{
+					{Type: blockTypeVariable, LabelNames: []string{attrLabelNameDefault}},
+				},
+			})
+			require.False(t, diags.HasErrors(), diags.Error())
+
+			variable := NewVariable()
+			diags = variable.decode(content.Blocks.OfType(blockTypeVariable)[0], test.vars)
+			require.False(t, diags.HasErrors(), diags.Error())
+
+			got := variable.ToProto()
+			require.Equal(t, test.expected.GetName(), got.GetName())
+			require.Equal(t, test.expected.GetType(), got.GetType())
+			require.Equal(t, test.expected.GetValue(), got.GetValue())
+			require.Equal(t, test.expected.GetSensitive(), got.GetSensitive())
+			require.Equal(t, test.expected.GetSource(), got.GetSource())
+		})
+	}
+}
@@ -143,7 +143,12 @@ func (g *Generator) Generate() error {
 		return err
 	}
 
-	return g.generateModule()
+	err = g.generateModule()
+	if err != nil {
+		return err
+	}
+
+	return g.generateManifest()
 }
 
 // TerraformRCPath is where the generated terraform.rc configuration file will
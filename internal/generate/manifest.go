@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ManifestFileName is the name of the manifest file that is written alongside each
+// generated module.
+const ManifestFileName = "enos.manifest.json"
+
+// manifestVersion is the current version of the manifest format.
+const manifestVersion = 1
+
+// Manifest describes a generated module. It's written alongside the module so that
+// the out directory is self-describing, even after the scenario that generated the
+// module is no longer a part of the flight plan.
+type Manifest struct {
+	// Scenario is a reference to the scenario that the module was generated for.
+	Scenario *pb.Ref_Scenario
+	// BaseDir is the directory of the flight plan that contained the scenario.
+	BaseDir string
+	// GeneratedAt is when the module was last generated.
+	GeneratedAt time.Time
+	// ExpiresAt is when the launched module should be reaped. A zero value means it never expires.
+	ExpiresAt time.Time
+}
+
+type manifestJSON struct {
+	Version     int             `json:"version"`
+	Scenario    json.RawMessage `json:"scenario"`
+	BaseDir     string          `json:"base_dir"`
+	GeneratedAt time.Time       `json:"generated_at"`
+	ExpiresAt   *time.Time      `json:"expires_at,omitempty"`
+}
+
+// MarshalJSON marshals the manifest to JSON.
+func (m *Manifest) MarshalJSON() ([]byte, error) {
+	ref, err := protojson.Marshal(m.Scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	mj := &manifestJSON{
+		Version:     manifestVersion,
+		Scenario:    ref,
+		BaseDir:     m.BaseDir,
+		GeneratedAt: m.GeneratedAt,
+	}
+	if !m.ExpiresAt.IsZero() {
+		mj.ExpiresAt = &m.ExpiresAt
+	}
+
+	return json.Marshal(mj)
+}
+
+// UnmarshalJSON unmarshals the manifest from JSON.
+func (m *Manifest) UnmarshalJSON(b []byte) error {
+	mj := &manifestJSON{}
+	if err := json.Unmarshal(b, mj); err != nil {
+		return err
+	}
+
+	if mj.Version != manifestVersion {
+		return fmt.Errorf("unsupported manifest version %d", mj.Version)
+	}
+
+	m.Scenario = &pb.Ref_Scenario{}
+	if len(mj.Scenario) > 0 {
+		if err := protojson.Unmarshal(mj.Scenario, m.Scenario); err != nil {
+			return err
+		}
+	}
+	m.BaseDir = mj.BaseDir
+	m.GeneratedAt = mj.GeneratedAt
+	if mj.ExpiresAt != nil {
+		m.ExpiresAt = *mj.ExpiresAt
+	}
+
+	return nil
+}
+
+// Expired returns whether or not the module has outlived its TTL.
+func (m *Manifest) Expired(now time.Time) bool {
+	if m == nil || m.ExpiresAt.IsZero() {
+		return false
+	}
+
+	return now.After(m.ExpiresAt)
+}
+
+// ManifestPath is where the manifest for the generated module will be written.
+func (g *Generator) ManifestPath() string {
+	return filepath.Join(g.TerraformModuleDir(), ManifestFileName)
+}
+
+// generateManifest writes the manifest of the generated module. If the module has previously been
+// launched with a TTL the expiry is retained.
+func (g *Generator) generateManifest() error {
+	err := g.ensureOutDir()
+	if err != nil {
+		return err
+	}
+
+	manifest := &Manifest{
+		Scenario:    g.Scenario.Ref(),
+		BaseDir:     g.BaseDir,
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	prev, err := (&GeneratedModule{Dir: g.TerraformModuleDir()}).Manifest()
+	if err == nil && prev != nil {
+		manifest.ExpiresAt = prev.ExpiresAt
+	}
+
+	if g.UI != nil {
+		g.UI.Info("writing to " + g.ManifestPath())
+	}
+
+	return writeManifest(g.ManifestPath(), manifest)
+}
+
+// writeManifest writes the manifest to the path.
+func writeManifest(path string, manifest *Manifest) error {
+	bytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bytes, 0o644)
+}
+
+// ManifestPath is the path to the modules manifest.
+func (m *GeneratedModule) ManifestPath() string {
+	return filepath.Join(m.Dir, ManifestFileName)
+}
+
+// Manifest reads the generated modules manifest. Modules that were generated before
+// we wrote manifests will not have one, in which case a nil manifest is returned.
+func (m *GeneratedModule) Manifest() (*Manifest, error) {
+	bytes, err := os.ReadFile(m.ManifestPath())
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	if err = json.Unmarshal(bytes, manifest); err != nil {
+		return nil, fmt.Errorf("reading manifest %s: %w", m.ManifestPath(), err)
+	}
+
+	return manifest, nil
+}
+
+// SetExpiresAt updates the generated modules manifest with when the module should be reaped. A
+// zero value removes the expiry, which is a no-op for modules without a manifest.
+func (m *GeneratedModule) SetExpiresAt(expiresAt time.Time) error {
+	manifest, err := m.Manifest()
+	if err != nil {
+		return err
+	}
+
+	if manifest == nil {
+		if expiresAt.IsZero() {
+			return nil
+		}
+
+		return fmt.Errorf("setting expiry: module %s does not have a manifest", m.Dir)
+	}
+
+	manifest.ExpiresAt = expiresAt.UTC()
+
+	return writeManifest(m.ManifestPath(), manifest)
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// Test_Manifest_RoundTrip verifies that we can read a manifest that we've written.
+func Test_Manifest_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	mod := &GeneratedModule{Dir: t.TempDir(), UID: "abc"}
+	manifest, err := mod.Manifest()
+	require.NoError(t, err)
+	require.Nil(t, manifest)
+
+	expected := &Manifest{
+		Scenario: &pb.Ref_Scenario{
+			Id: &pb.Scenario_ID{
+				Name: "test",
+				Uid:  "abc",
+				Variants: &pb.Matrix_Vector{
+					Elements: []*pb.Matrix_Element{{Key: "arch", Value: "amd64"}},
+				},
+			},
+		},
+		BaseDir:     "/enos",
+		GeneratedAt: time.Now().UTC().Truncate(time.Second),
+	}
+
+	b, err := json.Marshal(expected)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(mod.Dir, ManifestFileName), b, 0o644))
+
+	manifest, err = mod.Manifest()
+	require.NoError(t, err)
+	require.Equal(t, expected.BaseDir, manifest.BaseDir)
+	require.True(t, expected.GeneratedAt.Equal(manifest.GeneratedAt))
+	require.True(t, proto.Equal(expected.Scenario, manifest.Scenario))
+}
+
+// Test_Manifest_ExpiresAt verifies that we can set and clear the expiry of a generated module.
+func Test_Manifest_ExpiresAt(t *testing.T) {
+	t.Parallel()
+
+	mod := &GeneratedModule{Dir: t.TempDir(), UID: "abc"}
+	require.Error(t, mod.SetExpiresAt(time.Now()))
+	require.NoError(t, mod.SetExpiresAt(time.Time{}))
+
+	require.NoError(t, writeManifest(mod.ManifestPath(), &Manifest{
+		Scenario:    &pb.Ref_Scenario{Id: &pb.Scenario_ID{Name: "test", Uid: "abc"}},
+		BaseDir:     "/enos",
+		GeneratedAt: time.Now().UTC(),
+	}))
+	manifest, err := mod.Manifest()
+	require.NoError(t, err)
+	require.True(t, manifest.ExpiresAt.IsZero())
+	require.False(t, manifest.Expired(time.Now()))
+
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	require.NoError(t, mod.SetExpiresAt(expiresAt))
+	manifest, err = mod.Manifest()
+	require.NoError(t, err)
+	require.True(t, expiresAt.Equal(manifest.ExpiresAt))
+	require.Equal(t, "/enos", manifest.BaseDir)
+	require.False(t, manifest.Expired(time.Now()))
+	require.True(t, manifest.Expired(expiresAt.Add(time.Second)))
+
+	require.NoError(t, mod.SetExpiresAt(time.Time{}))
+	manifest, err = mod.Manifest()
+	require.NoError(t, err)
+	require.True(t, manifest.ExpiresAt.IsZero())
+}
@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// ModuleState describes what we know about the Terraform state of a generated module.
+type ModuleState int
+
+const (
+	// ModuleStateEmpty means the module has no state or its state has no resources.
+	ModuleStateEmpty ModuleState = iota
+	// ModuleStateNotEmpty means the module has state with resources.
+	ModuleStateNotEmpty
+	// ModuleStateUnknown means the module is configured with a remote backend and we
+	// cannot determine the state without Terraform.
+	ModuleStateUnknown
+)
+
+// GeneratedModule is a Terraform module that was previously generated into an out directory.
+type GeneratedModule struct {
+	// Dir is the absolute path to the generated module directory.
+	Dir string
+	// UID is the scenario UID that the module was generated for.
+	UID string
+}
+
+// FindGeneratedModules scans the out directory for previously generated modules. Only
+// directories that contain a generated scenario module are returned. If the out directory
+// does not exist no modules are returned.
+func FindGeneratedModules(outDir string) ([]*GeneratedModule, error) {
+	mods := []*GeneratedModule{}
+
+	entries, err := os.ReadDir(outDir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return mods, nil
+		}
+
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		dir := filepath.Join(outDir, entry.Name())
+		if _, err := os.Stat(filepath.Join(dir, "scenario.tf")); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		mods = append(mods, &GeneratedModule{
+			Dir: dir,
+			UID: entry.Name(),
+		})
+	}
+
+	slices.SortStableFunc(mods, func(a, b *GeneratedModule) int {
+		return strings.Compare(a.Dir, b.Dir)
+	})
+
+	return mods, nil
+}
+
+// ProviderCacheDir is where Terraform installs provider plugins for the module.
+func (m *GeneratedModule) ProviderCacheDir() string {
+	return filepath.Join(m.Dir, ".terraform", "providers")
+}
+
+// LocalStatePath is where Terraform writes the state for the module when it uses the
+// local backend.
+func (m *GeneratedModule) LocalStatePath() string {
+	return filepath.Join(m.Dir, "terraform.tfstate")
+}
+
+// State inspects the module directory and determines what we know about its state. We
+// only ever look at local state files. If the module has been initialized with a remote
+// backend we cannot know whether or not it has state without running Terraform.
+func (m *GeneratedModule) State() (ModuleState, error) {
+	backend := &struct {
+		Backend *struct {
+			Type string `json:"type"`
+		} `json:"backend"`
+	}{}
+
+	backendBytes, err := os.ReadFile(filepath.Join(m.Dir, ".terraform", "terraform.tfstate"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return ModuleStateUnknown, err
+	}
+
+	if err == nil {
+		if err = json.Unmarshal(backendBytes, backend); err != nil {
+			return ModuleStateUnknown, err
+		}
+
+		if backend.Backend != nil && backend.Backend.Type != "" && backend.Backend.Type != "local" {
+			return ModuleStateUnknown, nil
+		}
+	}
+
+	state := &struct {
+		Resources []json.RawMessage `json:"resources"`
+	}{}
+
+	stateBytes, err := os.ReadFile(m.LocalStatePath())
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return ModuleStateEmpty, nil
+		}
+
+		return ModuleStateUnknown, err
+	}
+
+	if len(stateBytes) == 0 {
+		return ModuleStateEmpty, nil
+	}
+
+	if err = json.Unmarshal(stateBytes, state); err != nil {
+		return ModuleStateUnknown, err
+	}
+
+	if len(state.Resources) > 0 {
+		return ModuleStateNotEmpty, nil
+	}
+
+	return ModuleStateEmpty, nil
+}
+
+// DirSize returns the total size in bytes of all regular files in the directory. If the
+// directory does not exist the size is zero.
+func DirSize(dir string) (uint64, error) {
+	var size uint64
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		size += uint64(info.Size())
+
+		return nil
+	})
+
+	return size, err
+}
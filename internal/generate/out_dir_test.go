@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test_FindGeneratedModules verifies that we only find directories that contain a generated
+// scenario module.
+func Test_FindGeneratedModules(t *testing.T) {
+	t.Parallel()
+
+	outDir := t.TempDir()
+	for _, dir := range []string{"bbb", "aaa", "not-a-module"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(outDir, dir), 0o755))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(outDir, "aaa", "scenario.tf"), nil, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(outDir, "bbb", "scenario.tf"), nil, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(outDir, "some-file"), nil, 0o644))
+
+	mods, err := FindGeneratedModules(outDir)
+	require.NoError(t, err)
+	require.Equal(t, []*GeneratedModule{
+		{Dir: filepath.Join(outDir, "aaa"), UID: "aaa"},
+		{Dir: filepath.Join(outDir, "bbb"), UID: "bbb"},
+	}, mods)
+
+	mods, err = FindGeneratedModules(filepath.Join(outDir, "does-not-exist"))
+	require.NoError(t, err)
+	require.Empty(t, mods)
+}
+
+// Test_GeneratedModule_State verifies that we determine the state of a generated module from
+// its local state and backend configuration.
+func Test_GeneratedModule_State(t *testing.T) {
+	t.Parallel()
+
+	for desc, test := range map[string]struct {
+		state    string
+		backend  string
+		expected ModuleState
+	}{
+		"no state": {
+			expected: ModuleStateEmpty,
+		},
+		"state without resources": {
+			state:    `{"version":4,"resources":[]}`,
+			expected: ModuleStateEmpty,
+		},
+		"state with resources": {
+			state:    `{"version":4,"resources":[{"mode":"managed","type":"null_resource","name":"foo"}]}`,
+			expected: ModuleStateNotEmpty,
+		},
+		"local backend with resources": {
+			state:    `{"version":4,"resources":[{"mode":"managed","type":"null_resource","name":"foo"}]}`,
+			backend:  `{"version":3,"backend":{"type":"local"}}`,
+			expected: ModuleStateNotEmpty,
+		},
+		"remote backend": {
+			backend:  `{"version":3,"backend":{"type":"s3"}}`,
+			expected: ModuleStateUnknown,
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			mod := &GeneratedModule{Dir: t.TempDir(), UID: "foo"}
+			if test.state != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(mod.Dir, "terraform.tfstate"), []byte(test.state), 0o644))
+			}
+			if test.backend != "" {
+				require.NoError(t, os.MkdirAll(filepath.Join(mod.Dir, ".terraform"), 0o755))
+				require.NoError(t, os.WriteFile(filepath.Join(mod.Dir, ".terraform", "terraform.tfstate"), []byte(test.backend), 0o644))
+			}
+
+			state, err := mod.State()
+			require.NoError(t, err)
+			require.Equal(t, test.expected, state)
+		})
+	}
+}
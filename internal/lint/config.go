@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+// ConfigFileName is the name of the lint configuration file in the flight plan directory.
+const ConfigFileName = ".enos-lint.hcl"
+
+var configSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "rule", LabelNames: []string{"name"}},
+	},
+}
+
+var ruleConfigSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "enabled"},
+		{Name: "severity"},
+	},
+}
+
+// Config is the lint configuration.
+type Config struct {
+	File  *hcl.File
+	Rules map[string]*RuleConfig
+}
+
+// RuleConfig is the configuration of a single rule.
+type RuleConfig struct {
+	Enabled  *bool
+	Severity hcl.DiagnosticSeverity
+}
+
+// NewConfig returns a new empty configuration. All rules will use their defaults.
+func NewConfig() *Config {
+	return &Config{Rules: map[string]*RuleConfig{}}
+}
+
+// DecodeConfig takes the path and contents of a lint configuration file and decodes it.
+//
+// An example configuration looks like:
+//
+//	rule "step_verifies" {
+//	  enabled  = true
+//	  severity = "error"
+//	}
+func DecodeConfig(path string, src []byte) (*Config, hcl.Diagnostics) {
+	cfg := NewConfig()
+
+	file, diags := hclparse.NewParser().ParseHCL(src, path)
+	if diags.HasErrors() {
+		return cfg, diags
+	}
+	cfg.File = file
+
+	content, moreDiags := file.Body.Content(configSchema)
+	diags = diags.Extend(moreDiags)
+	if moreDiags.HasErrors() {
+		return cfg, diagnostics.WithCode(diags, diagnostics.CodeInvalidLintConfig)
+	}
+
+	for _, block := range content.Blocks {
+		name := block.Labels[0]
+		if _, ok := findRule(name); !ok {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "unknown lint rule",
+				Detail:   fmt.Sprintf("%s is not a known lint rule, must be one of: %s", name, strings.Join(ruleNames(), ", ")),
+				Subject:  block.LabelRanges[0].Ptr(),
+				Context:  block.DefRange.Ptr(),
+				Extra:    diagnostics.CodeInvalidLintConfig,
+			})
+
+			continue
+		}
+
+		if _, ok := cfg.Rules[name]; ok {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "redefined block",
+				Detail:   fmt.Sprintf("rule %s has already been configured", name),
+				Subject:  block.LabelRanges[0].Ptr(),
+				Context:  block.DefRange.Ptr(),
+				Extra:    diagnostics.CodeInvalidLintConfig,
+			})
+
+			continue
+		}
+
+		ruleCfg, moreDiags := decodeRuleConfig(block)
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+		cfg.Rules[name] = ruleCfg
+	}
+
+	return cfg, diags
+}
+
+func decodeRuleConfig(block *hcl.Block) (*RuleConfig, hcl.Diagnostics) {
+	ruleCfg := &RuleConfig{Severity: hcl.DiagWarning}
+
+	content, diags := block.Body.Content(ruleConfigSchema)
+	if diags.HasErrors() {
+		return ruleCfg, diagnostics.WithCode(diags, diagnostics.CodeInvalidLintConfig)
+	}
+
+	if attr, ok := content.Attributes["enabled"]; ok {
+		val, moreDiags := attr.Expr.Value(nil)
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			return ruleCfg, diags
+		}
+
+		if val.IsNull() || !val.IsKnown() || !val.Type().Equals(cty.Bool) {
+			return ruleCfg, diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "invalid value",
+				Detail:   "enabled must be a bool",
+				Subject:  attr.Expr.Range().Ptr(),
+				Context:  attr.Range.Ptr(),
+				Extra:    diagnostics.CodeInvalidLintConfig,
+			})
+		}
+
+		enabled := val.True()
+		ruleCfg.Enabled = &enabled
+	}
+
+	if attr, ok := content.Attributes["severity"]; ok {
+		val, moreDiags := attr.Expr.Value(nil)
+		diags = diags.Extend(moreDiags)
+		if moreDiags.HasErrors() {
+			return ruleCfg, diags
+		}
+
+		sev := ""
+		if !val.IsNull() && val.IsKnown() && val.Type().Equals(cty.String) {
+			sev = val.AsString()
+		}
+
+		switch sev {
+		case "warning":
+			ruleCfg.Severity = hcl.DiagWarning
+		case "error":
+			ruleCfg.Severity = hcl.DiagError
+		default:
+			return ruleCfg, diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "invalid value",
+				Detail:   `severity must be either "warning" or "error"`,
+				Subject:  attr.Expr.Range().Ptr(),
+				Context:  attr.Range.Ptr(),
+				Extra:    diagnostics.CodeInvalidLintConfig,
+			})
+		}
+	}
+
+	return ruleCfg, diags
+}
+
+// ruleEnabled returns whether or not the rule is enabled.
+func (c *Config) ruleEnabled(rule *Rule) bool {
+	if c == nil {
+		return rule.DefaultEnabled
+	}
+
+	ruleCfg, ok := c.Rules[rule.Name]
+	if !ok || ruleCfg.Enabled == nil {
+		return rule.DefaultEnabled
+	}
+
+	return *ruleCfg.Enabled
+}
+
+// ruleSeverity returns the configured severity of the rule.
+func (c *Config) ruleSeverity(rule *Rule) hcl.DiagnosticSeverity {
+	if c == nil {
+		return hcl.DiagWarning
+	}
+
+	ruleCfg, ok := c.Rules[rule.Name]
+	if !ok {
+		return hcl.DiagWarning
+	}
+
+	return ruleCfg.Severity
+}
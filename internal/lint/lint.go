@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package lint provides static analysis of flight plans for problems that are valid
+// configuration but are likely mistakes, e.g. declared but unused blocks.
+package lint
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/enos/internal/flightplan"
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+// Linter lints flight plans.
+type Linter struct {
+	config *Config
+}
+
+// Opt is a functional option for a new Linter.
+type Opt func(*Linter)
+
+// New takes options and returns a new Linter.
+func New(opts ...Opt) *Linter {
+	l := &Linter{config: NewConfig()}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+// WithConfig sets the lint configuration.
+func WithConfig(cfg *Config) Opt {
+	return func(l *Linter) {
+		if cfg != nil {
+			l.config = cfg
+		}
+	}
+}
+
+// Lint runs all enabled rules against the flight plan and returns the diagnostics. The flight
+// plan must have been decoded with scenario blocks that include their matrices.
+func (l *Linter) Lint(fp *flightplan.FlightPlan) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+	if fp == nil {
+		return diags
+	}
+
+	refs := newReferences(fp.Files)
+	for _, rule := range rules {
+		if !l.config.ruleEnabled(rule) {
+			continue
+		}
+
+		for _, diag := range rule.check(fp, refs) {
+			diag.Severity = l.config.ruleSeverity(rule)
+			diag.Extra = rule.Code
+			if diag.Detail == "" {
+				diag.Detail = rule.Description
+			}
+			diag.Detail = fmt.Sprintf("%s (lint rule: %s)", diag.Detail, rule.Name)
+			diags = diags.Append(diag)
+		}
+	}
+
+	slices.SortStableFunc(diags, compareDiagnostics)
+
+	return diags
+}
+
+func compareDiagnostics(a, b *hcl.Diagnostic) int {
+	if a.Subject == nil || b.Subject == nil {
+		switch {
+		case a.Subject == nil && b.Subject == nil:
+			return strings.Compare(a.Summary, b.Summary)
+		case a.Subject == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	if c := strings.Compare(a.Subject.Filename, b.Subject.Filename); c != 0 {
+		return c
+	}
+
+	if c := a.Subject.Start.Byte - b.Subject.Start.Byte; c != 0 {
+		return c
+	}
+
+	return strings.Compare(a.Summary, b.Summary)
+}
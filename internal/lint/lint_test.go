@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+const lintTestFlightPlan = `
+module "used" {
+  source = "./used"
+}
+
+module "unused" {
+  source = "./unused"
+}
+
+variable "used" {
+  type = string
+  default = "foo"
+}
+
+variable "unused" {
+  type = string
+  default = "bar"
+}
+
+quality "used" {
+  description = "used"
+}
+
+quality "unused" {
+  description = "unused"
+}
+
+globals {
+  used   = "foo"
+  unused = "bar"
+}
+
+scenario "test" {
+  matrix {
+    arch   = ["amd64", "arm64"]
+    distro = ["ubuntu", "rhel"]
+
+    exclude {
+      arch = ["arm64"]
+    }
+  }
+
+  step "one" {
+    module   = module.used
+    verifies = quality.used
+
+    variables {
+      input  = var.used
+      global = global.used
+    }
+  }
+
+  step "two" {
+    module     = "used"
+    depends_on = [step.one, "one"]
+
+    variables {
+      input = step.one.output
+    }
+  }
+}
+`
+
+func testLintFlightPlan(t *testing.T, src string) *flightplan.FlightPlan {
+	t.Helper()
+
+	dec, err := flightplan.NewDecoder(
+		flightplan.WithDecoderBaseDir(t.TempDir()),
+		flightplan.WithDecoderFPFiles(flightplan.RawFiles{"enos.hcl": []byte(src)}),
+		flightplan.WithDecoderDecodeTarget(flightplan.DecodeTargetScenariosMatrixOnly),
+	)
+	require.NoError(t, err)
+
+	diags := dec.Parse()
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	fp, sd, diags := dec.Decode(context.Background())
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	diags = sd.DecodeAll(context.Background(), fp)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	return fp
+}
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	fp := testLintFlightPlan(t, lintTestFlightPlan)
+
+	for desc, test := range map[string]struct {
+		config   string
+		expected map[diagnostics.Code][]string
+		severity hcl.DiagnosticSeverity
+	}{
+		"defaults": {
+			expected: map[diagnostics.Code][]string{
+				diagnostics.CodeLintUnusedModule:        {`module "unused" is not used by any step`},
+				diagnostics.CodeLintUnusedVariable:      {`variable "unused" is never referenced`},
+				diagnostics.CodeLintUnusedQuality:       {`quality "unused" is never verified`},
+				diagnostics.CodeLintUnusedGlobal:        {`global "unused" is never referenced`},
+				diagnostics.CodeLintRedundantDependsOn:  {`step "two" depends_on "one" is redundant`, `step "two" depends_on "one" is redundant`},
+				diagnostics.CodeLintExcludedMatrixValue: {`matrix value arch:arm64 in scenario "test" is excluded from every variant`},
+			},
+			severity: hcl.DiagWarning,
+		},
+		"configured": {
+			config: `
+rule "unused_module" {
+  enabled = false
+}
+
+rule "unused_variable" {
+  enabled = false
+}
+
+rule "unused_quality" {
+  enabled = false
+}
+
+rule "unused_global" {
+  enabled = false
+}
+
+rule "redundant_depends_on" {
+  enabled = false
+}
+
+rule "excluded_matrix_value" {
+  enabled = false
+}
+
+rule "step_verifies" {
+  enabled  = true
+  severity = "error"
+}
+
+rule "scenario_description" {
+  enabled  = true
+  severity = "error"
+}
+`,
+			expected: map[diagnostics.Code][]string{
+				diagnostics.CodeLintStepWithoutVerifies:  {`step "two" in scenario "test" does not verify any quality`},
+				diagnostics.CodeLintUndocumentedScenario: {`scenario "test" does not have a description`},
+			},
+			severity: hcl.DiagError,
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, diags := DecodeConfig(ConfigFileName, []byte(test.config))
+			require.False(t, diags.HasErrors(), diags.Error())
+
+			got := map[diagnostics.Code][]string{}
+			for _, diag := range New(WithConfig(cfg)).Lint(fp) {
+				require.Equal(t, test.severity, diag.Severity)
+				require.NotNil(t, diag.Subject)
+				code, ok := diag.Extra.(diagnostics.Code)
+				require.True(t, ok)
+				got[code] = append(got[code], diag.Summary)
+			}
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestLintDynamicModule(t *testing.T) {
+	t.Parallel()
+
+	fp := testLintFlightPlan(t, `
+module "foo" {
+  source = "./foo"
+}
+
+module "bar" {
+  source = "./bar"
+}
+
+scenario "test" {
+  matrix {
+    backend = ["foo", "bar"]
+  }
+
+  step "one" {
+    module = matrix.backend
+  }
+}
+`)
+
+	for _, diag := range New().Lint(fp) {
+		require.NotEqual(t, diagnostics.CodeLintUnusedModule, diag.Extra)
+	}
+}
+
+func TestDecodeConfig(t *testing.T) {
+	t.Parallel()
+
+	for desc, test := range map[string]struct {
+		config string
+		fails  bool
+	}{
+		"empty": {},
+		"valid": {
+			config: `
+rule "step_verifies" {
+  enabled  = true
+  severity = "warning"
+}
+`,
+		},
+		"unknown rule": {
+			config: `
+rule "not_a_rule" {
+  enabled = true
+}
+`,
+			fails: true,
+		},
+		"redefined rule": {
+			config: `
+rule "step_verifies" {
+  enabled = true
+}
+
+rule "step_verifies" {
+  enabled = false
+}
+`,
+			fails: true,
+		},
+		"invalid enabled": {
+			config: `
+rule "step_verifies" {
+  enabled = "yes"
+}
+`,
+			fails: true,
+		},
+		"invalid severity": {
+			config: `
+rule "step_verifies" {
+  severity = "info"
+}
+`,
+			fails: true,
+		},
+		"unknown attribute": {
+			config: `
+rule "step_verifies" {
+  level = "error"
+}
+`,
+			fails: true,
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, diags := DecodeConfig(ConfigFileName, []byte(test.config))
+			if !test.fails {
+				require.False(t, diags.HasErrors(), diags.Error())
+
+				return
+			}
+
+			require.True(t, diags.HasErrors())
+			for _, diag := range diags {
+				require.Equal(t, diagnostics.CodeInvalidLintConfig, diag.Extra)
+			}
+		})
+	}
+}
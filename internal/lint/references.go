@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lint
+
+import (
+	"sort"
+
+	"github.com/zclconf/go-cty/cty"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// references is an index of every variable traversal in the flight plan. We index the
+// syntax rather than decoded values so that we're able to find things that are declared but
+// never used, which might have no effect on the decoded flight plan.
+type references struct {
+	// attrs are the attribute names that have been referenced for each root, e.g.
+	// "var.foo" is recorded as attrs["var"]["foo"].
+	attrs map[string]map[string]struct{}
+	// wholeRoots are roots that have been referenced without an attribute, e.g. "var"
+	// or var["foo"], which means we cannot determine which attributes are used.
+	wholeRoots map[string]struct{}
+	// moduleNames are modules that have been referenced by name in step module attributes.
+	moduleNames map[string]struct{}
+	// dynamicModules is true if any step has a module that we cannot determine statically.
+	dynamicModules bool
+}
+
+func newReferences(files map[string]*hcl.File) *references {
+	refs := &references{
+		attrs:       map[string]map[string]struct{}{},
+		wholeRoots:  map[string]struct{}{},
+		moduleNames: map[string]struct{}{},
+	}
+
+	for _, file := range sortedFiles(files) {
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		refs.addBody(body, nil)
+	}
+
+	return refs
+}
+
+// isUsed returns whether or not the root and attribute have been referenced.
+func (r *references) isUsed(root string, attr string) bool {
+	if _, ok := r.wholeRoots[root]; ok {
+		return true
+	}
+
+	_, ok := r.attrs[root][attr]
+
+	return ok
+}
+
+func (r *references) addBody(body *hclsyntax.Body, parent *hclsyntax.Block) {
+	for _, attr := range body.Attributes {
+		if parent != nil && parent.Type == "step" && attr.Name == "module" {
+			r.addStepModule(attr)
+		}
+
+		r.addTraversals(attr.Expr.Variables())
+	}
+
+	for _, block := range body.Blocks {
+		r.addBody(block.Body, block)
+	}
+}
+
+func (r *references) addTraversals(traversals []hcl.Traversal) {
+	for _, traversal := range traversals {
+		root := traversal.RootName()
+		if len(traversal) < 2 {
+			r.wholeRoots[root] = struct{}{}
+			continue
+		}
+
+		attr, ok := traversal[1].(hcl.TraverseAttr)
+		if !ok {
+			r.wholeRoots[root] = struct{}{}
+			continue
+		}
+
+		if _, ok := r.attrs[root]; !ok {
+			r.attrs[root] = map[string]struct{}{}
+		}
+		r.attrs[root][attr.Name] = struct{}{}
+	}
+}
+
+// addStepModule handles step modules that are referenced by name rather than traversal.
+func (r *references) addStepModule(attr *hclsyntax.Attribute) {
+	if len(attr.Expr.Variables()) > 0 {
+		// If the only reference is to a module we'll handle it as a normal traversal,
+		// otherwise it's a dynamic module that we can't determine.
+		for _, traversal := range attr.Expr.Variables() {
+			if traversal.RootName() != "module" {
+				r.dynamicModules = true
+			}
+		}
+
+		return
+	}
+
+	val, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() || val.IsNull() || !val.IsKnown() || !val.Type().Equals(cty.String) {
+		r.dynamicModules = true
+
+		return
+	}
+
+	r.moduleNames[val.AsString()] = struct{}{}
+}
+
+// stepReferences returns the names of steps that are referenced in the step body,
+// excluding any references in depends_on.
+func stepReferences(step *hclsyntax.Block) map[string]struct{} {
+	refs := &references{
+		attrs:       map[string]map[string]struct{}{},
+		wholeRoots:  map[string]struct{}{},
+		moduleNames: map[string]struct{}{},
+	}
+
+	for _, attr := range step.Body.Attributes {
+		if attr.Name == "depends_on" {
+			continue
+		}
+		refs.addTraversals(attr.Expr.Variables())
+	}
+
+	for _, block := range step.Body.Blocks {
+		refs.addBody(block.Body, block)
+	}
+
+	return refs.attrs["step"]
+}
+
+// sortedFiles returns the files sorted by name so that our diagnostics are stable.
+func sortedFiles(files map[string]*hcl.File) []*hcl.File {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := make([]*hcl.File, 0, len(names))
+	for _, name := range names {
+		res = append(res, files[name])
+	}
+
+	return res
+}
+
+// topLevelBlocks returns every top-level block of the given type in all files.
+func topLevelBlocks(files map[string]*hcl.File, blockType string) []*hclsyntax.Block {
+	blocks := []*hclsyntax.Block{}
+	for _, file := range sortedFiles(files) {
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type == blockType {
+				blocks = append(blocks, block)
+			}
+		}
+	}
+
+	return blocks
+}
+
+// childBlocks returns the child blocks of the given type.
+func childBlocks(block *hclsyntax.Block, blockType string) []*hclsyntax.Block {
+	blocks := []*hclsyntax.Block{}
+	for _, child := range block.Body.Blocks {
+		if child.Type == blockType {
+			blocks = append(blocks, child)
+		}
+	}
+
+	return blocks
+}
+
+// blockLabel returns the first label of the block.
+func blockLabel(block *hclsyntax.Block) string {
+	if len(block.Labels) < 1 {
+		return ""
+	}
+
+	return block.Labels[0]
+}
@@ -0,0 +1,368 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lint
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// Rule is a lint rule.
+type Rule struct {
+	Name           string
+	Code           diagnostics.Code
+	Description    string
+	DefaultEnabled bool
+	check          func(fp *flightplan.FlightPlan, refs *references) hcl.Diagnostics
+}
+
+var rules = []*Rule{
+	{
+		Name:           "unused_module",
+		Code:           diagnostics.CodeLintUnusedModule,
+		Description:    "module blocks that are not used by any scenario step",
+		DefaultEnabled: true,
+		check:          checkUnusedModules,
+	},
+	{
+		Name:           "unused_variable",
+		Code:           diagnostics.CodeLintUnusedVariable,
+		Description:    "variable blocks that are never referenced",
+		DefaultEnabled: true,
+		check:          checkUnusedNamedBlocks("variable", "var", "variable %q is never referenced"),
+	},
+	{
+		Name:           "unused_quality",
+		Code:           diagnostics.CodeLintUnusedQuality,
+		Description:    "quality blocks that are never verified",
+		DefaultEnabled: true,
+		check:          checkUnusedNamedBlocks("quality", "quality", "quality %q is never verified"),
+	},
+	{
+		Name:           "unused_global",
+		Code:           diagnostics.CodeLintUnusedGlobal,
+		Description:    "globals that are never referenced",
+		DefaultEnabled: true,
+		check:          checkUnusedGlobals,
+	},
+	{
+		Name:           "step_verifies",
+		Code:           diagnostics.CodeLintStepWithoutVerifies,
+		Description:    "scenario steps that do not verify any quality",
+		DefaultEnabled: false,
+		check:          checkStepVerifies,
+	},
+	{
+		Name:           "redundant_depends_on",
+		Code:           diagnostics.CodeLintRedundantDependsOn,
+		Description:    "step depends_on values that are implied by references to the step",
+		DefaultEnabled: true,
+		check:          checkRedundantDependsOn,
+	},
+	{
+		Name:           "excluded_matrix_value",
+		Code:           diagnostics.CodeLintExcludedMatrixValue,
+		Description:    "matrix values that are excluded from every variant",
+		DefaultEnabled: true,
+		check:          checkExcludedMatrixValues,
+	},
+	{
+		Name:           "scenario_description",
+		Code:           diagnostics.CodeLintUndocumentedScenario,
+		Description:    "scenarios that do not have a description",
+		DefaultEnabled: false,
+		check:          checkScenarioDescription,
+	},
+}
+
+// Rules returns all lint rules.
+func Rules() []*Rule {
+	return rules
+}
+
+func findRule(name string) (*Rule, bool) {
+	for _, rule := range rules {
+		if rule.Name == name {
+			return rule, true
+		}
+	}
+
+	return nil, false
+}
+
+func ruleNames() []string {
+	names := []string{}
+	for _, rule := range rules {
+		names = append(names, rule.Name)
+	}
+
+	return names
+}
+
+func checkUnusedModules(fp *flightplan.FlightPlan, refs *references) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	// If any step uses a module whose name is determined at decode time we can't know
+	// which modules are unused.
+	if refs.dynamicModules {
+		return diags
+	}
+
+	for _, block := range topLevelBlocks(fp.Files, "module") {
+		name := blockLabel(block)
+		if _, ok := refs.moduleNames[name]; ok {
+			continue
+		}
+
+		if refs.isUsed("module", name) {
+			continue
+		}
+
+		diags = diags.Append(&hcl.Diagnostic{
+			Summary: fmt.Sprintf("module %q is not used by any step", name),
+			Subject: block.LabelRanges[0].Ptr(),
+			Context: block.DefRange().Ptr(),
+		})
+	}
+
+	return diags
+}
+
+func checkUnusedNamedBlocks(blockType string, root string, summary string) func(*flightplan.FlightPlan, *references) hcl.Diagnostics {
+	return func(fp *flightplan.FlightPlan, refs *references) hcl.Diagnostics {
+		diags := hcl.Diagnostics{}
+
+		for _, block := range topLevelBlocks(fp.Files, blockType) {
+			name := blockLabel(block)
+			if refs.isUsed(root, name) {
+				continue
+			}
+
+			diags = diags.Append(&hcl.Diagnostic{
+				Summary: fmt.Sprintf(summary, name),
+				Subject: block.LabelRanges[0].Ptr(),
+				Context: block.DefRange().Ptr(),
+			})
+		}
+
+		return diags
+	}
+}
+
+func checkUnusedGlobals(fp *flightplan.FlightPlan, refs *references) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	for _, block := range topLevelBlocks(fp.Files, "globals") {
+		for _, attr := range sortedAttributes(block.Body) {
+			if refs.isUsed("global", attr.Name) {
+				continue
+			}
+
+			diags = diags.Append(&hcl.Diagnostic{
+				Summary: fmt.Sprintf("global %q is never referenced", attr.Name),
+				Subject: attr.NameRange.Ptr(),
+				Context: attr.SrcRange.Ptr(),
+			})
+		}
+	}
+
+	return diags
+}
+
+func checkStepVerifies(fp *flightplan.FlightPlan, refs *references) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	for _, scenario := range topLevelBlocks(fp.Files, "scenario") {
+		for _, step := range childBlocks(scenario, "step") {
+			verifies, ok := step.Body.Attributes["verifies"]
+			if ok {
+				tuple, isTuple := verifies.Expr.(*hclsyntax.TupleConsExpr)
+				if !isTuple || len(tuple.Exprs) > 0 {
+					continue
+				}
+			}
+
+			diags = diags.Append(&hcl.Diagnostic{
+				Summary: fmt.Sprintf("step %q in scenario %q does not verify any quality", blockLabel(step), blockLabel(scenario)),
+				Subject: step.LabelRanges[0].Ptr(),
+				Context: step.DefRange().Ptr(),
+			})
+		}
+	}
+
+	return diags
+}
+
+func checkRedundantDependsOn(fp *flightplan.FlightPlan, refs *references) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	for _, scenario := range topLevelBlocks(fp.Files, "scenario") {
+		for _, step := range childBlocks(scenario, "step") {
+			dependsOn, ok := step.Body.Attributes["depends_on"]
+			if !ok {
+				continue
+			}
+
+			tuple, ok := dependsOn.Expr.(*hclsyntax.TupleConsExpr)
+			if !ok {
+				continue
+			}
+
+			referenced := stepReferences(step)
+			for _, expr := range tuple.Exprs {
+				name, ok := dependsOnStepName(expr)
+				if !ok {
+					continue
+				}
+
+				if _, ok := referenced[name]; !ok {
+					continue
+				}
+
+				diags = diags.Append(&hcl.Diagnostic{
+					Summary: fmt.Sprintf("step %q depends_on %q is redundant", blockLabel(step), name),
+					Detail:  fmt.Sprintf("step %s already references step %s, which implies the dependency", blockLabel(step), name),
+					Subject: expr.Range().Ptr(),
+					Context: dependsOn.SrcRange.Ptr(),
+				})
+			}
+		}
+	}
+
+	return diags
+}
+
+// dependsOnStepName returns the name of the step in a depends_on element, which can either
+// be a string name or a step traversal.
+func dependsOnStepName(expr hclsyntax.Expression) (string, bool) {
+	if vars := expr.Variables(); len(vars) > 0 {
+		if len(vars) != 1 || vars[0].RootName() != "step" || len(vars[0]) < 2 {
+			return "", false
+		}
+
+		attr, ok := vars[0][1].(hcl.TraverseAttr)
+		if !ok {
+			return "", false
+		}
+
+		return attr.Name, true
+	}
+
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() || val.IsNull() || !val.IsKnown() || !val.Type().Equals(cty.String) {
+		return "", false
+	}
+
+	return val.AsString(), true
+}
+
+func checkExcludedMatrixValues(fp *flightplan.FlightPlan, refs *references) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	for _, sb := range fp.ScenarioBlocks {
+		if sb.MatrixBlock == nil || sb.MatrixBlock.GetOriginal() == nil {
+			continue
+		}
+
+		final := sb.MatrixBlock.Matrix()
+		for _, vec := range sb.MatrixBlock.GetOriginal().GetVectors() {
+			for _, elm := range vec.Elements() {
+				if matrixHasElement(final, elm) {
+					continue
+				}
+
+				diag := &hcl.Diagnostic{
+					Summary: fmt.Sprintf("matrix value %s in scenario %q is excluded from every variant", elm.String(), sb.Name),
+				}
+				if attr := matrixAttribute(sb.Block, elm.Key); attr != nil {
+					diag.Subject = attr.Expr.Range().Ptr()
+					diag.Context = attr.SrcRange.Ptr()
+				} else if sb.Block != nil {
+					diag.Subject = sb.Block.DefRange.Ptr()
+				}
+
+				diags = diags.Append(diag)
+			}
+		}
+	}
+
+	return diags
+}
+
+func matrixHasElement(m *flightplan.Matrix, elm flightplan.Element) bool {
+	if m == nil {
+		return false
+	}
+
+	for _, vec := range m.GetVectors() {
+		if slices.ContainsFunc(vec.Elements(), elm.Equal) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matrixAttribute returns the attribute in the matrix block of the scenario.
+func matrixAttribute(scenario *hcl.Block, key string) *hclsyntax.Attribute {
+	if scenario == nil {
+		return nil
+	}
+
+	body, ok := scenario.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil
+	}
+
+	for _, block := range body.Blocks {
+		if block.Type != "matrix" {
+			continue
+		}
+
+		if attr, ok := block.Body.Attributes[key]; ok {
+			return attr
+		}
+	}
+
+	return nil
+}
+
+func checkScenarioDescription(fp *flightplan.FlightPlan, refs *references) hcl.Diagnostics {
+	diags := hcl.Diagnostics{}
+
+	for _, scenario := range topLevelBlocks(fp.Files, "scenario") {
+		if _, ok := scenario.Body.Attributes["description"]; ok {
+			continue
+		}
+
+		diags = diags.Append(&hcl.Diagnostic{
+			Summary: fmt.Sprintf("scenario %q does not have a description", blockLabel(scenario)),
+			Subject: scenario.LabelRanges[0].Ptr(),
+			Context: scenario.DefRange().Ptr(),
+		})
+	}
+
+	return diags
+}
+
+// sortedAttributes returns the body attributes sorted by their position in the file.
+func sortedAttributes(body *hclsyntax.Body) []*hclsyntax.Attribute {
+	attrs := make([]*hclsyntax.Attribute, 0, len(body.Attributes))
+	for _, attr := range body.Attributes {
+		attrs = append(attrs, attr)
+	}
+
+	slices.SortFunc(attrs, func(a, b *hclsyntax.Attribute) int {
+		return a.SrcRange.Start.Byte - b.SrcRange.Start.Byte
+	})
+
+	return attrs
+}
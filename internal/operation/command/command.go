@@ -6,6 +6,7 @@ package command
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"sort"
@@ -62,6 +63,16 @@ func WithArgs(args ...string) Opt {
 	}
 }
 
+// WithOutput sets the command's STDOUT and STDERR.
+func WithOutput(stdout io.Writer, stderr io.Writer) Opt {
+	return func(cmd *Command) {
+		cmd.ExecOpts = append(cmd.ExecOpts, func(ecmd *exec.Cmd) {
+			ecmd.Stdout = stdout
+			ecmd.Stderr = stderr
+		})
+	}
+}
+
 // WithDir sets the command directory.
 func WithDir(dir string) Opt {
 	return func(cmd *Command) {
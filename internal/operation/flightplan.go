@@ -20,3 +20,15 @@ func isAbs(path string) (string, error) {
 func outDirForWorkspace(w *pb.Workspace) string {
 	return filepath.Join(w.GetFlightplan().GetBaseDir(), ".enos")
 }
+
+// OutDirForWorkspace returns the absolute path of the directory where modules for
+// the workspace are generated. If the workspace does not configure an out directory
+// it defaults to $dir/.enos.
+func OutDirForWorkspace(w *pb.Workspace) (string, error) {
+	outDir := w.GetOutDir()
+	if outDir == "" {
+		outDir = outDirForWorkspace(w)
+	}
+
+	return isAbs(outDir)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/enos/internal/flightplan"
 	"github.com/hashicorp/enos/internal/proto"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -165,6 +166,8 @@ func RequestTypeString(op *pb.Operation_Request) string {
 		return "exec"
 	case *pb.Operation_Request_Output_:
 		return "output"
+	case *pb.Operation_Request_State_:
+		return "state"
 	default:
 		return "unknown"
 	}
@@ -196,6 +199,12 @@ func EventTypeString(event *pb.Operation_Event) string {
 		return "terraform_exec"
 	case *pb.Operation_Event_Output:
 		return "terraform_output"
+	case *pb.Operation_Event_Show:
+		return "terraform_show"
+	case *pb.Operation_Event_Progress:
+		return "terraform_progress"
+	case *pb.Operation_Event_Hook:
+		return "enos_hook"
 	default:
 		return "unknown"
 	}
@@ -223,11 +232,29 @@ func ResponseTypeString(op *pb.Operation_Response) string {
 		return "exec"
 	case *pb.Operation_Response_Output_:
 		return "output"
+	case *pb.Operation_Response_State_:
+		return "state"
 	default:
 		return "unknown"
 	}
 }
 
+// newProgressPublisher returns a func that publishes Terraform resource progress as operation
+// events.
+func newProgressPublisher(
+	ref *pb.Ref_Operation,
+	events *EventSender,
+	log hclog.Logger,
+) func(*pb.Terraform_Command_Progress) {
+	return func(progress *pb.Terraform_Command_Progress) {
+		event := newEvent(ref, pb.Operation_STATUS_RUNNING)
+		event.Value = &pb.Operation_Event_Progress{Progress: progress}
+		if err := events.Publish(event); err != nil {
+			log.Error("failed to publish progress event", "error", err)
+		}
+	}
+}
+
 //nolint:unparam // right now all callers use pb.Operation_STATUS_RUNNING but that's not guaranteed.
 func newEvent(
 	ref *pb.Ref_Operation,
@@ -290,6 +317,10 @@ func NewEventFromResponse(res *pb.Operation_Response) (*pb.Operation_Event, erro
 		event.Value = &pb.Operation_Event_Output{
 			Output: t.Output.GetOutput(),
 		}
+	case *pb.Operation_Response_State_:
+		event.Value = &pb.Operation_Event_Show{
+			Show: t.State.GetShow(),
+		}
 	default:
 		err := errors.New("cannot convert response type to event")
 		merr = multierror.Append(merr, err)
@@ -341,6 +372,8 @@ func NewResponseFromRequest(op *pb.Operation_Request) (*pb.Operation_Response, e
 		res.Value = &pb.Operation_Response_Exec_{}
 	case *pb.Operation_Request_Output_:
 		res.Value = &pb.Operation_Response_Output_{}
+	case *pb.Operation_Request_State_:
+		res.Value = &pb.Operation_Response_State_{}
 	default:
 		err := errors.New("cannot convert response type to event")
 		merr = multierror.Append(merr, err)
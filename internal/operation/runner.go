@@ -6,7 +6,9 @@ package operation
 import (
 	"io"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/enos/internal/flightplan"
 	"github.com/hashicorp/enos/internal/operation/terraform"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 	"github.com/hashicorp/go-hclog"
@@ -17,9 +19,12 @@ type RunnerOpt func(*Runner)
 
 // Runner is a Terraform command runner.
 type Runner struct {
-	TFConfig *terraform.Config
-	Module   *pb.Terraform_Module
-	log      hclog.Logger
+	TFConfig      *terraform.Config
+	Module        *pb.Terraform_Module
+	log           hclog.Logger
+	stepTimeouts  map[string]time.Duration
+	scenario      *flightplan.Scenario
+	failurePolicy *pb.Operation_FailurePolicy
 }
 
 // NewTextOutput returns a new TextOutput.
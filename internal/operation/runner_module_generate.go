@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/enos/internal/diagnostics"
 	"github.com/hashicorp/enos/internal/flightplan"
@@ -86,7 +88,7 @@ func (r *Runner) moduleGenerate(
 	// Generate the module
 	err = gen.Generate()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeModuleGenerateFailed, err)))
 
 		return resVal
 	}
@@ -101,6 +103,29 @@ func (r *Runner) moduleGenerate(
 	// Configure our Terraform executor to use the module we generated
 	r.TFConfig.WithModule(resVal.Generate.GetTerraformModule())
 
+	// Only target some steps if we've been asked to
+	targetDiags := r.targetSteps(scenario)
+	if diagnostics.HasErrors(targetDiags) {
+		notifyFail(targetDiags)
+
+		return resVal
+	}
+	resVal.Generate.Diagnostics = append(resVal.Generate.GetDiagnostics(), targetDiags...)
+
+	// Keep track of step timeouts so that we can enforce them when applying
+	r.stepTimeouts = map[string]time.Duration{}
+	for _, step := range scenario.Steps {
+		if step.Timeout > 0 {
+			r.stepTimeouts[step.Name] = step.Timeout
+		}
+	}
+
+	// Keep our scenario so that we can run its hooks during the scenario lifecycle
+	r.scenario = scenario
+
+	// Keep our scenario failure policy in case launching fails
+	r.failurePolicy = scenario.FailurePolicy
+
 	// Finalize our responses and event
 	event.Status = diagnostics.Status(r.TFConfig.FailOnWarnings, resVal.Generate.GetDiagnostics()...)
 	event.Diagnostics = resVal.Generate.GetDiagnostics()
@@ -116,16 +141,46 @@ func (r *Runner) moduleGenerate(
 	return resVal
 }
 
-func scenarioAndModuleGeneratorForReq(ctx context.Context, req *pb.Operation_Request) (
-	*generate.Generator,
-	*flightplan.Scenario,
-	[]*pb.Diagnostic,
-) {
+// targetSteps configures our Terraform executor to only target the steps that have been requested
+// with target or from steps. If any steps are targeted a warning diagnostic is returned because the
+// resulting Terraform state will not reflect the entire scenario.
+func (r *Runner) targetSteps(scenario *flightplan.Scenario) []*pb.Diagnostic {
+	targetSteps := r.TFConfig.Flags.GetTargetSteps()
+	fromSteps := r.TFConfig.Flags.GetFromSteps()
+	if len(targetSteps) == 0 && len(fromSteps) == 0 {
+		return nil
+	}
+
+	steps, err := scenario.TargetSteps(targetSteps, fromSteps)
+	if err != nil {
+		return diagnostics.FromErr(err)
+	}
+	if len(steps) == 0 {
+		return diagnostics.FromErr(fmt.Errorf("scenario %s has no steps to target", scenario.String()))
+	}
+
+	r.TFConfig.Targets = []string{}
+	for _, step := range steps {
+		r.TFConfig.Targets = append(r.TFConfig.Targets, "module."+step)
+	}
+
+	return []*pb.Diagnostic{{
+		Severity: pb.Diagnostic_SEVERITY_WARNING,
+		Summary: fmt.Sprintf(
+			"only targeting steps %s, the Terraform state will not reflect the entire scenario",
+			strings.Join(steps, ", "),
+		),
+		Code: diagnostics.CodeTerraformPartialLaunch.String(),
+	}}
+}
+
+// scenarioForReq decodes the scenario that the request refers to from the workspace flight plan.
+func scenarioForReq(ctx context.Context, req *pb.Operation_Request) (*flightplan.Scenario, []*pb.Diagnostic) {
 	filter, err := flightplan.NewScenarioFilter(
 		flightplan.WithScenarioFilterFromScenarioRef(req.GetScenario()),
 	)
 	if err != nil {
-		return nil, nil, diagnostics.FromErr(err)
+		return nil, diagnostics.FromErr(err)
 	}
 
 	fp, scenarioDecoder, decRes := flightplan.DecodeProto(
@@ -145,42 +200,53 @@ func scenarioAndModuleGeneratorForReq(ctx context.Context, req *pb.Operation_Req
 		req.GetWorkspace().GetTfExecCfg().GetFailOnWarnings(),
 		decRes.GetDiagnostics(),
 	) {
-		return nil, nil, decRes.GetDiagnostics()
+		return nil, decRes.GetDiagnostics()
 	}
 
-	ws := req.GetWorkspace()
 	scenarios := fp.Scenarios()
 	switch len(scenarios) {
 	case 0:
-		return nil, nil, diagnostics.FromErr(errors.New("no matching scenarios found"))
+		return nil, diagnostics.FromErr(errors.New("no matching scenarios found"))
 	case 1:
 	default:
-		return nil, nil, diagnostics.FromErr(
+		return nil, diagnostics.FromErr(
 			fmt.Errorf("found more than one scenario matching scenario for filter %s: %+v",
 				filter.String(),
 				scenarios,
 			))
 	}
 
+	return scenarios[0], nil
+}
+
+func scenarioAndModuleGeneratorForReq(ctx context.Context, req *pb.Operation_Request) (
+	*generate.Generator,
+	*flightplan.Scenario,
+	[]*pb.Diagnostic,
+) {
+	scenario, diags := scenarioForReq(ctx, req)
+	if diagnostics.HasFailed(
+		req.GetWorkspace().GetTfExecCfg().GetFailOnWarnings(),
+		diags,
+	) {
+		return nil, nil, diags
+	}
+
+	ws := req.GetWorkspace()
 	baseDir, err := isAbs(ws.GetFlightplan().GetBaseDir())
 	if err != nil {
 		return nil, nil, diagnostics.FromErr(err)
 	}
 
 	// Determine our output directory
-	outDir := ws.GetOutDir()
-	if outDir == "" {
-		outDir = outDirForWorkspace(req.GetWorkspace())
-	}
-
-	outDir, err = isAbs(outDir)
+	outDir, err := OutDirForWorkspace(ws)
 	if err != nil {
 		return nil, nil, diagnostics.FromErr(err)
 	}
 
 	// Generate the module
 	gen, err := generate.NewGenerator(
-		generate.WithScenario(scenarios[0]),
+		generate.WithScenario(scenario),
 		generate.WithScenarioBaseDirectory(baseDir),
 		generate.WithOutBaseDirectory(outDir),
 	)
@@ -188,7 +254,7 @@ func scenarioAndModuleGeneratorForReq(ctx context.Context, req *pb.Operation_Req
 		return nil, nil, diagnostics.FromErr(err)
 	}
 
-	return gen, scenarios[0], nil
+	return gen, scenario, nil
 }
 
 // moduleForReq returns a Terraform module for the request. It does not generate
@@ -5,8 +5,10 @@ package operation
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 	"github.com/hashicorp/go-hclog"
 	tfjson "github.com/hashicorp/terraform-json"
@@ -45,21 +47,29 @@ func DestroyScenario(req *pb.Operation_Request) WorkFunc {
 			WithLogger(log),
 		)
 
-		// Generate our module
-		genVal := runner.moduleGenerate(ctx, req, events).Generate
-		resVal.Destroy.Generate = genVal
-
-		// Determine our status
-		res.Status = diagnostics.Status(runner.TFConfig.FailOnWarnings, genVal.GetDiagnostics()...)
-
-		// Return early if we failed to generate our module
-		if hasFailedStatus(res.GetStatus()) {
-			if err := events.PublishResponse(res); err != nil {
-				res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
-				log.Error("failed to send event", ResponseDebugArgs(res)...)
+		if mod := req.GetDestroy().GetTerraformModule(); mod != nil {
+			// We've been asked to destroy a module that has already been generated.
+			// Use it as-is as its scenario might no longer be in the flight plan. If it
+			// still is we'll load the scenario so that we can run its hooks.
+			runner.TFConfig.WithModule(mod)
+			runner.loadScenario(ctx, req)
+		} else {
+			// Generate our module
+			genVal := runner.moduleGenerate(ctx, req, events).Generate
+			resVal.Destroy.Generate = genVal
+
+			// Determine our status
+			res.Status = diagnostics.Status(runner.TFConfig.FailOnWarnings, genVal.GetDiagnostics()...)
+
+			// Return early if we failed to generate our module
+			if hasFailedStatus(res.GetStatus()) {
+				if err := events.PublishResponse(res); err != nil {
+					res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+					log.Error("failed to send event", ResponseDebugArgs(res)...)
+				}
+
+				return res
 			}
-
-			return res
 		}
 
 		// Initialize the terraform module before we destroy. We do this to ensure
@@ -96,8 +106,24 @@ func DestroyScenario(req *pb.Operation_Request) WorkFunc {
 			return res
 		}
 
-		// Destroy the scenario
-		resVal.Destroy.Destroy = runner.terraformDestroy(ctx, req, events, state)
+		// Run our before_destroy hooks and destroy the scenario. If anything fails we'll run
+		// our on_failure hooks.
+		resVal.Destroy.Hooks = runner.runHooks(ctx, req, events, flightplan.HookBeforeDestroy)
+		if !runner.hooksHaveFailed(resVal.Destroy.GetHooks()) {
+			resVal.Destroy.Destroy = runner.terraformDestroy(ctx, req, events, state)
+			if !diagnostics.HasFailed(runner.TFConfig.FailOnWarnings, resVal.Destroy.GetDestroy().GetDiagnostics()) {
+				// Remove the expiry so that the reaper doesn't destroy the scenario again. It
+				// can't always tell that the scenario is gone, e.g. when using remote state.
+				resVal.Destroy.Diagnostics = append(resVal.Destroy.GetDiagnostics(), runner.recordExpiry(time.Time{})...)
+				resVal.Destroy.Hooks = append(resVal.Destroy.GetHooks(), runner.runHooks(ctx, req, events, flightplan.HookAfterDestroy)...)
+			}
+		}
+		if runner.hooksHaveFailed(resVal.Destroy.GetHooks()) ||
+			diagnostics.HasFailed(runner.TFConfig.FailOnWarnings, resVal.Destroy.GetDestroy().GetDiagnostics()) {
+			cleanupCtx, cancel := failureCleanupContext(ctx)
+			resVal.Destroy.Hooks = append(resVal.Destroy.GetHooks(), runner.runHooks(cleanupCtx, req, events, flightplan.HookOnFailure)...)
+			cancel()
+		}
 
 		// Determine our final status from all operations
 		res.Status = diagnostics.OperationStatus(runner.TFConfig.FailOnWarnings, res)
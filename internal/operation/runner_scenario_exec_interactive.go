@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package operation
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os/exec"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/pty"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+	"github.com/hashicorp/go-hclog"
+)
+
+// InteractiveExecIO is the input and output of an interactive exec.
+type InteractiveExecIO struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	// TTY allocates a pseudo-terminal for the sub-command. STDOUT and STDERR
+	// are both written to Stdout.
+	TTY bool
+	// Size is the initial size of the pseudo-terminal
+	Size *pty.Size
+	// Resize receives updates to the size of the pseudo-terminal
+	Resize <-chan *pty.Size
+	// Term is the TERM to set in the sub-command's environment
+	Term string
+}
+
+// ExecScenarioInteractive executes the Terraform sub-command of the request in the
+// context of the scenario's generated module and connects the sub-command to the
+// given input and output. Unlike ExecScenario it does not run through the operator
+// as it blocks until the sub-command exits. It returns the exit code of the sub-command.
+func ExecScenarioInteractive(
+	ctx context.Context,
+	req *pb.Operation_Request,
+	eio *InteractiveExecIO,
+	log hclog.Logger,
+) (int32, []*pb.Diagnostic) {
+	log = log.With(RequestDebugArgs(req)...)
+
+	runner := NewRunner(
+		WithRunnerTerraformConfig(req.GetWorkspace().GetTfExecCfg()),
+		WithLogger(log),
+	)
+
+	// As with exec we try and configure the sub-command with the module but
+	// don't require it.
+	mod, diags := moduleForReq(ctx, req)
+	for i := range diags {
+		if diags[i].GetSeverity() == pb.Diagnostic_SEVERITY_ERROR {
+			diags[i].Severity = pb.Diagnostic_SEVERITY_WARNING
+		}
+	}
+	runner.TFConfig.WithModule(mod)
+
+	if eio.Term != "" {
+		env := map[string]string{}
+		for k, v := range runner.TFConfig.Env {
+			env[k] = v
+		}
+		env["TERM"] = eio.Term
+		runner.TFConfig.Env = env
+	}
+
+	tfCmd := runner.TFConfig.NewExecSubCmd()
+	if tfCmd == nil {
+		return 1, append(diags, diagnostics.FromErr(diagnostics.ErrWithCode(
+			diagnostics.CodeTerraformUnavailable,
+			errors.New("unable to locate terraform binary"),
+		))...)
+	}
+	cmd := tfCmd.Cmd(ctx)
+
+	var err error
+	if eio.TTY {
+		err = runInteractiveTTY(cmd, eio, log)
+	} else {
+		err = runInteractivePipes(cmd, eio)
+	}
+
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			// The sub-command will have written its own errors
+			return int32(exitErr.ExitCode()), diags
+		}
+
+		return 1, append(diags, diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformExecFailed, err))...)
+	}
+
+	log.Debug("finished interactive exec")
+
+	return 0, diags
+}
+
+// runInteractiveTTY runs the command with a pseudo-terminal.
+func runInteractiveTTY(cmd *exec.Cmd, eio *InteractiveExecIO, log hclog.Logger) error {
+	ptmx, err := pty.Start(cmd, eio.Size)
+	if err != nil {
+		return err
+	}
+	defer ptmx.Close()
+
+	go func() {
+		for size := range eio.Resize {
+			if err := pty.Setsize(ptmx, size); err != nil {
+				log.Error("failed to resize terminal", "error", err)
+			}
+		}
+	}()
+
+	go func() {
+		_, _ = io.Copy(ptmx, eio.Stdin)
+	}()
+
+	// Reading from the terminal will return an error when the sub-command exits
+	// and closes the terminal, which is how we know that we've read everything.
+	outDone := make(chan struct{})
+	go func() {
+		defer close(outDone)
+		_, _ = io.Copy(eio.Stdout, ptmx)
+	}()
+
+	err = cmd.Wait()
+	<-outDone
+
+	return err
+}
+
+// runInteractivePipes runs the command with plain pipes.
+func runInteractivePipes(cmd *exec.Cmd, eio *InteractiveExecIO) error {
+	cmd.Stdout = eio.Stdout
+	cmd.Stderr = eio.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	if err = cmd.Start(); err != nil {
+		return err
+	}
+
+	// We copy stdin ourselves rather than passing the reader to the command
+	// because waiting for the command would otherwise block until our input
+	// has been closed. Wait closes the pipe when the command exits.
+	go func() {
+		_, _ = io.Copy(stdin, eio.Stdin)
+		_ = stdin.Close()
+	}()
+
+	err = cmd.Wait()
+
+	return err
+}
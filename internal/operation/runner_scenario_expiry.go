@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package operation
+
+import (
+	"time"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/generate"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// recordExpiry records when the launched scenario should be reaped in the manifest of the
+// generated module. A zero value removes any previously recorded expiry.
+func (r *Runner) recordExpiry(expiresAt time.Time) []*pb.Diagnostic {
+	mod := &generate.GeneratedModule{Dir: r.TFConfig.DirPath}
+	if err := mod.SetExpiresAt(expiresAt); err != nil {
+		r.log.Error("failed to record scenario expiry", "error", err)
+
+		return diagnostics.FromErr(err)
+	}
+
+	return nil
+}
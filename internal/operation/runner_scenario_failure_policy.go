@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package operation
+
+import (
+	"context"
+	"time"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// failureCleanupTimeout bounds how long we'll spend running on_failure hooks and applying the
+// failure policy after an operation has failed.
+const failureCleanupTimeout = 30 * time.Minute
+
+// failureCleanupContext returns a context for cleaning up after a failed operation. Operations
+// often fail because their context has been cancelled or has timed out, so the cleanup context
+// doesn't inherit the cancellation and has its own timeout instead.
+func failureCleanupContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), failureCleanupTimeout)
+}
+
+// failurePolicyFor returns the failure policy for the request. A policy that is passed with the
+// request takes precedence over the scenario's policy. If neither is set we keep the resources.
+func (r *Runner) failurePolicyFor(req *pb.Operation_Request) *pb.Operation_FailurePolicy {
+	for _, policy := range []*pb.Operation_FailurePolicy{
+		req.GetLaunch().GetFailurePolicy(),
+		req.GetRun().GetFailurePolicy(),
+		r.failurePolicy,
+	} {
+		if policy.GetType() != pb.Operation_FailurePolicy_TYPE_UNSPECIFIED {
+			return policy
+		}
+	}
+
+	return &pb.Operation_FailurePolicy{Type: pb.Operation_FailurePolicy_TYPE_KEEP}
+}
+
+// applyFailurePolicy applies the failure policy after launching the scenario has failed.
+func (r *Runner) applyFailurePolicy(
+	ctx context.Context,
+	req *pb.Operation_Request,
+	events *EventSender,
+) *pb.Operation_Response_FailurePolicy {
+	policy := r.failurePolicyFor(req)
+	res := &pb.Operation_Response_FailurePolicy{
+		Diagnostics: []*pb.Diagnostic{},
+		Policy:      policy,
+		Outcome:     pb.Operation_Response_FailurePolicy_OUTCOME_KEPT,
+	}
+	log := r.log.With(RequestDebugArgs(req)...).With("failure_policy", flightplan.FailurePolicyString(policy))
+
+	switch policy.GetType() {
+	case pb.Operation_FailurePolicy_TYPE_DESTROY:
+		log.Debug("destroying scenario after failed launch")
+
+		// Get the current state of the scenario because destroying requires it
+		res.PriorStateShow = r.terraformShow(ctx, req, events)
+		state := &tfjson.State{}
+		if err := state.UnmarshalJSON(res.GetPriorStateShow().GetState()); err != nil {
+			res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+		}
+
+		if diagnostics.HasFailed(
+			r.TFConfig.FailOnWarnings,
+			res.GetDiagnostics(),
+			res.GetPriorStateShow().GetDiagnostics(),
+		) {
+			res.Outcome = pb.Operation_Response_FailurePolicy_OUTCOME_DESTROY_FAILED
+
+			return res
+		}
+
+		res.Destroy = r.terraformDestroy(ctx, req, events, state)
+		if diagnostics.HasFailed(r.TFConfig.FailOnWarnings, res.GetDestroy().GetDiagnostics()) {
+			res.Outcome = pb.Operation_Response_FailurePolicy_OUTCOME_DESTROY_FAILED
+
+			return res
+		}
+
+		res.Outcome = pb.Operation_Response_FailurePolicy_OUTCOME_DESTROYED
+	case pb.Operation_FailurePolicy_TYPE_KEEP_FOR:
+		// We only record the expiry in the module manifest. Nothing destroys the scenario when
+		// it expires unless the reaper is running, either via "enos scenario reap" or the
+		// ReapScenarios RPC.
+		expiresAt := time.Now().Add(policy.GetKeepFor().AsDuration())
+		res.ExpiresAt = timestamppb.New(expiresAt)
+		res.Diagnostics = append(res.GetDiagnostics(), r.recordExpiry(expiresAt)...)
+		log.Debug("keeping scenario after failed launch", "expires_at", expiresAt)
+	case pb.Operation_FailurePolicy_TYPE_KEEP, pb.Operation_FailurePolicy_TYPE_UNSPECIFIED:
+		log.Debug("keeping scenario after failed launch")
+	default:
+	}
+
+	return res
+}
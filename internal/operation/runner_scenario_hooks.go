@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os/exec"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	"github.com/hashicorp/enos/internal/operation/command"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// runHooks runs all of the scenario hooks of the given type in the order in which they were
+// defined. Each hook publishes a hook event when it starts and when it finishes. If a hook fails
+// the remaining hooks of the type are not run.
+func (r *Runner) runHooks(
+	ctx context.Context,
+	req *pb.Operation_Request,
+	events *EventSender,
+	hookType string,
+) []*pb.Operation_Response_Hook {
+	res := []*pb.Operation_Response_Hook{}
+
+	hooks := r.scenario.HooksOfType(hookType)
+	if len(hooks) == 0 {
+		return res
+	}
+
+	log := r.log.With(RequestDebugArgs(req)...)
+
+	ref, err := NewReferenceFromRequest(req)
+	if err != nil {
+		log.Error("failed to create reference from request", "error", err)
+
+		return append(res, &pb.Operation_Response_Hook{
+			Type:        hookType,
+			Diagnostics: diagnostics.FromErr(err),
+		})
+	}
+
+	env := r.hookEnv(ctx, req, hookType)
+	for _, hook := range hooks {
+		hookRes := r.runHook(ctx, req, ref, events, hook, env)
+		res = append(res, hookRes)
+		if diagnostics.HasFailed(r.TFConfig.FailOnWarnings, hookRes.GetDiagnostics()) {
+			break
+		}
+	}
+
+	return res
+}
+
+// loadScenario decodes the scenario that the request refers to from the flight plan so that we
+// can run its hooks when operating on a module that has already been generated. Scenarios that are
+// no longer in the flight plan are skipped, as are scenarios that fail to decode.
+func (r *Runner) loadScenario(ctx context.Context, req *pb.Operation_Request) {
+	ref := req.GetScenario()
+	if ref.GetId().GetName() == "" {
+		return
+	}
+
+	scenario, diags := scenarioForReq(ctx, req)
+	if diagnostics.HasErrors(diags) {
+		r.log.With(RequestDebugArgs(req)...).Warn("unable to load scenario, its hooks will not be run",
+			"error", diagnostics.String(diags[0]),
+		)
+
+		return
+	}
+
+	if scenario.UID() != ref.GetId().GetUid() {
+		return
+	}
+
+	r.scenario = scenario
+}
+
+// runHook runs a single scenario hook.
+func (r *Runner) runHook(
+	ctx context.Context,
+	req *pb.Operation_Request,
+	ref *pb.Ref_Operation,
+	events *EventSender,
+	hook *flightplan.ScenarioHook,
+	env map[string]string,
+) *pb.Operation_Response_Hook {
+	res := &pb.Operation_Response_Hook{
+		Type:        hook.Type,
+		Command:     hook.Command,
+		Diagnostics: []*pb.Diagnostic{},
+	}
+	log := r.log.With(RequestDebugArgs(req)...).With("hook", hook.Type)
+
+	// Notify that we're running the hook
+	event := newEvent(ref, pb.Operation_STATUS_RUNNING)
+	event.Value = &pb.Operation_Event_Hook{Hook: res}
+	if err := events.Publish(event); err != nil {
+		res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+		log.Error("failed to publish event", "error", err)
+	}
+
+	env = maps.Clone(env)
+	for k, v := range hook.Env {
+		env[k] = v
+	}
+
+	stdout := &strings.Builder{}
+	stderr := &strings.Builder{}
+	cmd := command.NewCommand(hook.Command[0],
+		command.WithArgs(hook.Command[1:]...),
+		command.WithDir(req.GetWorkspace().GetFlightplan().GetBaseDir()),
+		command.WithEnvPassthrough(),
+		command.WithEnv(env),
+		command.WithOutput(stdout, stderr),
+	)
+
+	start := time.Now()
+	_, err := cmd.Run(ctx)
+	res.Elapsed = durationpb.New(time.Since(start))
+	res.Stdout = stdout.String()
+	res.Stderr = stderr.String()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			res.ExitCode = int32(exitErr.ExitCode())
+		}
+
+		err = fmt.Errorf("%s hook %s failed: %w", hook.Type, strings.Join(hook.Command, " "), err)
+		res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(
+			diagnostics.ErrWithCode(diagnostics.CodeScenarioHookFailed, err),
+		)...)
+	}
+
+	// Notify that we've finished
+	event.Status = diagnostics.Status(r.TFConfig.FailOnWarnings, res.GetDiagnostics()...)
+	event.Diagnostics = res.GetDiagnostics()
+	if err := events.Publish(event); err != nil {
+		log.Error("failed to publish event", "error", err)
+		res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+	}
+	log.Debug("finished hook")
+
+	return res
+}
+
+// hookEnv returns the environment variables that expose the scenario to hook commands. Outputs
+// are included for every hook type except before_launch, as they are only available after the
+// scenario has been launched.
+func (r *Runner) hookEnv(ctx context.Context, req *pb.Operation_Request, hookType string) map[string]string {
+	scenario := flightplan.NewScenario()
+	scenario.FromRef(req.GetScenario())
+
+	env := map[string]string{
+		"ENOS_HOOK":            hookType,
+		"ENOS_SCENARIO_NAME":   scenario.Name,
+		"ENOS_SCENARIO_UID":    scenario.UID(),
+		"ENOS_SCENARIO_FILTER": scenario.FilterStr(),
+		"ENOS_MODULE_DIR":      r.TFConfig.DirPath,
+	}
+
+	if scenario.Variants != nil {
+		for _, elm := range scenario.Variants.Elements() {
+			env["ENOS_VARIANT_"+hookEnvName(elm.Key)] = elm.Val
+		}
+	}
+
+	if hookType == flightplan.HookBeforeLaunch {
+		return env
+	}
+
+	tf, err := r.TFConfig.Terraform()
+	if err != nil {
+		r.log.Debug("unable to get outputs for hook", "error", err)
+
+		return env
+	}
+
+	outputs, err := tf.Output(ctx, r.TFConfig.OutputOptions()...)
+	if err != nil {
+		r.log.Debug("unable to get outputs for hook", "error", err)
+
+		return env
+	}
+
+	for name, meta := range outputs {
+		val := string(meta.Value)
+		var str string
+		if err := json.Unmarshal(meta.Value, &str); err == nil {
+			val = str
+		}
+		env["ENOS_OUTPUT_"+hookEnvName(name)] = val
+	}
+
+	return env
+}
+
+// hookEnvName returns the name as an upper case environment variable name.
+func hookEnvName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+
+		return '_'
+	}, name)
+}
+
+// hooksHaveFailed returns whether or not any hooks have failed.
+func (r *Runner) hooksHaveFailed(hooks []*pb.Operation_Response_Hook) bool {
+	for _, hook := range hooks {
+		if diagnostics.HasFailed(r.TFConfig.FailOnWarnings, hook.GetDiagnostics()) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -5,8 +5,12 @@ package operation
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 	"github.com/hashicorp/go-hclog"
 )
@@ -69,6 +73,9 @@ func LaunchScenario(req *pb.Operation_Request) WorkFunc {
 		resVal.Launch.Validate = launch.GetValidate()
 		resVal.Launch.Plan = launch.GetPlan()
 		resVal.Launch.Apply = launch.GetApply()
+		resVal.Launch.Hooks = launch.GetHooks()
+		resVal.Launch.FailurePolicy = launch.GetFailurePolicy()
+		resVal.Launch.ExpiresAt = launch.GetExpiresAt()
 
 		// Determine our final status from all operations
 		res.Status = diagnostics.OperationStatus(runner.TFConfig.FailOnWarnings, res)
@@ -88,10 +95,46 @@ func (r *Runner) scenarioLaunch(
 		Launch: &pb.Operation_Response_Launch{},
 	}
 
+	// onFailure runs our on_failure hooks. If we've attempted to apply the module we also apply
+	// our failure policy.
+	applied := false
+	onFailure := func() *pb.Operation_Response_Launch_ {
+		ctx, cancel := failureCleanupContext(ctx)
+		defer cancel()
+
+		res.Launch.Hooks = append(res.Launch.GetHooks(), r.runHooks(ctx, req, events, flightplan.HookOnFailure)...)
+		if applied {
+			res.Launch.FailurePolicy = r.applyFailurePolicy(ctx, req, events)
+			if expiresAt := res.Launch.GetFailurePolicy().GetExpiresAt(); expiresAt != nil {
+				res.Launch.ExpiresAt = expiresAt
+			}
+		}
+
+		return res
+	}
+
+	// Record when the scenario should be reaped. If we haven't been given a TTL we remove any
+	// previous expiry so that relaunching a scenario doesn't get it reaped unexpectedly.
+	expiresAt := time.Time{}
+	if ttl := req.GetLaunch().GetTtl(); ttl != nil {
+		expiresAt = time.Now().Add(ttl.AsDuration())
+		res.Launch.ExpiresAt = timestamppb.New(expiresAt)
+	}
+	res.Launch.Diagnostics = r.recordExpiry(expiresAt)
+	if diagnostics.HasFailed(r.TFConfig.FailOnWarnings, res.Launch.GetDiagnostics()) {
+		return res
+	}
+
+	// Run our before_launch hooks
+	res.Launch.Hooks = r.runHooks(ctx, req, events, flightplan.HookBeforeLaunch)
+	if r.hooksHaveFailed(res.Launch.GetHooks()) {
+		return onFailure()
+	}
+
 	// check the Terraform module
 	checkRes := r.scenarioCheck(ctx, req, events)
 
-	res.Launch.Diagnostics = checkRes.Check.GetDiagnostics()
+	res.Launch.Diagnostics = append(res.Launch.GetDiagnostics(), checkRes.Check.GetDiagnostics()...)
 	res.Launch.Init = checkRes.Check.GetInit()
 	res.Launch.Validate = checkRes.Check.GetValidate()
 	res.Launch.Plan = checkRes.Check.GetPlan()
@@ -104,10 +147,20 @@ func (r *Runner) scenarioLaunch(
 		res.Launch.GetValidate().GetDiagnostics(),
 		res.Launch.GetPlan().GetDiagnostics(),
 	) {
-		return res
+		return onFailure()
 	}
 
+	applied = true
 	res.Launch.Apply = r.terraformApply(ctx, req, events)
+	if diagnostics.HasFailed(r.TFConfig.FailOnWarnings, res.Launch.GetApply().GetDiagnostics()) {
+		return onFailure()
+	}
+
+	// Run our after_launch hooks
+	res.Launch.Hooks = append(res.Launch.GetHooks(), r.runHooks(ctx, req, events, flightplan.HookAfterLaunch)...)
+	if r.hooksHaveFailed(res.Launch.GetHooks()) {
+		return onFailure()
+	}
 
 	return res
 }
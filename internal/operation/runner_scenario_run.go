@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 	"github.com/hashicorp/go-hclog"
 	tfjson "github.com/hashicorp/terraform-json"
@@ -70,6 +71,8 @@ func RunScenario(req *pb.Operation_Request) WorkFunc {
 		resVal.Run.Plan = run.GetPlan()
 		resVal.Run.Apply = run.GetApply()
 		resVal.Run.Destroy = run.GetDestroy()
+		resVal.Run.Hooks = run.GetHooks()
+		resVal.Run.FailurePolicy = run.GetFailurePolicy()
 
 		// Determine our final status from all operations
 		res.Status = diagnostics.OperationStatus(runner.TFConfig.FailOnWarnings, res)
@@ -98,8 +101,11 @@ func (r *Runner) scenarioRun(
 	res.Run.Validate = launchRes.Launch.GetValidate()
 	res.Run.Plan = launchRes.Launch.GetPlan()
 	res.Run.Apply = launchRes.Launch.GetApply()
+	res.Run.Hooks = launchRes.Launch.GetHooks()
+	res.Run.FailurePolicy = launchRes.Launch.GetFailurePolicy()
 
-	// Return early if we failed to apply our module
+	// Return early if we failed to apply our module. Our on_failure hooks and failure policy have
+	// already been run when launching.
 	if diagnostics.HasFailed(
 		r.TFConfig.FailOnWarnings,
 		res.Run.GetDiagnostics(),
@@ -107,7 +113,17 @@ func (r *Runner) scenarioRun(
 		res.Run.GetValidate().GetDiagnostics(),
 		res.Run.GetPlan().GetDiagnostics(),
 		res.Run.GetApply().GetDiagnostics(),
-	) {
+	) || r.hooksHaveFailed(res.Run.GetHooks()) {
+		return res
+	}
+
+	// onFailure runs our on_failure hooks
+	onFailure := func() *pb.Operation_Response_Run_ {
+		ctx, cancel := failureCleanupContext(ctx)
+		defer cancel()
+
+		res.Run.Hooks = append(res.Run.GetHooks(), r.runHooks(ctx, req, events, flightplan.HookOnFailure)...)
+
 		return res
 	}
 
@@ -122,10 +138,25 @@ func (r *Runner) scenarioRun(
 	}
 
 	if diagnostics.HasFailed(r.TFConfig.FailOnWarnings, stateVal.GetDiagnostics()) {
-		return res
+		return onFailure()
+	}
+
+	// Run our before_destroy hooks
+	res.Run.Hooks = append(res.Run.GetHooks(), r.runHooks(ctx, req, events, flightplan.HookBeforeDestroy)...)
+	if r.hooksHaveFailed(res.Run.GetHooks()) {
+		return onFailure()
 	}
 
 	res.Run.Destroy = r.terraformDestroy(ctx, req, events, state)
+	if diagnostics.HasFailed(r.TFConfig.FailOnWarnings, res.Run.GetDestroy().GetDiagnostics()) {
+		return onFailure()
+	}
+
+	// Run our after_destroy hooks
+	res.Run.Hooks = append(res.Run.GetHooks(), r.runHooks(ctx, req, events, flightplan.HookAfterDestroy)...)
+	if r.hooksHaveFailed(res.Run.GetHooks()) {
+		return onFailure()
+	}
 
 	return res
 }
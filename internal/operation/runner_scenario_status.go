@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package operation
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/generate"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+	"github.com/hashicorp/go-hclog"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// StatusScenario takes an operation request for state and returns a worker function
+// that determines whether or not a scenario has state and which step modules the
+// resources belong to.
+func StatusScenario(req *pb.Operation_Request) WorkFunc {
+	return func(
+		ctx context.Context,
+		eventC chan *pb.Operation_Event,
+		log hclog.Logger,
+	) *pb.Operation_Response {
+		log = log.With(RequestDebugArgs(req)...)
+		events := NewEventSender(eventC)
+
+		resVal := &pb.Operation_Response_State_{
+			State: &pb.Operation_Response_State{},
+		}
+
+		// Create our new response from our request
+		res, err := NewResponseFromRequest(req)
+		if err != nil {
+			log.Debug("failed to create response", "error", err)
+			if err = events.PublishResponse(res); err != nil {
+				log.Error("failed to send event", "error", err)
+			}
+
+			return res
+		}
+		res.Value = resVal
+
+		runner := NewRunner(
+			WithRunnerTerraformConfig(req.GetWorkspace().GetTfExecCfg()),
+			WithLogger(log),
+		)
+
+		// Find where the module should be. We never generate it here because a
+		// module that doesn't exist can't have any state.
+		mod, diags := moduleForReq(ctx, req)
+		resVal.State.Diagnostics = append(resVal.State.GetDiagnostics(), diags...)
+		resVal.State.TerraformModule = mod
+
+		// publishRes determines our final status and publishes the response
+		publishRes := func() *pb.Operation_Response {
+			res.Status = diagnostics.OperationStatus(runner.TFConfig.FailOnWarnings, res)
+			if err := events.PublishResponse(res); err != nil {
+				res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+				log.Error("failed to send event", ResponseDebugArgs(res)...)
+			}
+
+			return res
+		}
+
+		if diagnostics.HasFailed(runner.TFConfig.FailOnWarnings, resVal.State.GetDiagnostics()) {
+			log.Debug("failed to load Terraform module")
+
+			return publishRes()
+		}
+
+		genMod := &generate.GeneratedModule{
+			Dir: filepath.Dir(mod.GetModulePath()),
+			UID: filepath.Base(filepath.Dir(mod.GetModulePath())),
+		}
+
+		// If the module has never been initialized and has no local state then
+		// it has never been launched.
+		launched := false
+		for _, path := range []string{
+			filepath.Join(genMod.Dir, ".terraform"),
+			genMod.LocalStatePath(),
+		} {
+			_, err := os.Stat(path)
+			if err == nil {
+				launched = true
+
+				continue
+			}
+
+			if !errors.Is(err, fs.ErrNotExist) {
+				resVal.State.Diagnostics = append(resVal.State.GetDiagnostics(), diagnostics.FromErr(err)...)
+
+				return publishRes()
+			}
+		}
+
+		if !launched {
+			log.Debug("scenario module has not been launched")
+
+			return publishRes()
+		}
+
+		// Only local state has a modification time that we can rely on.
+		modState, err := genMod.State()
+		if err != nil {
+			resVal.State.Diagnostics = append(resVal.State.GetDiagnostics(), diagnostics.FromErr(err)...)
+		}
+		if modState != generate.ModuleStateUnknown {
+			info, err := os.Stat(genMod.LocalStatePath())
+			if err == nil {
+				resVal.State.LastModified = timestamppb.New(info.ModTime())
+			} else if !errors.Is(err, fs.ErrNotExist) {
+				resVal.State.Diagnostics = append(resVal.State.GetDiagnostics(), diagnostics.FromErr(err)...)
+			}
+		}
+
+		// Configure our Terraform executor to use the existing module
+		runner.TFConfig.WithModule(mod)
+
+		// Terraform needs the providers to be installed to show the state. They
+		// might be missing if the module has never been initialized or if the
+		// provider cache has been cleaned.
+		if _, err := os.Stat(genMod.ProviderCacheDir()); err != nil {
+			resVal.State.Init = runner.terraformInit(ctx, req, events)
+			if diagnostics.HasFailed(runner.TFConfig.FailOnWarnings, resVal.State.GetInit().GetDiagnostics()) {
+				return publishRes()
+			}
+		}
+
+		// Get the current state of the scenario. This works for both local and
+		// remote backends as Terraform will read the state from the backend.
+		resVal.State.Show = runner.terraformShow(ctx, req, events)
+		if diagnostics.HasFailed(runner.TFConfig.FailOnWarnings, resVal.State.GetShow().GetDiagnostics()) {
+			return publishRes()
+		}
+
+		state := &tfjson.State{}
+		if err = state.UnmarshalJSON(resVal.State.GetShow().GetState()); err != nil {
+			resVal.State.Diagnostics = append(resVal.State.GetDiagnostics(), diagnostics.FromErr(err)...)
+
+			return publishRes()
+		}
+
+		resVal.State.ResourceCount, resVal.State.Steps = stateResourceCounts(state)
+		resVal.State.Exists = resVal.State.GetResourceCount() > 0
+
+		// Determine our final status from all operations
+		res.Status = diagnostics.OperationStatus(runner.TFConfig.FailOnWarnings, res)
+
+		return res
+	}
+}
+
+// stateResourceCounts returns the total number of managed resources in the state
+// and the number of managed resources in each step module.
+func stateResourceCounts(state *tfjson.State) (int64, []*pb.Operation_Response_State_Step) {
+	if state == nil || state.Values == nil || state.Values.RootModule == nil {
+		return 0, nil
+	}
+
+	total := countManagedResources(state.Values.RootModule)
+	steps := []*pb.Operation_Response_State_Step{}
+	for _, child := range state.Values.RootModule.ChildModules {
+		// Each step is a module in the root module, eg: module.step_name
+		steps = append(steps, &pb.Operation_Response_State_Step{
+			Name:          strings.TrimPrefix(child.Address, "module."),
+			ResourceCount: countManagedResources(child),
+		})
+	}
+
+	slices.SortStableFunc(steps, func(a, b *pb.Operation_Response_State_Step) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	return total, steps
+}
+
+// countManagedResources recursively counts all the managed resources in the module
+// and its child modules.
+func countManagedResources(mod *tfjson.StateModule) int64 {
+	if mod == nil {
+		return 0
+	}
+
+	var count int64
+	for _, r := range mod.Resources {
+		if r.Mode == tfjson.ManagedResourceMode {
+			count++
+		}
+	}
+
+	for _, child := range mod.ChildModules {
+		count += countManagedResources(child)
+	}
+
+	return count
+}
@@ -5,8 +5,11 @@ package operation
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/operation/terraform"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 )
 
@@ -55,19 +58,41 @@ func (r *Runner) terraformApply(
 	// Create our terraform executor
 	tf, err := r.TFConfig.Terraform()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformUnavailable, err)))
 
 		return res
 	}
 
-	// terraform apply
+	// Enforce step timeouts by watching how long each step has been applying
+	applyCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	publish := newProgressPublisher(ref, events, log)
+	timer := terraform.NewStepTimer(r.stepTimeouts)
+	if len(r.stepTimeouts) > 0 {
+		go timer.Watch(applyCtx, time.Second, cancel)
+	}
+
+	// terraform apply -json so that we can publish resource level progress
 	applyOut := NewTextOutput()
-	tf.SetStdout(applyOut.Stdout)
+	progress := terraform.NewProgressWriter(func(p *pb.Terraform_Command_Progress) {
+		timer.Observe(p)
+		publish(p)
+	})
 	tf.SetStderr(applyOut.Stderr)
-	err = tf.Apply(ctx, r.TFConfig.ApplyOptions()...)
+	err = tf.ApplyJSON(applyCtx, progress, r.TFConfig.ApplyOptions()...)
 	res.Stderr = applyOut.Stderr.String()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		code := diagnostics.CodeTerraformApplyFailed
+		var timeoutErr *terraform.StepTimeoutError
+		if errors.As(context.Cause(applyCtx), &timeoutErr) {
+			code = diagnostics.CodeTerraformStepTimeout
+			err = timeoutErr
+		}
+
+		notifyFail(append(
+			diagnostics.FromTFJSON(progress.Diagnostics()),
+			diagnostics.FromErr(diagnostics.ErrWithCode(code, err))...,
+		))
 
 		return res
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/operation/terraform"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 	tfjson "github.com/hashicorp/terraform-json"
 )
@@ -78,18 +79,22 @@ func (r *Runner) terraformDestroy(
 	// Create our terraform executor
 	tf, err := r.TFConfig.Terraform()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformUnavailable, err)))
 
 		return res
 	}
 
+	// terraform destroy -json so that we can publish resource level progress
 	destroyOut := NewTextOutput()
-	tf.SetStdout(destroyOut.Stdout)
+	progress := terraform.NewProgressWriter(newProgressPublisher(ref, events, log))
 	tf.SetStderr(destroyOut.Stderr)
-	err = tf.Destroy(ctx, r.TFConfig.DestroyOptions()...)
+	err = tf.DestroyJSON(ctx, progress, r.TFConfig.DestroyOptions()...)
 	res.Stderr = destroyOut.Stderr.String()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(append(
+			diagnostics.FromTFJSON(progress.Diagnostics()),
+			diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformDestroyFailed, err))...,
+		))
 
 		return res
 	}
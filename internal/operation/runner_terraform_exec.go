@@ -70,7 +70,7 @@ func (r *Runner) terraformExec(
 	res.Stdout = stdout.String()
 	res.Stderr = execOut.Stderr.String()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformExecFailed, err)))
 
 		return res
 	}
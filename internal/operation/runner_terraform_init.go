@@ -55,7 +55,7 @@ func (r *Runner) terraformInit(
 	// Create our terraform executor
 	tf, err := r.TFConfig.Terraform()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformUnavailable, err)))
 
 		return res
 	}
@@ -67,7 +67,7 @@ func (r *Runner) terraformInit(
 	err = tf.Init(ctx, r.TFConfig.InitOptions()...)
 	res.Stderr = initOut.Stderr.String()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformInitFailed, err)))
 
 		return res
 	}
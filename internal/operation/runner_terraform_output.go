@@ -56,7 +56,7 @@ func (r *Runner) terraformOutput(
 	// Create our terraform executor
 	tf, err := r.TFConfig.Terraform()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformUnavailable, err)))
 
 		return res
 	}
@@ -81,7 +81,7 @@ func (r *Runner) terraformOutput(
 
 	metas, err := tf.Output(ctx, r.TFConfig.OutputOptions()...)
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformOutputFailed, err)))
 
 		return res
 	}
@@ -89,7 +89,10 @@ func (r *Runner) terraformOutput(
 	if r.TFConfig.OutputName != "" {
 		meta, found := metas[r.TFConfig.OutputName]
 		if !found {
-			notifyFail(diagnostics.FromErr(fmt.Errorf("no output with key %s", r.TFConfig.OutputName)))
+			notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(
+				diagnostics.CodeTerraformOutputFailed,
+				fmt.Errorf("no output with key %s", r.TFConfig.OutputName),
+			)))
 
 			return res
 		}
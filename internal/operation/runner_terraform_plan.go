@@ -55,7 +55,7 @@ func (r *Runner) terraformPlan(
 	// Create our terraform executor
 	tf, err := r.TFConfig.Terraform()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformUnavailable, err)))
 
 		return res
 	}
@@ -68,7 +68,7 @@ func (r *Runner) terraformPlan(
 	res.ChangesPresent = changes
 	res.Stderr = planOut.Stderr.String()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformPlanFailed, err)))
 
 		return res
 	}
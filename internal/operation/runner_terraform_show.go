@@ -56,7 +56,7 @@ func (r *Runner) terraformShow(
 	// Create our terraform executor
 	tf, err := r.TFConfig.Terraform()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformUnavailable, err)))
 
 		return res
 	}
@@ -67,7 +67,7 @@ func (r *Runner) terraformShow(
 	tf.SetStderr(showOut.Stderr)
 	state, err := tf.Show(ctx, r.TFConfig.ShowOptions()...)
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformShowFailed, err)))
 
 		return res
 	}
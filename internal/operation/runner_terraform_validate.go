@@ -56,7 +56,7 @@ func (r *Runner) terraformValidate(
 	// Create our terraform executor
 	tf, err := r.TFConfig.Terraform()
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformUnavailable, err)))
 
 		return res
 	}
@@ -79,7 +79,7 @@ func (r *Runner) terraformValidate(
 		}
 	}
 	if err != nil {
-		notifyFail(diagnostics.FromErr(err))
+		notifyFail(diagnostics.FromErr(diagnostics.ErrWithCode(diagnostics.CodeTerraformValidateFailed, err)))
 
 		return res
 	}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package format
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// progressVerbs are the progressive and noun forms of resource actions as Terraform writes them.
+var progressVerbs = map[string][2]string{
+	"create":  {"Creating", "Creation"},
+	"read":    {"Reading", "Read"},
+	"update":  {"Modifying", "Modifications"},
+	"replace": {"Replacing", "Replacement"},
+	"delete":  {"Destroying", "Destruction"},
+}
+
+// Progress takes Terraform resource progress and returns it as a human friendly string that
+// resembles Terraform's own UI.
+func Progress(p *pb.Terraform_Command_Progress) string {
+	if p == nil {
+		return ""
+	}
+
+	verbs, ok := progressVerbs[p.GetAction()]
+	if !ok {
+		verbs = [2]string{"Applying", "Apply"}
+	}
+	elapsed := p.GetElapsed().AsDuration().Round(time.Second)
+
+	switch p.GetType() {
+	case pb.Terraform_Command_Progress_TYPE_APPLY_START:
+		return fmt.Sprintf("%s: %s...", p.GetAddress(), verbs[0])
+	case pb.Terraform_Command_Progress_TYPE_APPLY_PROGRESS:
+		return fmt.Sprintf("%s: Still %s... [%s elapsed]", p.GetAddress(), strings.ToLower(verbs[0]), elapsed)
+	case pb.Terraform_Command_Progress_TYPE_APPLY_COMPLETE:
+		msg := fmt.Sprintf("%s: %s complete after %s", p.GetAddress(), verbs[1], elapsed)
+		if id := p.GetId(); id != "" {
+			msg = fmt.Sprintf("%s [id=%s]", msg, id)
+		}
+
+		return msg
+	case pb.Terraform_Command_Progress_TYPE_APPLY_ERRORED:
+		return fmt.Sprintf("%s: %s errored after %s", p.GetAddress(), verbs[1], elapsed)
+	case pb.Terraform_Command_Progress_TYPE_UNSPECIFIED:
+		return p.GetAddress()
+	default:
+		return p.GetAddress()
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package terraform
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// uiMessage is a message of Terraform's machine readable UI output. Only the message types and
+// fields that we need are decoded.
+// See: https://developer.hashicorp.com/terraform/internals/machine-readable-ui
+type uiMessage struct {
+	Type       string             `json:"type"`
+	Hook       *uiHook            `json:"hook,omitempty"`
+	Diagnostic *tfjson.Diagnostic `json:"diagnostic,omitempty"`
+}
+
+type uiHook struct {
+	Resource struct {
+		Addr   string `json:"addr"`
+		Module string `json:"module"`
+	} `json:"resource"`
+	Action         string  `json:"action"`
+	IDValue        string  `json:"id_value"`
+	ElapsedSeconds float64 `json:"elapsed_seconds"`
+}
+
+// ProgressWriter is an io.Writer for the machine readable output of terraform apply and destroy
+// that calls the progress func for every resource level progress message. Diagnostic messages are
+// collected and can be retrieved with Diagnostics().
+type ProgressWriter struct {
+	progress func(*pb.Terraform_Command_Progress)
+	mu       sync.Mutex
+	buf      []byte
+	diags    []tfjson.Diagnostic
+}
+
+// NewProgressWriter takes a progress func and returns a new ProgressWriter.
+func NewProgressWriter(progress func(*pb.Terraform_Command_Progress)) *ProgressWriter {
+	return &ProgressWriter{progress: progress}
+}
+
+// Write writes machine readable UI output. Each complete line is parsed as a message.
+func (w *ProgressWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+
+		w.handleLine(w.buf[:i])
+		w.buf = w.buf[i+1:]
+	}
+
+	return len(p), nil
+}
+
+// Diagnostics returns the diagnostics that Terraform reported.
+func (w *ProgressWriter) Diagnostics() []tfjson.Diagnostic {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.buf) > 0 {
+		w.handleLine(w.buf)
+		w.buf = nil
+	}
+
+	return w.diags
+}
+
+func (w *ProgressWriter) handleLine(line []byte) {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return
+	}
+
+	msg := &uiMessage{}
+	if err := json.Unmarshal(line, msg); err != nil {
+		// Terraform should only write JSON, ignore anything else
+		return
+	}
+
+	if msg.Type == "diagnostic" && msg.Diagnostic != nil {
+		w.diags = append(w.diags, *msg.Diagnostic)
+
+		return
+	}
+
+	progress := msg.progress()
+	if progress != nil && w.progress != nil {
+		w.progress(progress)
+	}
+}
+
+// progress returns the message as resource progress if it is a hook message of a resource.
+func (m *uiMessage) progress() *pb.Terraform_Command_Progress {
+	if m.Hook == nil {
+		return nil
+	}
+
+	var t pb.Terraform_Command_Progress_Type
+	switch m.Type {
+	case "apply_start":
+		t = pb.Terraform_Command_Progress_TYPE_APPLY_START
+	case "apply_progress":
+		t = pb.Terraform_Command_Progress_TYPE_APPLY_PROGRESS
+	case "apply_complete":
+		t = pb.Terraform_Command_Progress_TYPE_APPLY_COMPLETE
+	case "apply_errored":
+		t = pb.Terraform_Command_Progress_TYPE_APPLY_ERRORED
+	default:
+		return nil
+	}
+
+	return &pb.Terraform_Command_Progress{
+		Type:    t,
+		Address: m.Hook.Resource.Addr,
+		Step:    stepFromModule(m.Hook.Resource.Module),
+		Action:  m.Hook.Action,
+		Elapsed: durationpb.New(time.Duration(m.Hook.ElapsedSeconds * float64(time.Second))),
+		Id:      m.Hook.IDValue,
+	}
+}
+
+// stepFromModule returns the step name of a resource module address. Each scenario step is a
+// module call in the root module, so the step is the name of the outermost module call.
+func stepFromModule(module string) string {
+	step, ok := strings.CutPrefix(module, "module.")
+	if !ok {
+		return ""
+	}
+
+	if i := strings.IndexAny(step, ".["); i >= 0 {
+		step = step[:i]
+	}
+
+	return step
+}
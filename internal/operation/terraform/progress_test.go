@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package terraform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// TestProgressWriter tests that the machine readable UI output is parsed into progress and
+// diagnostics, even when lines are split across writes.
+func TestProgressWriter(t *testing.T) {
+	t.Parallel()
+
+	out := `{"@level":"info","@message":"Terraform 1.9.0","type":"version","terraform":"1.9.0","ui":"1.2"}
+{"@level":"info","@message":"module.vpc.aws_vpc.vpc: Creating...","hook":{"resource":{"addr":"module.vpc.aws_vpc.vpc","module":"module.vpc","resource":"aws_vpc.vpc"},"action":"create"},"type":"apply_start"}
+{"@level":"info","@message":"module.vpc.aws_vpc.vpc: Still creating... [10s elapsed]","hook":{"resource":{"addr":"module.vpc.aws_vpc.vpc","module":"module.vpc","resource":"aws_vpc.vpc"},"action":"create","elapsed_seconds":10},"type":"apply_progress"}
+{"@level":"info","@message":"module.vpc.aws_vpc.vpc: Creation complete after 12s [id=vpc-123]","hook":{"resource":{"addr":"module.vpc.aws_vpc.vpc","module":"module.vpc","resource":"aws_vpc.vpc"},"action":"create","id_key":"id","id_value":"vpc-123","elapsed_seconds":12},"type":"apply_complete"}
+{"@level":"info","@message":"module.vault[0].module.x.aws_instance.i: Destroying...","hook":{"resource":{"addr":"module.vault[0].module.x.aws_instance.i","module":"module.vault[0].module.x","resource":"aws_instance.i"},"action":"delete"},"type":"apply_start"}
+{"@level":"error","@message":"Error: boom","diagnostic":{"severity":"error","summary":"boom","detail":"it went boom"},"type":"diagnostic"}
+`
+
+	got := []*pb.Terraform_Command_Progress{}
+	w := NewProgressWriter(func(p *pb.Terraform_Command_Progress) {
+		got = append(got, p)
+	})
+
+	// Write in uneven chunks so that lines are split across writes
+	for i := 0; i < len(out); i += 37 {
+		_, err := w.Write([]byte(out[i:min(i+37, len(out))]))
+		require.NoError(t, err)
+	}
+
+	require.Len(t, got, 4)
+	require.Equal(t, pb.Terraform_Command_Progress_TYPE_APPLY_START, got[0].GetType())
+	require.Equal(t, "module.vpc.aws_vpc.vpc", got[0].GetAddress())
+	require.Equal(t, "vpc", got[0].GetStep())
+	require.Equal(t, "create", got[0].GetAction())
+	require.Equal(t, pb.Terraform_Command_Progress_TYPE_APPLY_PROGRESS, got[1].GetType())
+	require.Equal(t, 10*time.Second, got[1].GetElapsed().AsDuration())
+	require.Equal(t, pb.Terraform_Command_Progress_TYPE_APPLY_COMPLETE, got[2].GetType())
+	require.Equal(t, "vpc-123", got[2].GetId())
+	require.Equal(t, "vault", got[3].GetStep())
+	require.Equal(t, "delete", got[3].GetAction())
+
+	diags := w.Diagnostics()
+	require.Len(t, diags, 1)
+	require.Equal(t, "boom", diags[0].Summary)
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// StepTimeoutError is the error returned when a step has exceeded its timeout.
+type StepTimeoutError struct {
+	Step    string
+	Timeout time.Duration
+}
+
+// Error returns the error message.
+func (e *StepTimeoutError) Error() string {
+	return fmt.Sprintf("step %s exceeded its timeout of %s", e.Step, e.Timeout)
+}
+
+// StepTimer observes resource progress to determine how long each step has been applying. A step
+// starts applying when the first of its resources starts applying.
+type StepTimer struct {
+	timeouts map[string]time.Duration
+	mu       sync.Mutex
+	started  map[string]time.Time
+	inflight map[string]int
+	now      func() time.Time
+}
+
+// NewStepTimer takes the timeouts of steps and returns a new StepTimer.
+func NewStepTimer(timeouts map[string]time.Duration) *StepTimer {
+	return &StepTimer{
+		timeouts: timeouts,
+		started:  map[string]time.Time{},
+		inflight: map[string]int{},
+		now:      time.Now,
+	}
+}
+
+// Observe observes resource progress.
+func (s *StepTimer) Observe(p *pb.Terraform_Command_Progress) {
+	step := p.GetStep()
+	if step == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch p.GetType() {
+	case pb.Terraform_Command_Progress_TYPE_APPLY_START:
+		if _, ok := s.started[step]; !ok {
+			s.started[step] = s.now()
+		}
+		s.inflight[step]++
+	case pb.Terraform_Command_Progress_TYPE_APPLY_COMPLETE, pb.Terraform_Command_Progress_TYPE_APPLY_ERRORED:
+		if s.inflight[step] > 0 {
+			s.inflight[step]--
+		}
+	case pb.Terraform_Command_Progress_TYPE_APPLY_PROGRESS, pb.Terraform_Command_Progress_TYPE_UNSPECIFIED:
+	default:
+	}
+}
+
+// Exceeded returns a *StepTimeoutError if a step that is still applying has exceeded its timeout.
+func (s *StepTimer) Exceeded() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	steps := []string{}
+	for step := range s.inflight {
+		steps = append(steps, step)
+	}
+	sort.Strings(steps)
+
+	for _, step := range steps {
+		timeout, ok := s.timeouts[step]
+		if !ok || timeout <= 0 || s.inflight[step] < 1 {
+			continue
+		}
+
+		if s.now().Sub(s.started[step]) > timeout {
+			return &StepTimeoutError{Step: step, Timeout: timeout}
+		}
+	}
+
+	return nil
+}
+
+// Watch checks whether any step has exceeded its timeout every interval until the context is
+// done. If a step has exceeded its timeout the cancel func is called with the *StepTimeoutError.
+func (s *StepTimer) Watch(ctx context.Context, interval time.Duration, cancel context.CancelCauseFunc) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Exceeded(); err != nil {
+				cancel(err)
+
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package terraform
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// TestStepTimer tests that a step that is still applying after its timeout is reported.
+func TestStepTimer(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	timer := NewStepTimer(map[string]time.Duration{
+		"verify":  10 * time.Minute,
+		"cluster": time.Hour,
+	})
+	timer.now = func() time.Time { return now }
+
+	progress := func(step string, t pb.Terraform_Command_Progress_Type) *pb.Terraform_Command_Progress {
+		return &pb.Terraform_Command_Progress{Type: t, Step: step}
+	}
+
+	timer.Observe(progress("cluster", pb.Terraform_Command_Progress_TYPE_APPLY_START))
+	timer.Observe(progress("infra", pb.Terraform_Command_Progress_TYPE_APPLY_START))
+	timer.Observe(progress("verify", pb.Terraform_Command_Progress_TYPE_APPLY_START))
+	timer.Observe(progress("verify", pb.Terraform_Command_Progress_TYPE_APPLY_START))
+	require.NoError(t, timer.Exceeded())
+
+	// Only verify has exceeded its timeout, infra does not have one
+	now = now.Add(11 * time.Minute)
+	timer.Observe(progress("verify", pb.Terraform_Command_Progress_TYPE_APPLY_COMPLETE))
+	err := timer.Exceeded()
+	require.Error(t, err)
+	var timeoutErr *StepTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	require.Equal(t, "verify", timeoutErr.Step)
+	require.Equal(t, 10*time.Minute, timeoutErr.Timeout)
+
+	// A step that has finished applying is not reported
+	timer.Observe(progress("verify", pb.Terraform_Command_Progress_TYPE_APPLY_ERRORED))
+	require.NoError(t, timer.Exceeded())
+
+	// Watch cancels with the timeout error
+	now = now.Add(time.Hour)
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	timer.Watch(ctx, time.Millisecond, cancel)
+	require.ErrorAs(t, context.Cause(ctx), &timeoutErr)
+	require.Equal(t, "cluster", timeoutErr.Step)
+}
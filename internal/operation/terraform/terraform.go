@@ -29,6 +29,7 @@ type Config struct {
 	ExecSubCmd     string            // raw command to run
 	OutputName     string            // output name
 	FailOnWarnings bool              // fail on warning diagnostics
+	Targets        []string          // resource addresses to target during plan and apply
 	Flags          *pb.Terraform_Runner_Config_Flags
 }
 
@@ -304,18 +305,24 @@ func (c *Config) InitOptions() []tfexec.InitOption {
 
 // PlanOptions are the plan command options.
 func (c *Config) PlanOptions() []tfexec.PlanOption {
-	return []tfexec.PlanOption{
+	opts := []tfexec.PlanOption{
 		tfexec.Refresh(!c.Flags.GetNoRefresh()),
 		tfexec.LockTimeout(c.lockTimeoutString()),
 		tfexec.Lock(!c.Flags.GetNoLock()),
 		tfexec.Parallelism(int(c.Flags.GetParallelism())),
 		tfexec.Refresh(c.Flags.GetRefreshOnly()),
 	}
+
+	for _, target := range c.Targets {
+		opts = append(opts, tfexec.Target(target))
+	}
+
+	return opts
 }
 
 // ApplyOptions are the apply command options.
 func (c *Config) ApplyOptions() []tfexec.ApplyOption {
-	return []tfexec.ApplyOption{
+	opts := []tfexec.ApplyOption{
 		tfexec.Backup(c.Flags.GetBackupStateFilePath()),
 		tfexec.Refresh(!c.Flags.GetNoRefresh()),
 		tfexec.LockTimeout(c.lockTimeoutString()),
@@ -323,6 +330,12 @@ func (c *Config) ApplyOptions() []tfexec.ApplyOption {
 		tfexec.Parallelism(int(c.Flags.GetParallelism())),
 		tfexec.Refresh(c.Flags.GetRefreshOnly()),
 	}
+
+	for _, target := range c.Targets {
+		opts = append(opts, tfexec.Target(target))
+	}
+
+	return opts
 }
 
 // DestroyOptions are the destroy command options.
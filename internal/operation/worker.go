@@ -74,6 +74,8 @@ func newWorkReqForOpReq(op *pb.Operation_Request) (*workReq, error) {
 		req.f = ExecScenario(op)
 	case *pb.Operation_Request_Output_:
 		req.f = OutputScenario(op)
+	case *pb.Operation_Request_State_:
+		req.f = StatusScenario(op)
 	default:
 		req.f, err = UnknownWorkFunc(op)
 	}
@@ -0,0 +1,18 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package pty allocates pseudo-terminals for commands that need to be run interactively.
+package pty
+
+import (
+	"errors"
+)
+
+// ErrUnsupported is returned when pseudo-terminals are not supported on the platform.
+var ErrUnsupported = errors.New("pseudo-terminals are not supported on this platform")
+
+// Size is the size of a terminal.
+type Size struct {
+	Rows uint16
+	Cols uint16
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build darwin
+
+package pty
+
+import (
+	"bytes"
+	"os"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// open opens a new pseudo-terminal and returns the primary and secondary sides.
+func open() (*os.File, *os.File, error) {
+	ptmx, err := os.OpenFile("/dev/ptmx", os.O_RDWR|unix.O_NOCTTY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Grant and unlock the secondary side and get its name
+	fd := int(ptmx.Fd())
+	if err = unix.IoctlSetInt(fd, unix.TIOCPTYGRANT, 0); err != nil {
+		_ = ptmx.Close()
+
+		return nil, nil, err
+	}
+
+	if err = unix.IoctlSetInt(fd, unix.TIOCPTYUNLK, 0); err != nil {
+		_ = ptmx.Close()
+
+		return nil, nil, err
+	}
+
+	name := make([]byte, 128)
+	//nolint:gosec // G103 we need to pass the buffer to the ioctl
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), uintptr(unix.TIOCPTYGNAME), uintptr(unsafe.Pointer(&name[0])))
+	if errno != 0 {
+		_ = ptmx.Close()
+
+		return nil, nil, errno
+	}
+
+	if i := bytes.IndexByte(name, 0); i >= 0 {
+		name = name[:i]
+	}
+
+	tty, err := os.OpenFile(string(name), os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		_ = ptmx.Close()
+
+		return nil, nil, err
+	}
+
+	return ptmx, tty, nil
+}
@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !linux && !darwin
+
+package pty
+
+import (
+	"os"
+	"os/exec"
+)
+
+// Start is not supported on this platform.
+func Start(cmd *exec.Cmd, size *Size) (*os.File, error) {
+	return nil, ErrUnsupported
+}
+
+// Setsize is not supported on this platform.
+func Setsize(ptmx *os.File, size *Size) error {
+	return ErrUnsupported
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build linux || darwin
+
+package pty
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// Start allocates a pseudo-terminal, attaches it to the commands STDIN, STDOUT and
+// STDERR as its controlling terminal, and starts the command. It returns the primary
+// side of the terminal which the caller is responsible for closing.
+func Start(cmd *exec.Cmd, size *Size) (*os.File, error) {
+	ptmx, tty, err := open()
+	if err != nil {
+		return nil, err
+	}
+	// The command has its own copy of the tty after it has been started.
+	defer tty.Close()
+
+	if size != nil {
+		if err = Setsize(ptmx, size); err != nil {
+			_ = ptmx.Close()
+
+			return nil, err
+		}
+	}
+
+	cmd.Stdin = tty
+	cmd.Stdout = tty
+	cmd.Stderr = tty
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	// Start the command in a new session with the tty as the controlling terminal
+	cmd.SysProcAttr.Setsid = true
+	cmd.SysProcAttr.Setctty = true
+
+	if err = cmd.Start(); err != nil {
+		_ = ptmx.Close()
+
+		return nil, err
+	}
+
+	return ptmx, nil
+}
+
+// Setsize resizes the terminal.
+func Setsize(ptmx *os.File, size *Size) error {
+	return unix.IoctlSetWinsize(int(ptmx.Fd()), unix.TIOCSWINSZ, &unix.Winsize{
+		Row: size.Rows,
+		Col: size.Cols,
+	})
+}
@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build linux || darwin
+
+package pty
+
+import (
+	"bytes"
+	"io"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestStart verifies that the command is started with a terminal of the requested size.
+func TestStart(t *testing.T) {
+	t.Parallel()
+
+	cmd := exec.Command("/bin/sh", "-c", "test -t 0 && test -t 1 && stty size")
+	ptmx, err := Start(cmd, &Size{Rows: 24, Cols: 80})
+	require.NoError(t, err)
+	defer ptmx.Close()
+
+	out := &bytes.Buffer{}
+	// Reading from the primary returns an error when the secondary is closed
+	_, _ = io.Copy(out, ptmx)
+	require.NoError(t, cmd.Wait())
+	require.Contains(t, out.String(), "24 80")
+}
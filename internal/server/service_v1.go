@@ -367,8 +367,9 @@ func (s *ServiceV1) dispatch(
 		if err != nil {
 			diags = append(diags, diagnostics.FromErr(err)...)
 		} else {
-			diags = append(diags, diagnostics.FromErr(fmt.Errorf(
-				"no scenarios found matching filter '%s'", filter.String(),
+			diags = append(diags, diagnostics.FromErr(diagnostics.ErrWithCode(
+				diagnostics.CodeNoMatchingScenarios,
+				fmt.Errorf("no scenarios found matching filter '%s'", filter.String()),
 			))...)
 		}
 	}
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	"github.com/hashicorp/enos/internal/generate"
+	"github.com/hashicorp/enos/internal/operation"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// CleanScenarios removes stale generated modules and provider plugin caches from the
+// workspace out directory.
+func (s *ServiceV1) CleanScenarios(
+	ctx context.Context,
+	req *pb.CleanScenariosRequest,
+) (
+	*pb.CleanScenariosResponse,
+	error,
+) {
+	res := &pb.CleanScenariosResponse{
+		DryRun: req.GetDryRun(),
+	}
+
+	filter, err := flightplan.NewScenarioFilter(flightplan.WithScenarioFilterDecode(req.GetFilter()))
+	if err != nil {
+		res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+
+		return res, nil
+	}
+
+	// Matching on modules or verified qualities requires the scenario steps.
+	target := flightplan.DecodeTarget(flightplan.DecodeTargetScenariosNamesExpandVariants)
+	if filter.RequiresSteps() {
+		target = flightplan.DecodeTargetScenariosComplete
+	}
+
+	// Always decode every scenario in the flight plan so that we can determine which
+	// generated modules are orphaned, regardless of the filter we've been given.
+	fp, scenarioDecoder, decRes := flightplan.DecodeProto(
+		ctx,
+		req.GetWorkspace().GetFlightplan(),
+		target,
+		&pb.Scenario_Filter{SelectAll: &pb.Scenario_Filter_SelectAll{}},
+	)
+	res.Decode = decRes
+
+	if scenarioDecoder != nil {
+		hclDiags := scenarioDecoder.DecodeAll(ctx, fp)
+		if len(hclDiags) > 0 {
+			decRes.Diagnostics = append(decRes.GetDiagnostics(), diagnostics.FromHCL(nil, hclDiags)...)
+		}
+	} else {
+		decRes.Diagnostics = append(decRes.GetDiagnostics(), diagnostics.FromErr(errors.New(
+			"unable to decode scenarios",
+		))...)
+	}
+
+	if diagnostics.HasFailed(
+		req.GetWorkspace().GetTfExecCfg().GetFailOnWarnings(),
+		decRes.GetDiagnostics(),
+	) {
+		return res, nil
+	}
+
+	scenarios := map[string]*flightplan.Scenario{}
+	for _, scenario := range fp.Scenarios() {
+		scenarios[scenario.UID()] = scenario
+	}
+
+	outDir, err := operation.OutDirForWorkspace(req.GetWorkspace())
+	if err != nil {
+		res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+
+		return res, nil
+	}
+
+	mods, err := generate.FindGeneratedModules(outDir)
+	if err != nil {
+		res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+
+		return res, nil
+	}
+
+	for _, mod := range mods {
+		scenario, ok := scenarios[mod.UID]
+		if ok && !scenario.Match(filter) {
+			continue
+		}
+
+		modRes := &pb.CleanScenariosResponse_Module{
+			Path:     mod.Dir,
+			Orphaned: !ok,
+		}
+		if ok {
+			modRes.Scenario = scenario.Ref()
+		} else {
+			// The scenario is no longer in the flight plan but the manifest will
+			// tell us which scenario the module was generated for.
+			manifest, err := mod.Manifest()
+			if err != nil {
+				modRes.Diagnostics = append(modRes.GetDiagnostics(), diagnostics.FromErr(err)...)
+			} else if manifest != nil {
+				modRes.Scenario = manifest.Scenario
+			}
+		}
+
+		res.Modules = append(res.GetModules(), cleanGeneratedModule(mod, modRes, req))
+	}
+
+	return res, nil
+}
+
+// cleanGeneratedModule determines how to clean the generated module and, unless it's a
+// dry run, removes either the whole module directory or its provider plugin cache.
+func cleanGeneratedModule(
+	mod *generate.GeneratedModule,
+	res *pb.CleanScenariosResponse_Module,
+	req *pb.CleanScenariosRequest,
+) *pb.CleanScenariosResponse_Module {
+	state, err := mod.State()
+	if err != nil {
+		res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+	}
+
+	switch state {
+	case generate.ModuleStateEmpty:
+		res.State = pb.CleanScenariosResponse_Module_STATE_EMPTY
+	case generate.ModuleStateNotEmpty:
+		res.State = pb.CleanScenariosResponse_Module_STATE_NOT_EMPTY
+	case generate.ModuleStateUnknown:
+		res.State = pb.CleanScenariosResponse_Module_STATE_UNKNOWN
+	default:
+		res.State = pb.CleanScenariosResponse_Module_STATE_UNSPECIFIED
+	}
+
+	// We can only safely remove the module if we know that there's nothing in the state,
+	// otherwise we'd lose track of infrastructure that has yet to be destroyed.
+	if (err == nil && state == generate.ModuleStateEmpty) || req.GetForce() {
+		res.Removed = true
+		res.ReclaimedBytes, err = generate.DirSize(mod.Dir)
+		if err != nil {
+			res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+		}
+
+		if !req.GetDryRun() {
+			if err = os.RemoveAll(mod.Dir); err != nil {
+				res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+			}
+		}
+
+		return res
+	}
+
+	// The module might have infrastructure so we'll keep it but clean up the provider
+	// plugins. They're by far the largest part of module and can always be reinstalled
+	// when the module is initialized.
+	cacheDir := mod.ProviderCacheDir()
+	if _, err := os.Stat(cacheDir); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+		}
+
+		return res
+	}
+
+	res.ProviderCacheRemoved = true
+	res.ReclaimedBytes, err = generate.DirSize(cacheDir)
+	if err != nil {
+		res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+	}
+
+	if !req.GetDryRun() {
+		if err = os.RemoveAll(cacheDir); err != nil {
+			res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
+		}
+	}
+
+	return res
+}
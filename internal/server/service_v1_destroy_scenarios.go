@@ -5,7 +5,13 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/generate"
+	"github.com/hashicorp/enos/internal/operation"
+	"github.com/hashicorp/enos/internal/proto"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 )
 
@@ -18,6 +24,13 @@ func (s *ServiceV1) DestroyScenarios(
 	error,
 ) {
 	res := &pb.DestroyScenariosResponse{}
+
+	if req.GetAllLaunched() {
+		res.Diagnostics, res.Operations = s.dispatchDestroyLaunched(req.GetWorkspace(), nil)
+
+		return res, nil
+	}
+
 	res.Diagnostics, res.Decode, res.Operations = s.dispatch(
 		ctx,
 		req.GetFilter(),
@@ -29,3 +42,92 @@ func (s *ServiceV1) DestroyScenarios(
 
 	return res, nil
 }
+
+// dispatchDestroyLaunched finds every previously generated module in the workspace out
+// directory that has or might have state and dispatches a destroy operation for each. As
+// the scenario that generated the module might no longer be in the flight plan we rely on
+// the module manifest to determine the scenario and destroy the existing module as-is. If
+// include is set only modules whose manifest it includes are destroyed.
+func (s *ServiceV1) dispatchDestroyLaunched(
+	ws *pb.Workspace,
+	include func(*generate.Manifest) bool,
+) (
+	[]*pb.Diagnostic,
+	[]*pb.Ref_Operation,
+) {
+	diags := []*pb.Diagnostic{}
+	refs := []*pb.Ref_Operation{}
+
+	outDir, err := operation.OutDirForWorkspace(ws)
+	if err != nil {
+		return diagnostics.FromErr(err), refs
+	}
+
+	mods, err := generate.FindGeneratedModules(outDir)
+	if err != nil {
+		return diagnostics.FromErr(err), refs
+	}
+
+	for _, mod := range mods {
+		state, err := mod.State()
+		if err != nil {
+			diags = append(diags, diagnostics.FromErr(err)...)
+			continue
+		}
+
+		if state == generate.ModuleStateEmpty {
+			continue
+		}
+
+		manifest, err := mod.Manifest()
+		if err != nil {
+			diags = append(diags, diagnostics.FromErr(err)...)
+			continue
+		}
+
+		if include != nil && !include(manifest) {
+			continue
+		}
+
+		scenarioRef := &pb.Ref_Scenario{Id: &pb.Scenario_ID{Uid: mod.UID}}
+		if manifest != nil {
+			scenarioRef = manifest.Scenario
+		} else {
+			diags = append(diags, &pb.Diagnostic{
+				Severity: pb.Diagnostic_SEVERITY_WARNING,
+				Summary:  "generated module does not have a manifest",
+				Detail: fmt.Sprintf(
+					"the module in %s does not have a manifest so the scenario it belongs to cannot be determined",
+					mod.Dir,
+				),
+			})
+		}
+
+		req := &pb.Operation_Request{}
+		err = proto.Copy(&pb.Operation_Request{
+			Workspace: ws,
+			Scenario:  scenarioRef,
+			Value: &pb.Operation_Request_Destroy_{
+				Destroy: &pb.Operation_Request_Destroy{
+					TerraformModule: &pb.Terraform_Module{
+						ModulePath:  filepath.Join(mod.Dir, "scenario.tf"),
+						RcPath:      filepath.Join(mod.Dir, "terraform.rc"),
+						ScenarioRef: scenarioRef,
+					},
+				},
+			},
+		}, req)
+		if err != nil {
+			diags = append(diags, diagnostics.FromErr(err)...)
+			continue
+		}
+
+		ref, moreDiags := s.operator.Dispatch(req)
+		diags = append(diags, moreDiags...)
+		if ref != nil {
+			refs = append(refs, ref)
+		}
+	}
+
+	return diags, refs
+}
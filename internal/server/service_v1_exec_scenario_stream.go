@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	"github.com/hashicorp/enos/internal/operation"
+	"github.com/hashicorp/enos/internal/pty"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ExecScenarioStream interactively executes a Terraform sub-command in the context of a
+// single scenario. The client streams STDIN and terminal size changes to the sub-command
+// and the server streams STDOUT and STDERR back. The final message is always the exit.
+func (s *ServiceV1) ExecScenarioStream(stream pb.EnosService_ExecScenarioStreamServer) error {
+	ctx := stream.Context()
+	sender := &execStreamSender{stream: stream}
+	exit := &pb.ExecScenarioStreamResponse_Exit{ExitCode: 1}
+	sendExit := func() error {
+		return sender.send(&pb.ExecScenarioStreamResponse{
+			Response: &pb.ExecScenarioStreamResponse_Exit_{Exit: exit},
+		})
+	}
+
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	start := msg.GetStart()
+	if start == nil {
+		exit.Diagnostics = diagnostics.FromErr(errors.New("the first exec stream message must be start"))
+
+		return sendExit()
+	}
+	ws := start.GetWorkspace()
+
+	fp, scenarioDecoder, decRes := flightplan.DecodeProto(
+		ctx,
+		ws.GetFlightplan(),
+		flightplan.DecodeTargetAll,
+		start.GetFilter(),
+	)
+	exit.Decode = decRes
+	if scenarioDecoder != nil {
+		hclDiags := scenarioDecoder.DecodeAll(ctx, fp)
+		if len(hclDiags) > 0 {
+			decRes.Diagnostics = append(decRes.GetDiagnostics(), diagnostics.FromHCL(nil, hclDiags)...)
+		}
+	}
+
+	if diagnostics.HasFailed(ws.GetTfExecCfg().GetFailOnWarnings(), decRes.GetDiagnostics()) {
+		return sendExit()
+	}
+
+	// Interactive sub-commands can only be run against a single scenario
+	scenarios := fp.Scenarios()
+	if len(scenarios) != 1 {
+		exit.Diagnostics = diagnostics.FromErr(fmt.Errorf(
+			"interactive exec requires a filter that matches exactly one scenario, found %d",
+			len(scenarios),
+		))
+
+		return sendExit()
+	}
+	exit.Scenario = scenarios[0].Ref()
+
+	stdinR, stdinW := io.Pipe()
+	resizeC := make(chan *pty.Size, 1)
+	eio := &operation.InteractiveExecIO{
+		Stdin:  stdinR,
+		Stdout: &execStreamWriter{sender: sender, stderr: false},
+		Stderr: &execStreamWriter{sender: sender, stderr: true},
+		TTY:    start.GetTty(),
+		Resize: resizeC,
+		Term:   start.GetTerm(),
+	}
+	if size := start.GetSize(); size != nil {
+		eio.Size = &pty.Size{Rows: uint16(size.GetRows()), Cols: uint16(size.GetCols())}
+	}
+
+	// Handle client messages until the client is done sending or the stream is
+	// closed when we return.
+	go func() {
+		defer close(resizeC)
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				_ = stdinW.Close()
+
+				return
+			}
+
+			switch t := msg.GetRequest().(type) {
+			case *pb.ExecScenarioStreamRequest_Stdin_:
+				if len(t.Stdin.GetData()) > 0 {
+					if _, err := stdinW.Write(t.Stdin.GetData()); err != nil {
+						return
+					}
+				}
+				if t.Stdin.GetEof() {
+					_ = stdinW.Close()
+				}
+			case *pb.ExecScenarioStreamRequest_Resize:
+				select {
+				case resizeC <- &pty.Size{Rows: uint16(t.Resize.GetRows()), Cols: uint16(t.Resize.GetCols())}:
+				default:
+					// Drop the resize if we're still handling the last one
+				}
+			default:
+				s.log.Debug("ignoring unexpected exec stream message", "message", msg)
+			}
+		}
+	}()
+
+	exit.ExitCode, exit.Diagnostics = operation.ExecScenarioInteractive(
+		ctx,
+		&pb.Operation_Request{
+			Workspace: ws,
+			Scenario:  exit.GetScenario(),
+			Value:     &pb.Operation_Request_Exec_{},
+		},
+		eio,
+		s.log,
+	)
+
+	// Make sure anything still writing our stdin is unblocked
+	_ = stdinR.Close()
+
+	return sendExit()
+}
+
+// execStreamSender serializes sending messages on an exec stream as gRPC streams are not
+// safe for concurrent senders.
+type execStreamSender struct {
+	mu     sync.Mutex
+	stream pb.EnosService_ExecScenarioStreamServer
+}
+
+func (e *execStreamSender) send(res *pb.ExecScenarioStreamResponse) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.stream.Send(res)
+}
+
+// execStreamWriter is an io.Writer that sends writes on the exec stream.
+type execStreamWriter struct {
+	sender *execStreamSender
+	stderr bool
+}
+
+func (e *execStreamWriter) Write(p []byte) (int, error) {
+	// The stream might hold on to our message so we need our own copy
+	data := make([]byte, len(p))
+	copy(data, p)
+
+	res := &pb.ExecScenarioStreamResponse{
+		Response: &pb.ExecScenarioStreamResponse_Stdout{Stdout: data},
+	}
+	if e.stderr {
+		res.Response = &pb.ExecScenarioStreamResponse_Stderr{Stderr: data}
+	}
+
+	if err := e.sender.send(res); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package server
+
+import (
+	"context"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ExplainDiagnosticCode returns the remediation information for a diagnostic code. If no
+// code is given all known codes are returned.
+func (s *ServiceV1) ExplainDiagnosticCode(
+	ctx context.Context,
+	req *pb.ExplainDiagnosticCodeRequest,
+) (
+	*pb.ExplainDiagnosticCodeResponse,
+	error,
+) {
+	res := &pb.ExplainDiagnosticCodeResponse{}
+
+	if req.GetCode() == "" {
+		for _, info := range diagnostics.Codes() {
+			res.Codes = append(res.GetCodes(), info.Proto())
+		}
+
+		return res, nil
+	}
+
+	code := diagnostics.Code(req.GetCode())
+	if err := diagnostics.ValidateCode(code); err != nil {
+		res.Diagnostics = diagnostics.FromErr(err)
+
+		return res, nil
+	}
+
+	info, _ := diagnostics.LookupCode(code)
+	res.Codes = []*pb.DiagnosticCode{info.Proto()}
+
+	return res, nil
+}
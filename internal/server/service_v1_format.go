@@ -15,6 +15,7 @@ import (
 	"github.com/hexops/gotextdiff/span"
 
 	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclwrite"
@@ -47,6 +48,15 @@ func (s *ServiceV1) Format(
 		}
 
 		formatted := hclwrite.Format(file.GetBody())
+		if req.GetConfig().GetCanonical() {
+			formatted, diags = flightplan.FormatCanonical(file.GetPath(), file.GetBody())
+			if diags.HasErrors() {
+				r.Diagnostics = diagnostics.FromHCL(nil, diags)
+				res.Responses = append(res.GetResponses(), r)
+
+				continue
+			}
+		}
 		if bytes.Equal(file.GetBody(), formatted) {
 			// If nothing has changed we can move on
 			res.Responses = append(res.GetResponses(), r)
@@ -25,7 +25,12 @@ func (s *ServiceV1) LaunchScenarios(
 		req.GetFilter(),
 		&pb.Operation_Request{
 			Workspace: req.GetWorkspace(),
-			Value:     &pb.Operation_Request_Launch_{},
+			Value: &pb.Operation_Request_Launch_{
+				Launch: &pb.Operation_Request_Launch{
+					FailurePolicy: req.GetFailurePolicy(),
+					Ttl:           req.GetTtl(),
+				},
+			},
 		},
 	)
 
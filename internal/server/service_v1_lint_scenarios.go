@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	"github.com/hashicorp/enos/internal/lint"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+// LintScenarios lints a flight plan config.
+func (s *ServiceV1) LintScenarios(
+	ctx context.Context,
+	req *pb.LintScenariosRequest,
+) (
+	*pb.LintScenariosResponse,
+	error,
+) {
+	res := &pb.LintScenariosResponse{}
+
+	cfg := lint.NewConfig()
+	if src := req.GetConfig().GetHcl(); len(src) > 0 {
+		var hclDiags hcl.Diagnostics
+		cfg, hclDiags = lint.DecodeConfig(req.GetConfig().GetPath(), src)
+		if len(hclDiags) > 0 {
+			res.Diagnostics = diagnostics.FromHCL(
+				map[string]*hcl.File{req.GetConfig().GetPath(): cfg.File}, hclDiags,
+			)
+		}
+
+		if hclDiags.HasErrors() {
+			return res, nil
+		}
+	}
+
+	fp, scenarioDecoder, decRes := flightplan.DecodeProto(
+		ctx,
+		req.GetWorkspace().GetFlightplan(),
+		flightplan.DecodeTargetScenariosMatrixOnly,
+		nil,
+	)
+	res.Decode = decRes
+
+	if diagnostics.HasFailed(
+		req.GetWorkspace().GetTfExecCfg().GetFailOnWarnings(),
+		decRes.GetDiagnostics(),
+	) {
+		return res, nil
+	}
+
+	if fp == nil || scenarioDecoder == nil {
+		decRes.Diagnostics = append(decRes.GetDiagnostics(), diagnostics.FromErr(errors.New(
+			"failed to decode scenarios",
+		))...)
+
+		return res, nil
+	}
+
+	if hclDiags := scenarioDecoder.DecodeAll(ctx, fp); len(hclDiags) > 0 {
+		decRes.Diagnostics = append(decRes.GetDiagnostics(), diagnostics.FromHCL(fp.Files, hclDiags)...)
+
+		if hclDiags.HasErrors() {
+			return res, nil
+		}
+	}
+
+	res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromHCL(
+		fp.Files, lint.New(lint.WithConfig(cfg)).Lint(fp),
+	)...)
+
+	return res, nil
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package server
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ListScenarioVariables returns the flight plan variables along with their resolved values and
+// where the values came from. Values that have been set for undeclared variables are included.
+func (s *ServiceV1) ListScenarioVariables(
+	ctx context.Context,
+	req *pb.ListScenarioVariablesRequest,
+) (
+	*pb.ListScenarioVariablesResponse,
+	error,
+) {
+	res := &pb.ListScenarioVariablesResponse{}
+
+	fp, _, decRes := flightplan.DecodeProto(
+		ctx,
+		req.GetWorkspace().GetFlightplan(),
+		flightplan.DecodeTargetVariables,
+		nil,
+	)
+	res.Decode = decRes
+
+	if fp == nil || diagnostics.HasFailed(
+		req.GetWorkspace().GetTfExecCfg().GetFailOnWarnings(),
+		decRes.GetDiagnostics(),
+	) {
+		return res, nil
+	}
+
+	for _, v := range fp.Variables {
+		res.Variables = append(res.GetVariables(), v.ToProto())
+	}
+
+	for name, v := range fp.UndeclaredVariableValues {
+		res.Variables = append(res.GetVariables(), v.ToProto(name))
+	}
+
+	slices.SortStableFunc(res.GetVariables(), func(a, b *pb.Variable) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	return res, nil
+}
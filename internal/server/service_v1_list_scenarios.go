@@ -16,10 +16,20 @@ import (
 func (s *ServiceV1) ListScenarios(req *pb.ListScenariosRequest, stream pb.EnosService_ListScenariosServer) error {
 	diags := hcl.Diagnostics{}
 
-	_, scenarioDecoder, decRes := flightplan.DecodeProto(
+	// Determining which scenarios are affected by changes requires fully decoded scenarios.
+	target := flightplan.DecodeTarget(flightplan.DecodeTargetScenariosNamesExpandVariants)
+	var changed *flightplan.ChangedFiles
+	if len(req.GetAffectedBy()) > 0 {
+		target = flightplan.DecodeTargetScenariosComplete
+		changed = flightplan.NewChangedFiles(
+			req.GetWorkspace().GetFlightplan().GetBaseDir(), req.GetAffectedBy()...,
+		)
+	}
+
+	fp, scenarioDecoder, decRes := flightplan.DecodeProto(
 		stream.Context(),
 		req.GetWorkspace().GetFlightplan(),
-		flightplan.DecodeTargetScenariosNamesExpandVariants,
+		target,
 		req.GetFilter(),
 	)
 
@@ -67,6 +77,10 @@ func (s *ServiceV1) ListScenarios(req *pb.ListScenariosRequest, stream pb.EnosSe
 			return sendListScenarioDecodeResponse(req, stream, decRes, moreDiags)
 		}
 
+		if changed != nil && !scenarioAffectedBy(req, fp, scenarioResponse, changed) {
+			continue
+		}
+
 		err := stream.Send(&pb.EnosServiceListScenariosResponse{
 			Response: &pb.EnosServiceListScenariosResponse_Scenario{
 				Scenario: scenarioResponse.Scenario.Ref(),
@@ -80,6 +94,22 @@ func (s *ServiceV1) ListScenarios(req *pb.ListScenariosRequest, stream pb.EnosSe
 	return sendListScenarioDecodeResponse(req, stream, decRes, iter.Diagnostics())
 }
 
+// scenarioAffectedBy returns whether or not the decoded scenario variant depends on any of the
+// changed files. Changes to variables files can affect every scenario.
+func scenarioAffectedBy(
+	req *pb.ListScenariosRequest,
+	fp *flightplan.FlightPlan,
+	res *flightplan.ScenarioDecodeResponse,
+	changed *flightplan.ChangedFiles,
+) bool {
+	deps := fp.ScenarioDependencies(res)
+	for path := range req.GetWorkspace().GetFlightplan().GetEnosVarsHcl() {
+		deps = append(deps, path)
+	}
+
+	return changed.Affects(deps...)
+}
+
 func sendListScenarioDecodeResponse(
 	req *pb.ListScenariosRequest,
 	stream pb.EnosService_ListScenariosServer,
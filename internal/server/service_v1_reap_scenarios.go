@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/generate"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ReapScenarios destroys every launched scenario in the workspace out directory that has outlived
+// its TTL.
+func (s *ServiceV1) ReapScenarios(
+	ctx context.Context,
+	req *pb.ReapScenariosRequest,
+) (
+	*pb.ReapScenariosResponse,
+	error,
+) {
+	res := &pb.ReapScenariosResponse{}
+	now := time.Now()
+	res.Diagnostics, res.Operations = s.dispatchDestroyLaunched(
+		req.GetWorkspace(),
+		func(manifest *generate.Manifest) bool {
+			return manifest.Expired(now)
+		},
+	)
+
+	return res, nil
+}
+
+// Reaper reaps expired scenarios in the workspace immediately and then every interval until the
+// context is done. It allows a long running server to reap scenarios without a client driving it.
+// Failed reaps are logged and returned when the context is done.
+func (s *ServiceV1) Reaper(ctx context.Context, ws *pb.Workspace, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var errs error
+	for {
+		res, err := s.ReapScenarios(ctx, &pb.ReapScenariosRequest{Workspace: ws})
+		if err == nil && diagnostics.HasFailed(ws.GetTfExecCfg().GetFailOnWarnings(), res.GetDiagnostics()) {
+			err = &diagnostics.Error{Diags: res.GetDiagnostics()}
+		}
+
+		if err != nil {
+			s.log.Error("failed to reap scenarios", "error", err)
+			errs = errors.Join(errs, err)
+		} else {
+			s.log.Info("dispatched destroy operations for expired scenarios", "operations", len(res.GetOperations()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return errs
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/enos/internal/generate"
+	"github.com/hashicorp/enos/internal/operation"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// destroyOperator is an operator that records dispatched destroy operations and simulates them
+// succeeding by clearing the expiry of the module, like the destroy worker does.
+type destroyOperator struct {
+	operation.Operator
+
+	dispatched []*pb.Operation_Request
+}
+
+func (o *destroyOperator) Dispatch(req *pb.Operation_Request) (*pb.Ref_Operation, []*pb.Diagnostic) {
+	o.dispatched = append(o.dispatched, req)
+
+	mod := &generate.GeneratedModule{
+		Dir: filepath.Dir(req.GetDestroy().GetTerraformModule().GetModulePath()),
+	}
+	if err := mod.SetExpiresAt(time.Time{}); err != nil {
+		panic(err)
+	}
+
+	return &pb.Ref_Operation{Id: req.GetId()}, nil
+}
+
+// testExpiredModule writes a generated module with an expired TTL that uses a remote backend to
+// the out directory.
+func testExpiredModule(t *testing.T, outDir string) *generate.GeneratedModule {
+	t.Helper()
+
+	mod := &generate.GeneratedModule{Dir: filepath.Join(outDir, "abc"), UID: "abc"}
+	require.NoError(t, os.MkdirAll(filepath.Join(mod.Dir, ".terraform"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(mod.Dir, "scenario.tf"), nil, 0o644))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(mod.Dir, ".terraform", "terraform.tfstate"),
+		[]byte(`{"version":3,"backend":{"type":"s3"}}`),
+		0o644,
+	))
+	manifest, err := json.Marshal(&generate.Manifest{
+		Scenario:    &pb.Ref_Scenario{Id: &pb.Scenario_ID{Name: "test", Uid: "abc"}},
+		BaseDir:     outDir,
+		GeneratedAt: time.Now().UTC(),
+		ExpiresAt:   time.Now().Add(-time.Minute).UTC(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(mod.ManifestPath(), manifest, 0o644))
+
+	state, err := mod.State()
+	require.NoError(t, err)
+	require.Equal(t, generate.ModuleStateUnknown, state)
+
+	return mod
+}
+
+// Test_ReapScenarios_Twice verifies that a successfully destroyed scenario is not reaped again,
+// even when the state of the module can't be determined because it uses a remote backend.
+func Test_ReapScenarios_Twice(t *testing.T) {
+	t.Parallel()
+
+	outDir := t.TempDir()
+	mod := testExpiredModule(t, outDir)
+
+	op := &destroyOperator{}
+	svc, err := New(WithOperator(op))
+	require.NoError(t, err)
+
+	req := &pb.ReapScenariosRequest{Workspace: &pb.Workspace{OutDir: outDir}}
+	for range 2 {
+		res, err := svc.ReapScenarios(context.Background(), req)
+		require.NoError(t, err)
+		require.Empty(t, res.GetDiagnostics())
+	}
+
+	require.Len(t, op.dispatched, 1)
+	require.Equal(t,
+		filepath.Join(mod.Dir, "scenario.tf"),
+		op.dispatched[0].GetDestroy().GetTerraformModule().GetModulePath(),
+	)
+}
+
+// Test_Reaper verifies that the reaper reaps until the context is done.
+func Test_Reaper(t *testing.T) {
+	t.Parallel()
+
+	outDir := t.TempDir()
+	testExpiredModule(t, outDir)
+
+	op := &destroyOperator{}
+	svc, err := New(WithOperator(op))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, svc.Reaper(ctx, &pb.Workspace{OutDir: outDir}, time.Hour))
+	require.Len(t, op.dispatched, 1)
+}
@@ -25,7 +25,11 @@ func (s *ServiceV1) RunScenarios(
 		req.GetFilter(),
 		&pb.Operation_Request{
 			Workspace: req.GetWorkspace(),
-			Value:     &pb.Operation_Request_Run_{},
+			Value: &pb.Operation_Request_Run_{
+				Run: &pb.Operation_Request_Run{
+					FailurePolicy: req.GetFailurePolicy(),
+				},
+			},
 		},
 	)
 
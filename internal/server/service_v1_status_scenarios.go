@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package server
+
+import (
+	"context"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// StatusScenarios returns the state status of scenarios.
+func (s *ServiceV1) StatusScenarios(
+	ctx context.Context,
+	req *pb.StatusScenariosRequest,
+) (
+	*pb.StatusScenariosResponse,
+	error,
+) {
+	res := &pb.StatusScenariosResponse{}
+	res.Diagnostics, res.Decode, res.Operations = s.dispatch(
+		ctx,
+		req.GetFilter(),
+		&pb.Operation_Request{
+			Workspace: req.GetWorkspace(),
+			Value:     &pb.Operation_Request_State_{},
+		},
+	)
+
+	return res, nil
+}
@@ -102,8 +102,9 @@ func (s *ServiceV1) ValidateScenariosConfiguration(
 			if err != nil {
 				res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(err)...)
 			} else {
-				res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(fmt.Errorf(
-					"no scenarios found matching filter '%s'", filter.String(),
+				res.Diagnostics = append(res.GetDiagnostics(), diagnostics.FromErr(diagnostics.ErrWithCode(
+					diagnostics.CodeNoMatchingScenarios,
+					fmt.Errorf("no scenarios found matching filter '%s'", filter.String()),
 				))...)
 			}
 
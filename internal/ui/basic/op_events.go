@@ -161,6 +161,43 @@ func (v *View) writeEventApply(e *pb.Operation_Event, w *strings.Builder) {
 	v.writeDiags(a.GetDiagnostics(), w)
 }
 
+func (v *View) writeEventProgress(e *pb.Operation_Event, w *strings.Builder) {
+	if v.settings.GetLevel() < pb.UI_Settings_LEVEL_INFO {
+		return
+	}
+
+	p := e.GetProgress()
+	if p == nil {
+		return
+	}
+
+	scenario := flightplan.NewScenario()
+	scenario.FromRef(e.GetOp().GetScenario())
+
+	fmt.Fprintf(w, "%s %s", scenario.String(), format.Progress(p))
+}
+
+func (v *View) writeEventHook(e *pb.Operation_Event, w *strings.Builder) {
+	h := e.GetHook()
+	if h == nil {
+		return
+	}
+
+	v.writeEventHeader(h.GetType()+" hook", e, pb.UI_Settings_LEVEL_INFO, w)
+
+	if stdout := h.GetStdout(); stdout != "" &&
+		v.settings.GetLevel() == pb.UI_Settings_LEVEL_DEBUG {
+		fmt.Fprintf(w, "\n  Stdout: %s\n", stdout)
+	}
+
+	if stderr := h.GetStderr(); stderr != "" &&
+		v.settings.GetLevel() == pb.UI_Settings_LEVEL_DEBUG {
+		fmt.Fprintf(w, "\n  Stderr: %s\n", stderr)
+	}
+
+	v.writeDiags(h.GetDiagnostics(), w)
+}
+
 func (v *View) writeEventDestroy(e *pb.Operation_Event, w *strings.Builder) {
 	v.writeEventHeader("destroy", e, pb.UI_Settings_LEVEL_INFO, w)
 
@@ -4,6 +4,10 @@
 package basic
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/enos/internal/flightplan"
 	"github.com/hashicorp/enos/internal/ui/status"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 )
@@ -82,3 +86,64 @@ func (v *View) writeGenerateResponse(out *pb.Operation_Response_Generate) {
 	v.ui.Debug("  Module rc path: " + out.GetTerraformModule().GetRcPath())
 	v.WriteDiagnostics(out.GetDiagnostics())
 }
+
+// writeHookResponses takes scenario hook responses and writes human readable output.
+func (v *View) writeHookResponses(hooks []*pb.Operation_Response_Hook) {
+	for _, hook := range hooks {
+		name := "  Hook " + hook.GetType()
+		if status.HasFailed(v.settings.GetFailOnWarnings(), hook) {
+			msg := name + ": failed!"
+			if v.settings.GetIsTty() {
+				msg = name + ": ❌"
+			}
+			v.ui.Error(msg)
+			if stderr := hook.GetStderr(); stderr != "" {
+				v.ui.Error(stderr)
+			}
+			v.WriteDiagnostics(hook.GetDiagnostics())
+
+			continue
+		}
+
+		msg := name + ": success!"
+		if v.settings.GetIsTty() {
+			msg = name + ": ✅"
+		}
+		v.ui.Info(msg)
+		if stdout := hook.GetStdout(); stdout != "" {
+			v.ui.Debug(stdout)
+		}
+		v.WriteDiagnostics(hook.GetDiagnostics())
+	}
+}
+
+// writeFailurePolicyResponse takes a failure policy response and writes human readable output.
+func (v *View) writeFailurePolicyResponse(res *pb.Operation_Response_FailurePolicy) {
+	if res == nil {
+		return
+	}
+
+	policy := flightplan.FailurePolicyString(res.GetPolicy())
+	switch res.GetOutcome() {
+	case pb.Operation_Response_FailurePolicy_OUTCOME_DESTROYED:
+		v.ui.Info(fmt.Sprintf("  Failure policy %s: destroyed scenario", policy))
+	case pb.Operation_Response_FailurePolicy_OUTCOME_DESTROY_FAILED:
+		v.ui.Error(fmt.Sprintf("  Failure policy %s: failed to destroy scenario!", policy))
+		if show := res.GetPriorStateShow(); show != nil {
+			v.writeShowResponse(show)
+		}
+		if destroy := res.GetDestroy(); destroy != nil {
+			v.writePlainTextResponse("destroy", destroy.GetStderr(), destroy)
+		}
+	case pb.Operation_Response_FailurePolicy_OUTCOME_KEPT:
+		msg := fmt.Sprintf("  Failure policy %s: kept scenario", policy)
+		if expires := res.GetExpiresAt(); expires != nil {
+			msg += " until " + expires.AsTime().Local().Format(time.RFC3339)
+		}
+		v.ui.Info(msg)
+	case pb.Operation_Response_FailurePolicy_OUTCOME_UNSPECIFIED:
+	default:
+	}
+
+	v.WriteDiagnostics(res.GetDiagnostics())
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package basic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/go-wordwrap"
+
+	"github.com/hashicorp/enos/internal/ui/status"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ShowDiagnosticCodeExplanation shows the explanation of a diagnostic code. If more than
+// one code is in the response they are shown as a table.
+func (v *View) ShowDiagnosticCodeExplanation(res *pb.ExplainDiagnosticCodeResponse) error {
+	switch len(res.GetCodes()) {
+	case 0:
+	case 1:
+		v.ui.Output(DiagnosticCodeExplanationString(res.GetCodes()[0], int(v.settings.GetWidth())))
+	default:
+		header := []string{"code", "summary"}
+		rows := [][]string{{"", ""}} // add a padding row
+		for _, code := range res.GetCodes() {
+			rows = append(rows, []string{code.GetCode(), code.GetSummary()})
+		}
+		v.ui.RenderTable(header, rows)
+	}
+
+	v.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.ExplainDiagnosticCode(v.settings.GetFailOnWarnings(), res)
+}
+
+// DiagnosticCodeExplanationString returns the diagnostic code explanation as a string. If
+// width is greater than zero the remediation will be wrapped.
+func DiagnosticCodeExplanationString(code *pb.DiagnosticCode, width int) string {
+	if code == nil {
+		return ""
+	}
+
+	out := strings.Builder{}
+	out.WriteString(fmt.Sprintf("%s: %s\n", code.GetCode(), code.GetSummary()))
+
+	if rem := code.GetRemediation(); rem != "" {
+		if width > 0 {
+			rem = wordwrap.WrapString(rem, uint(width))
+		}
+		out.WriteString("\n" + rem + "\n")
+	}
+
+	if url := code.GetDocUrl(); url != "" {
+		out.WriteString("\nDocumentation: " + url + "\n")
+	}
+
+	return strings.TrimSuffix(out.String(), "\n")
+}
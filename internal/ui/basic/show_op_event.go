@@ -44,6 +44,10 @@ func (v *View) ShowOperationEvent(event *pb.Operation_Event) {
 		v.writeEventExec(event, msg)
 	case *pb.Operation_Event_Show:
 		v.writeEventShow(event, msg)
+	case *pb.Operation_Event_Progress:
+		v.writeEventProgress(event, msg)
+	case *pb.Operation_Event_Hook:
+		v.writeEventHook(event, msg)
 	case *pb.Operation_Event_Output:
 		// Don't display output events by default since outputs have their own
 		// view.
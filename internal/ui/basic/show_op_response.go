@@ -120,14 +120,14 @@ func (v *View) showOperationResponse(res *pb.Operation_Response, fullOnComplete
 	case *pb.Operation_Response_Generate_:
 		v.writeGenerateResponse(res.GetGenerate())
 	case *pb.Operation_Response_Check_:
-		v.writeGenerateResponse(res.GetGenerate())
+		v.writeGenerateResponse(res.GetCheck().GetGenerate())
 		v.writeInitResponse(res.GetCheck().GetInit())
 		v.writeValidateResponse(res.GetCheck().GetValidate())
 		if plan := res.GetCheck().GetPlan(); plan != nil {
 			v.writePlainTextResponse("plan", plan.GetStderr(), plan)
 		}
 	case *pb.Operation_Response_Launch_:
-		v.writeGenerateResponse(res.GetGenerate())
+		v.writeGenerateResponse(res.GetLaunch().GetGenerate())
 		v.writeInitResponse(res.GetLaunch().GetInit())
 		v.writeValidateResponse(res.GetLaunch().GetValidate())
 		if plan := res.GetLaunch().GetPlan(); plan != nil {
@@ -136,8 +136,13 @@ func (v *View) showOperationResponse(res *pb.Operation_Response, fullOnComplete
 		if apply := res.GetLaunch().GetApply(); apply != nil {
 			v.writePlainTextResponse("apply", apply.GetStderr(), apply)
 		}
+		v.writeHookResponses(res.GetLaunch().GetHooks())
+		v.writeFailurePolicyResponse(res.GetLaunch().GetFailurePolicy())
+		if expires := res.GetLaunch().GetExpiresAt(); expires != nil {
+			v.ui.Info("  Expires: " + expires.AsTime().Local().Format(time.RFC3339))
+		}
 	case *pb.Operation_Response_Destroy_:
-		v.writeGenerateResponse(res.GetGenerate())
+		v.writeGenerateResponse(res.GetDestroy().GetGenerate())
 		v.writeInitResponse(res.GetDestroy().GetInit())
 		if show := res.GetDestroy().GetPriorStateShow(); show != nil {
 			v.writeShowResponse(show)
@@ -145,8 +150,9 @@ func (v *View) showOperationResponse(res *pb.Operation_Response, fullOnComplete
 		if destroy := res.GetDestroy().GetDestroy(); destroy != nil {
 			v.writePlainTextResponse("destroy", destroy.GetStderr(), destroy)
 		}
+		v.writeHookResponses(res.GetDestroy().GetHooks())
 	case *pb.Operation_Response_Run_:
-		v.writeGenerateResponse(res.GetGenerate())
+		v.writeGenerateResponse(res.GetRun().GetGenerate())
 		v.writeInitResponse(res.GetRun().GetInit())
 		v.writeValidateResponse(res.GetRun().GetValidate())
 		if plan := res.GetRun().GetPlan(); plan != nil {
@@ -161,10 +167,16 @@ func (v *View) showOperationResponse(res *pb.Operation_Response, fullOnComplete
 		if destroy := res.GetRun().GetDestroy(); destroy != nil {
 			v.writePlainTextResponse("destroy", destroy.GetStderr(), destroy)
 		}
+		v.writeHookResponses(res.GetRun().GetHooks())
+		v.writeFailurePolicyResponse(res.GetRun().GetFailurePolicy())
 	case *pb.Operation_Response_Exec_:
 		v.writeExecResponse(res.GetExec().GetExec())
 	case *pb.Operation_Response_Output_:
 		v.writeOutputResponse(res)
+	case *pb.Operation_Response_State_:
+		v.writeInitResponse(res.GetState().GetInit())
+		v.writeShowResponse(res.GetState().GetShow())
+		v.WriteDiagnostics(res.GetState().GetDiagnostics())
 	default:
 		return fmt.Errorf("unable to display operation response value '%t:%[1]v'", t)
 	}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package basic
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/enos/internal/flightplan"
+	"github.com/hashicorp/enos/internal/ui/status"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ShowScenarioClean shows the result of cleaning generated scenario modules.
+func (v *View) ShowScenarioClean(res *pb.CleanScenariosResponse) error {
+	header := []string{"module", "scenario", "state", "action", "reclaimed"}
+	rows := [][]string{{""}} // add a padding row
+	var reclaimed uint64
+
+	for _, mod := range res.GetModules() {
+		name := CleanModuleScenarioString(mod)
+
+		rows = append(rows, []string{
+			filepath.Base(mod.GetPath()),
+			name,
+			CleanModuleStateString(mod.GetState()),
+			CleanModuleActionString(res.GetDryRun(), mod),
+			FormatBytes(mod.GetReclaimedBytes()),
+		})
+		reclaimed += mod.GetReclaimedBytes()
+	}
+
+	if len(rows) > 1 {
+		v.ui.RenderTable(header, rows)
+
+		msg := "\nReclaimed " + FormatBytes(reclaimed)
+		if res.GetDryRun() {
+			msg = "\nWould reclaim " + FormatBytes(reclaimed)
+		}
+		v.ui.Info(msg)
+	} else {
+		v.ui.Info("No generated modules to clean")
+	}
+
+	for _, mod := range res.GetModules() {
+		v.WriteDiagnostics(mod.GetDiagnostics())
+	}
+	v.WriteDiagnostics(res.GetDecode().GetDiagnostics())
+	v.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.CleanScenarios(v.settings.GetFailOnWarnings(), res)
+}
+
+// CleanModuleScenarioString returns the name of the scenario that the cleaned module was
+// generated for.
+func CleanModuleScenarioString(mod *pb.CleanScenariosResponse_Module) string {
+	if mod.GetScenario() == nil {
+		return "(orphaned)"
+	}
+
+	scenario := flightplan.NewScenario()
+	scenario.FromRef(mod.GetScenario())
+	if mod.GetOrphaned() {
+		return scenario.String() + " (orphaned)"
+	}
+
+	return scenario.String()
+}
+
+// CleanModuleStateString returns a human friendly description of a cleaned module's state.
+func CleanModuleStateString(state pb.CleanScenariosResponse_Module_State) string {
+	switch state {
+	case pb.CleanScenariosResponse_Module_STATE_EMPTY:
+		return "empty"
+	case pb.CleanScenariosResponse_Module_STATE_NOT_EMPTY:
+		return "not empty"
+	case pb.CleanScenariosResponse_Module_STATE_UNKNOWN:
+		return "unknown (remote backend)"
+	case pb.CleanScenariosResponse_Module_STATE_UNSPECIFIED:
+		return "unknown"
+	default:
+		return "unknown"
+	}
+}
+
+// CleanModuleActionString returns a human friendly description of what clean did, or would
+// do in a dry run, to a module.
+func CleanModuleActionString(dryRun bool, mod *pb.CleanScenariosResponse_Module) string {
+	switch {
+	case mod.GetRemoved() && dryRun:
+		return "would remove module"
+	case mod.GetRemoved():
+		return "removed module"
+	case mod.GetProviderCacheRemoved() && dryRun:
+		return "would remove provider cache"
+	case mod.GetProviderCacheRemoved():
+		return "removed provider cache"
+	default:
+		return "kept, use --force to remove"
+	}
+}
+
+// FormatBytes returns the size in bytes as a human friendly string.
+func FormatBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
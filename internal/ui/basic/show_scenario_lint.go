@@ -0,0 +1,17 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package basic
+
+import (
+	"github.com/hashicorp/enos/internal/ui/status"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ShowScenarioLint shows the flight plan lint response.
+func (v *View) ShowScenarioLint(res *pb.LintScenariosResponse) error {
+	v.WriteDiagnostics(res.GetDecode().GetDiagnostics())
+	v.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.ScenariosLint(v.settings.GetFailOnWarnings(), res)
+}
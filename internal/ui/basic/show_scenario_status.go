@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package basic
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	"github.com/hashicorp/enos/internal/ui/status"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ShowScenarioStatus shows the state status of scenarios.
+func (v *View) ShowScenarioStatus(res *pb.OperationResponses) error {
+	header := []string{"scenario", "state", "resources", "steps", "last modified"}
+	rows := [][]string{{""}} // add a padding row
+
+	for _, r := range res.GetResponses() {
+		scenario := flightplan.NewScenario()
+		scenario.FromRef(r.GetOp().GetScenario())
+		state := r.GetState()
+
+		rows = append(rows, []string{
+			scenario.String(),
+			ScenarioStateString(r),
+			strconv.FormatInt(state.GetResourceCount(), 10),
+			ScenarioStateStepsString(state),
+			ScenarioStateLastModifiedString(state),
+		})
+	}
+
+	if len(rows) > 1 {
+		v.ui.RenderTable(header, rows)
+	}
+
+	for _, r := range res.GetResponses() {
+		v.WriteDiagnostics(diagnostics.Concat(
+			r.GetDiagnostics(),
+			r.GetState().GetDiagnostics(),
+			r.GetState().GetInit().GetDiagnostics(),
+			r.GetState().GetShow().GetDiagnostics(),
+		))
+	}
+	v.WriteDiagnostics(res.GetDecode().GetDiagnostics())
+	v.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.OperationResponses(v.settings.GetFailOnWarnings(), res)
+}
+
+// ScenarioStateString returns a human friendly description of whether or not the
+// scenario has state.
+func ScenarioStateString(res *pb.Operation_Response) string {
+	switch {
+	case diagnostics.OpResErrors(res):
+		return "unknown"
+	case res.GetState().GetExists():
+		return "launched"
+	default:
+		return "not launched"
+	}
+}
+
+// ScenarioStateStepsString returns the resource count of each step module that has
+// resources.
+func ScenarioStateStepsString(state *pb.Operation_Response_State) string {
+	steps := []string{}
+	for _, step := range state.GetSteps() {
+		if step.GetResourceCount() < 1 {
+			continue
+		}
+
+		steps = append(steps, fmt.Sprintf("%s:%d", step.GetName(), step.GetResourceCount()))
+	}
+
+	return strings.Join(steps, " ")
+}
+
+// ScenarioStateLastModifiedString returns the last modified time of the state or
+// a dash if it's not known.
+func ScenarioStateLastModifiedString(state *pb.Operation_Response_State) string {
+	if state.GetLastModified() == nil {
+		return "-"
+	}
+
+	return state.GetLastModified().AsTime().Local().Format(time.RFC3339)
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package basic
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/enos/internal/flightplan"
+	"github.com/hashicorp/enos/internal/ui/status"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ShowScenarioVariables shows the variables, their values, and where the values came from.
+func (v *View) ShowScenarioVariables(res *pb.ListScenarioVariablesResponse) error {
+	header := []string{"name", "type", "value", "source"}
+	rows := [][]string{{""}} // add a padding row
+	for _, variable := range res.GetVariables() {
+		value := variable.GetValue()
+		if variable.GetSensitive() && variable.GetSource() != pb.Variable_SOURCE_UNSET {
+			value = "(sensitive)"
+		}
+
+		rows = append(rows, []string{
+			variable.GetName(),
+			variable.GetType(),
+			value,
+			variableSourceString(variable),
+		})
+	}
+
+	if len(rows) > 1 {
+		v.ui.RenderTable(header, rows)
+	}
+	v.WriteDiagnostics(res.GetDecode().GetDiagnostics())
+	v.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.ListScenarioVariables(v.settings.GetFailOnWarnings(), res)
+}
+
+func variableSourceString(variable *pb.Variable) string {
+	location := ""
+	if rng := variable.GetRange(); rng != nil {
+		location = fmt.Sprintf(" %s:%d", rng.GetFilename(), rng.GetStart().GetLine())
+	}
+
+	switch variable.GetSource() {
+	case pb.Variable_SOURCE_DEFAULT:
+		return "default" + location
+	case pb.Variable_SOURCE_VARS_FILE:
+		return "vars file" + location
+	case pb.Variable_SOURCE_ENV_VAR:
+		return "env " + flightplan.EnvVarPrefix + variable.GetName()
+	case pb.Variable_SOURCE_UNSET:
+		return "WARNING: unset and no default"
+	case pb.Variable_SOURCE_UNDECLARED:
+		if variable.GetRange().GetFilename() == "environment_variables" {
+			location = " " + flightplan.EnvVarPrefix + variable.GetName()
+		}
+
+		return "WARNING: undeclared variable" + location
+	case pb.Variable_SOURCE_UNSPECIFIED:
+		return "unknown" + location
+	default:
+		return "unknown" + location
+	}
+}
@@ -8,8 +8,12 @@ import (
 	"embed"
 	"fmt"
 	"html/template"
+	"path/filepath"
 	"strings"
+	"sync"
 
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
 	"github.com/hashicorp/enos/internal/ui/basic"
 	"github.com/hashicorp/enos/internal/ui/status"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
@@ -19,12 +23,15 @@ import (
 var templates embed.FS
 
 // View is our html view. At the current time it only implements a limited set of the interface to
-// allow for writing scenarios outlines as HTML, and the CLI should only ever be configured with
-// html from that command. If the html view is given to other commands it will return an error
-// through the basic CLI view.
+// allow for writing scenario outlines, status, and operation reports as HTML. If the html view is
+// given to other commands it will return an error through the basic CLI view.
 type View struct {
 	basic    *basic.View
 	settings *pb.UI_Settings
+
+	mu          sync.Mutex
+	timings     map[string]*opTiming
+	observation *pb.Sample_Observation
 }
 
 // Opt is a functional option.
@@ -81,6 +88,11 @@ func (v *View) ShowDiagnostics(diags []*pb.Diagnostic) error {
 	return v.basic.ShowDiagnostics(diags)
 }
 
+// ShowDiagnosticCodeExplanation shows the explanation of a diagnostic code.
+func (v *View) ShowDiagnosticCodeExplanation(res *pb.ExplainDiagnosticCodeResponse) error {
+	return v.ShowError(status.Unimplemented("html/ui: ShowDiagnosticCodeExplanation"))
+}
+
 // ShowVersion shows the version information.
 func (v *View) ShowVersion(all bool, res *pb.GetVersionResponse) error {
 	return v.ShowError(status.Unimplemented("html/ui: ShowVersion"))
@@ -91,6 +103,16 @@ func (v *View) ShowScenariosValidateConfig(res *pb.ValidateScenariosConfiguratio
 	return v.ShowError(status.Unimplemented("html/ui: ShowScenariosValidateConfig"))
 }
 
+// ShowScenarioLint shows the lint response.
+func (v *View) ShowScenarioLint(res *pb.LintScenariosResponse) error {
+	return v.ShowError(status.Unimplemented("html/ui: ShowScenarioLint"))
+}
+
+// ShowScenarioVariables shows the variables list.
+func (v *View) ShowScenarioVariables(res *pb.ListScenarioVariablesResponse) error {
+	return v.ShowError(status.Unimplemented("html/ui: ShowScenarioVariables"))
+}
+
 // ShowScenarioList shows the a list of scenarios.
 func (v *View) ShowScenarioList(res *pb.ListScenariosResponse) error {
 	return v.ShowError(status.Unimplemented("html/ui: ShowScenarioList"))
@@ -182,14 +204,120 @@ func (v *View) ShowScenarioOutline(res *pb.OutlineScenariosResponse) error {
 	return status.OutlineScenarios(v.settings.GetFailOnWarnings(), res)
 }
 
+// ShowScenarioClean shows the result of cleaning generated scenario modules.
+func (v *View) ShowScenarioClean(res *pb.CleanScenariosResponse) error {
+	if res == nil {
+		return nil
+	}
+
+	t, err := template.New("clean.html.tmpl").Funcs(template.FuncMap{
+		"moduleName": func(m *pb.CleanScenariosResponse_Module) string {
+			return filepath.Base(m.GetPath())
+		},
+		"scenarioName": basic.CleanModuleScenarioString,
+		"moduleState": func(m *pb.CleanScenariosResponse_Module) string {
+			return basic.CleanModuleStateString(m.GetState())
+		},
+		"moduleAction": func(m *pb.CleanScenariosResponse_Module) string {
+			return basic.CleanModuleActionString(res.GetDryRun(), m)
+		},
+		"moduleReclaimed": func(m *pb.CleanScenariosResponse_Module) string {
+			return basic.FormatBytes(m.GetReclaimedBytes())
+		},
+	}).ParseFS(templates, "template/clean.html.tmpl")
+	if err != nil {
+		return v.ShowError(err)
+	}
+
+	buf := bytes.Buffer{}
+	err = t.Execute(&buf, res)
+	if err != nil {
+		return v.ShowError(err)
+	}
+
+	v.basic.UI().Output(buf.String())
+
+	for _, mod := range res.GetModules() {
+		v.basic.WriteDiagnostics(mod.GetDiagnostics())
+	}
+	v.basic.WriteDiagnostics(res.GetDecode().GetDiagnostics())
+	v.basic.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.CleanScenarios(v.settings.GetFailOnWarnings(), res)
+}
+
+// ShowScenarioStatus shows the state status of scenarios.
+func (v *View) ShowScenarioStatus(res *pb.OperationResponses) error {
+	if res == nil {
+		return nil
+	}
+
+	t, err := template.New("status.html.tmpl").Funcs(template.FuncMap{
+		"scenarioName": func(r *pb.Operation_Response) string {
+			scenario := flightplan.NewScenario()
+			scenario.FromRef(r.GetOp().GetScenario())
+
+			return scenario.String()
+		},
+		"scenarioState": basic.ScenarioStateString,
+		"lastModified": func(r *pb.Operation_Response) string {
+			return basic.ScenarioStateLastModifiedString(r.GetState())
+		},
+	}).ParseFS(templates, "template/status.html.tmpl")
+	if err != nil {
+		return v.ShowError(err)
+	}
+
+	buf := bytes.Buffer{}
+	err = t.Execute(&buf, res)
+	if err != nil {
+		return v.ShowError(err)
+	}
+
+	v.basic.UI().Output(buf.String())
+
+	for _, r := range res.GetResponses() {
+		v.basic.WriteDiagnostics(diagnostics.Concat(
+			r.GetDiagnostics(),
+			r.GetState().GetDiagnostics(),
+			r.GetState().GetInit().GetDiagnostics(),
+			r.GetState().GetShow().GetDiagnostics(),
+		))
+	}
+	v.basic.WriteDiagnostics(res.GetDecode().GetDiagnostics())
+	v.basic.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.OperationResponses(v.settings.GetFailOnWarnings(), res)
+}
+
 // ShowSampleList shows the a list of samples.
 func (v *View) ShowSampleList(res *pb.ListSamplesResponse) error {
 	return v.ShowError(status.Unimplemented("html/ui: ShowSampleList"))
 }
 
-// ShowSample shows the sample observation.
+// ShowSampleObservation shows the sample observation. The observation is retained and included in
+// any operation report that is rendered afterwards.
 func (v *View) ShowSampleObservation(res *pb.ObserveSampleResponse) error {
-	return v.ShowError(status.Unimplemented("html/ui: ShowSampleObservation"))
+	if res == nil {
+		return nil
+	}
+
+	v.mu.Lock()
+	v.observation = res.GetObservation()
+	v.mu.Unlock()
+
+	err := v.writeReport(&pb.OperationResponses{
+		Diagnostics: res.GetDiagnostics(),
+		Decode:      res.GetDecode(),
+	})
+	if err != nil {
+		return v.ShowError(err)
+	}
+
+	v.basic.WriteDiagnostics(res.GetDecode().GetDiagnostics())
+	v.basic.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.ShowSampleObservation(v.settings.GetFailOnWarnings(), res)
 }
 
 // ShowDecode shows the decode response unless it's a incremental update.
@@ -197,21 +325,58 @@ func (v *View) ShowDecode(res *pb.DecodeResponse, incremental bool) error {
 	return v.basic.ShowDecode(res, incremental)
 }
 
-// ShowOutput shows output response.
+// ShowOutput shows output response as an operation report.
 func (v *View) ShowOutput(out *pb.OperationResponses) error {
-	return v.ShowError(status.Unimplemented("html/ui: ShowOutput"))
+	return v.ShowOperationResponses(out)
 }
 
-// ShowOperationEvent does nothing as the html output doesn't stream events.
-func (v *View) ShowOperationEvent(*pb.Operation_Event) {
+// ShowOperationEvent doesn't stream events but records when each operation was first and last seen
+// so that the report can include timing.
+func (v *View) ShowOperationEvent(event *pb.Operation_Event) {
+	v.recordEvent(event)
 }
 
-// ShowOperationResponse shows an operation response.
+// ShowOperationResponse shows an operation response as an operation report.
 func (v *View) ShowOperationResponse(res *pb.Operation_Response) error {
-	return v.ShowError(status.Unimplemented("html/ui: ShowOperationResponse"))
+	if res == nil {
+		return nil
+	}
+
+	return v.ShowOperationResponses(&pb.OperationResponses{
+		Responses: []*pb.Operation_Response{res},
+	})
 }
 
-// ShowOperationResponses shows the results of multiple operations.
+// ShowOperationResponses shows the results of multiple operations as a self-contained report.
 func (v *View) ShowOperationResponses(res *pb.OperationResponses) error {
-	return v.ShowError(status.Unimplemented("html/ui: ShowOperationResponses"))
+	if res == nil {
+		return nil
+	}
+
+	if err := v.writeReport(res); err != nil {
+		return v.ShowError(err)
+	}
+
+	v.basic.WriteDiagnostics(res.GetDecode().GetDiagnostics())
+	v.basic.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.OperationResponses(v.settings.GetFailOnWarnings(), res)
+}
+
+// writeReport renders the operation report and writes it to stdout.
+func (v *View) writeReport(res *pb.OperationResponses) error {
+	t, err := template.New("operations.html.tmpl").ParseFS(templates, "template/operations.html.tmpl")
+	if err != nil {
+		return err
+	}
+
+	buf := bytes.Buffer{}
+	err = t.Execute(&buf, v.newReport(res))
+	if err != nil {
+		return err
+	}
+
+	v.basic.UI().Output(buf.String())
+
+	return nil
 }
@@ -4,9 +4,13 @@
 package html
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 )
@@ -31,3 +35,125 @@ func TestShowScenarioOutline(t *testing.T) {
 		})
 	}
 }
+
+// TestShowScenarioClean tests showing the scenario clean results.
+func TestShowScenarioClean(t *testing.T) {
+	t.Parallel()
+
+	v, err := New()
+	require.NoError(t, err)
+	require.NoError(t, v.ShowScenarioClean(&pb.CleanScenariosResponse{
+		DryRun: true,
+		Modules: []*pb.CleanScenariosResponse_Module{
+			{
+				Path:     "/tmp/.enos/abc",
+				Orphaned: true,
+				State:    pb.CleanScenariosResponse_Module_STATE_EMPTY,
+				Removed:  true,
+			},
+		},
+	}))
+}
+
+// TestShowScenarioStatus tests showing the scenario status.
+func TestShowScenarioStatus(t *testing.T) {
+	t.Parallel()
+
+	v, err := New()
+	require.NoError(t, err)
+	require.NoError(t, v.ShowScenarioStatus(&pb.OperationResponses{
+		Responses: []*pb.Operation_Response{
+			{
+				Op: &pb.Ref_Operation{
+					Scenario: &pb.Ref_Scenario{Id: &pb.Scenario_ID{Name: "test"}},
+				},
+				Value: &pb.Operation_Response_State_{
+					State: &pb.Operation_Response_State{
+						Exists:        true,
+						ResourceCount: 2,
+						Steps: []*pb.Operation_Response_State_Step{
+							{Name: "create_vpc", ResourceCount: 2},
+							{Name: "read_ami"},
+						},
+					},
+				},
+			},
+		},
+	}))
+}
+
+// TestShowOperationResponses tests rendering the operation report.
+func TestShowOperationResponses(t *testing.T) {
+	t.Parallel()
+
+	outPath := filepath.Join(t.TempDir(), "report.html")
+	v, err := New(WithUISettings(&pb.UI_Settings{StdoutPath: outPath}))
+	require.NoError(t, err)
+
+	ref := &pb.Ref_Operation{
+		Id:       "abc",
+		Scenario: &pb.Ref_Scenario{Id: &pb.Scenario_ID{Name: "smoke"}},
+	}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	v.ShowOperationEvent(&pb.Operation_Event{Op: ref, PublishedAt: timestamppb.New(start)})
+	v.ShowOperationEvent(&pb.Operation_Event{Op: ref, PublishedAt: timestamppb.New(start.Add(2 * time.Minute))})
+
+	v.observation = &pb.Sample_Observation{
+		Elements: []*pb.Sample_Element{{
+			Sample: &pb.Ref_Sample{Id: &pb.Sample_ID{Name: "nightly"}},
+			Subset: &pb.Ref_Sample_Subset{Id: &pb.Sample_Subset_ID{Name: "smoke_linux"}},
+		}},
+	}
+
+	err = v.ShowOperationResponses(&pb.OperationResponses{
+		Responses: []*pb.Operation_Response{
+			{
+				Op:     ref,
+				Status: pb.Operation_STATUS_FAILED,
+				Value: &pb.Operation_Response_Run_{
+					Run: &pb.Operation_Response_Run{
+						Generate: &pb.Operation_Response_Generate{},
+						Apply: &pb.Terraform_Command_Apply_Response{
+							Stderr: "the <provider> crashed",
+							Diagnostics: []*pb.Diagnostic{{
+								Severity: pb.Diagnostic_SEVERITY_ERROR,
+								Summary:  "apply failed",
+							}},
+						},
+					},
+				},
+			},
+			{
+				Op: &pb.Ref_Operation{
+					Id:       "def",
+					Scenario: &pb.Ref_Scenario{Id: &pb.Scenario_ID{Name: "upgrade"}},
+				},
+				Status: pb.Operation_STATUS_COMPLETED,
+				Value: &pb.Operation_Response_Output_{
+					Output: &pb.Operation_Response_Output{
+						Output: &pb.Terraform_Command_Output_Response{
+							Meta: []*pb.Terraform_Command_Output_Response_Meta{
+								{Name: "token", Type: []byte(`"string"`), Value: []byte(`"hunter2"`), Sensitive: true},
+								{Name: "addr", Type: []byte(`"string"`), Value: []byte(`"10.0.0.1"`)},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.Error(t, err)
+	require.NoError(t, v.Close())
+
+	out, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	report := string(out)
+	require.Contains(t, report, "2 operations")
+	require.Contains(t, report, `<a href="#op-abc">smoke</a>`)
+	require.Contains(t, report, "2m0s")
+	require.Contains(t, report, "apply failed")
+	require.Contains(t, report, "the &lt;provider&gt; crashed")
+	require.Contains(t, report, "10.0.0.1")
+	require.NotContains(t, report, "hunter2")
+	require.Contains(t, report, "smoke_linux")
+}
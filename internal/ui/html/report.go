@@ -0,0 +1,366 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package html
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/colorstring"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	"github.com/hashicorp/enos/internal/operation/terraform/format"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// report is the data model for the operation report template.
+type report struct {
+	GeneratedAt string
+	Total       int
+	Completed   int
+	Warnings    int
+	Failed      int
+	Other       int
+	Operations  []*reportOperation
+	Diagnostics []string
+	Sample      *reportSample
+}
+
+// reportOperation is a single operation in the report.
+type reportOperation struct {
+	ID          string
+	Scenario    string
+	Status      string
+	StatusClass string
+	Started     string
+	Duration    string
+	Diagnostics []string
+	Phases      []*reportPhase
+	Outputs     []*reportOutput
+}
+
+// reportPhase is a phase of an operation, e.g. init, plan, apply.
+type reportPhase struct {
+	Name        string
+	Status      string
+	StatusClass string
+	Diagnostics []string
+	Stdout      string
+	Stderr      string
+}
+
+// reportOutput is a scenario output value.
+type reportOutput struct {
+	Name      string
+	Value     string
+	Sensitive bool
+}
+
+// reportSample is a sample observation.
+type reportSample struct {
+	Elements    []*reportSampleElement
+	Diagnostics []string
+}
+
+// reportSampleElement is a single element of a sample observation.
+type reportSampleElement struct {
+	Sample     string
+	Subset     string
+	Filter     string
+	Attributes []string
+}
+
+// opTiming is the first and last time we've seen an event for an operation.
+type opTiming struct {
+	start time.Time
+	end   time.Time
+}
+
+// recordEvent records the event timing for the event's operation.
+func (v *View) recordEvent(event *pb.Operation_Event) {
+	if event == nil || event.GetOp().GetId() == "" || event.GetPublishedAt() == nil {
+		return
+	}
+
+	at := event.GetPublishedAt().AsTime()
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.timings == nil {
+		v.timings = map[string]*opTiming{}
+	}
+
+	timing, ok := v.timings[event.GetOp().GetId()]
+	if !ok {
+		v.timings[event.GetOp().GetId()] = &opTiming{start: at, end: at}
+
+		return
+	}
+
+	if at.Before(timing.start) {
+		timing.start = at
+	}
+	if at.After(timing.end) {
+		timing.end = at
+	}
+}
+
+// timing returns the recorded timing for an operation.
+func (v *View) timing(id string) (*opTiming, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	timing, ok := v.timings[id]
+
+	return timing, ok
+}
+
+// newReport builds the report data model from the operation responses.
+func (v *View) newReport(res *pb.OperationResponses) *report {
+	r := &report{
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		Diagnostics: diagStrings(res.GetDecode().GetDiagnostics(), res.GetDiagnostics()),
+	}
+
+	for _, opRes := range res.GetResponses() {
+		op := v.newReportOperation(opRes)
+		r.Operations = append(r.Operations, op)
+		r.Total++
+
+		switch op.StatusClass {
+		case "completed":
+			r.Completed++
+		case "warning":
+			r.Warnings++
+		case "failed":
+			r.Failed++
+		default:
+			r.Other++
+		}
+	}
+
+	slices.SortStableFunc(r.Operations, func(a, b *reportOperation) int {
+		return strings.Compare(a.Scenario, b.Scenario)
+	})
+
+	v.mu.Lock()
+	obs := v.observation
+	v.mu.Unlock()
+	if obs != nil {
+		r.Sample = newReportSample(obs)
+	}
+
+	return r
+}
+
+// newReportOperation builds the report model for a single operation response.
+func (v *View) newReportOperation(res *pb.Operation_Response) *reportOperation {
+	scenario := flightplan.NewScenario()
+	scenario.FromRef(res.GetOp().GetScenario())
+
+	opStatus := res.GetStatus()
+	if opStatus == pb.Operation_STATUS_UNSPECIFIED {
+		opStatus = diagnostics.OperationStatus(v.settings.GetFailOnWarnings(), res)
+	}
+
+	op := &reportOperation{
+		ID:          res.GetOp().GetId(),
+		Scenario:    scenario.String(),
+		Diagnostics: diagStrings(res.GetDiagnostics()),
+	}
+	op.Status, op.StatusClass = reportStatus(opStatus)
+
+	if timing, ok := v.timing(op.ID); ok {
+		op.Started = timing.start.UTC().Format(time.RFC3339)
+		op.Duration = timing.end.Sub(timing.start).Round(time.Second).String()
+	}
+
+	addPhase := func(name string, diags []*pb.Diagnostic, stdout string, stderr string) {
+		phase := &reportPhase{
+			Name:        name,
+			Diagnostics: diagStrings(diags),
+			Stdout:      stdout,
+			Stderr:      stderr,
+		}
+		phase.Status, phase.StatusClass = reportStatus(
+			diagnostics.Status(v.settings.GetFailOnWarnings(), diags...),
+		)
+		op.Phases = append(op.Phases, phase)
+	}
+
+	addGenerate := func(gen *pb.Operation_Response_Generate) {
+		if gen == nil {
+			return
+		}
+		addPhase("generate", gen.GetDiagnostics(), "", "")
+	}
+	addInit := func(init *pb.Terraform_Command_Init_Response) {
+		if init == nil {
+			return
+		}
+		addPhase("init", init.GetDiagnostics(), "", init.GetStderr())
+	}
+	addValidate := func(validate *pb.Terraform_Command_Validate_Response) {
+		if validate == nil {
+			return
+		}
+		addPhase("validate", validate.GetDiagnostics(), "", "")
+	}
+	addPlan := func(plan *pb.Terraform_Command_Plan_Response) {
+		if plan == nil {
+			return
+		}
+		addPhase("plan", plan.GetDiagnostics(), "", plan.GetStderr())
+	}
+	addApply := func(apply *pb.Terraform_Command_Apply_Response) {
+		if apply == nil {
+			return
+		}
+		addPhase("apply", apply.GetDiagnostics(), "", apply.GetStderr())
+	}
+	addShow := func(show *pb.Terraform_Command_Show_Response) {
+		if show == nil {
+			return
+		}
+		addPhase("show", show.GetDiagnostics(), "", "")
+	}
+	addDestroy := func(destroy *pb.Terraform_Command_Destroy_Response) {
+		if destroy == nil {
+			return
+		}
+		addPhase("destroy", destroy.GetDiagnostics(), "", destroy.GetStderr())
+	}
+
+	switch t := res.GetValue().(type) {
+	case *pb.Operation_Response_Generate_:
+		op.Diagnostics = append(op.Diagnostics, diagStrings(t.Generate.GetDiagnostics())...)
+		addGenerate(t.Generate)
+	case *pb.Operation_Response_Check_:
+		op.Diagnostics = append(op.Diagnostics, diagStrings(t.Check.GetDiagnostics())...)
+		addGenerate(t.Check.GetGenerate())
+		addInit(t.Check.GetInit())
+		addValidate(t.Check.GetValidate())
+		addPlan(t.Check.GetPlan())
+	case *pb.Operation_Response_Launch_:
+		op.Diagnostics = append(op.Diagnostics, diagStrings(t.Launch.GetDiagnostics())...)
+		addGenerate(t.Launch.GetGenerate())
+		addInit(t.Launch.GetInit())
+		addValidate(t.Launch.GetValidate())
+		addPlan(t.Launch.GetPlan())
+		addApply(t.Launch.GetApply())
+	case *pb.Operation_Response_Destroy_:
+		op.Diagnostics = append(op.Diagnostics, diagStrings(t.Destroy.GetDiagnostics())...)
+		addShow(t.Destroy.GetPriorStateShow())
+		addGenerate(t.Destroy.GetGenerate())
+		addInit(t.Destroy.GetInit())
+		addDestroy(t.Destroy.GetDestroy())
+	case *pb.Operation_Response_Run_:
+		op.Diagnostics = append(op.Diagnostics, diagStrings(t.Run.GetDiagnostics())...)
+		addGenerate(t.Run.GetGenerate())
+		addInit(t.Run.GetInit())
+		addValidate(t.Run.GetValidate())
+		addPlan(t.Run.GetPlan())
+		addApply(t.Run.GetApply())
+		addShow(t.Run.GetPriorStateShow())
+		addDestroy(t.Run.GetDestroy())
+	case *pb.Operation_Response_Exec_:
+		op.Diagnostics = append(op.Diagnostics, diagStrings(t.Exec.GetDiagnostics())...)
+		if exec := t.Exec.GetExec(); exec != nil {
+			addPhase("exec "+exec.GetSubCommand(), exec.GetDiagnostics(), exec.GetStdout(), exec.GetStderr())
+		}
+	case *pb.Operation_Response_Output_:
+		op.Diagnostics = append(op.Diagnostics, diagStrings(t.Output.GetDiagnostics())...)
+		out := t.Output.GetOutput()
+		if out == nil {
+			break
+		}
+		addPhase("output", out.GetDiagnostics(), "", "")
+		for _, meta := range out.GetMeta() {
+			val, err := format.TerraformOutput(meta, 2)
+			if err != nil {
+				val = fmt.Sprintf("unable to format output: %s", err)
+			}
+			op.Outputs = append(op.Outputs, &reportOutput{
+				Name:      meta.GetName(),
+				Value:     val,
+				Sensitive: meta.GetSensitive(),
+			})
+		}
+	case *pb.Operation_Response_State_:
+		op.Diagnostics = append(op.Diagnostics, diagStrings(t.State.GetDiagnostics())...)
+		addInit(t.State.GetInit())
+		addShow(t.State.GetShow())
+	default:
+	}
+
+	return op
+}
+
+// newReportSample builds the report model for a sample observation.
+func newReportSample(obs *pb.Sample_Observation) *reportSample {
+	s := &reportSample{Diagnostics: diagStrings(obs.GetDiagnostics())}
+
+	for _, elm := range obs.GetElements() {
+		e := &reportSampleElement{
+			Sample: elm.GetSample().GetId().GetName(),
+			Subset: elm.GetSubset().GetId().GetName(),
+			Filter: elm.GetScenario().GetId().GetFilter(),
+		}
+
+		attrs := elm.GetAttributes().AsMap()
+		for key, val := range attrs {
+			e.Attributes = append(e.Attributes, fmt.Sprintf("%s=%v", key, val))
+		}
+		slices.Sort(e.Attributes)
+
+		s.Elements = append(s.Elements, e)
+	}
+
+	return s
+}
+
+// reportStatus returns the human readable status and the CSS class for an operation status.
+func reportStatus(status pb.Operation_Status) (string, string) {
+	switch status {
+	case pb.Operation_STATUS_COMPLETED:
+		return "completed", "completed"
+	case pb.Operation_STATUS_COMPLETED_WARNING:
+		return "completed with warnings", "warning"
+	case pb.Operation_STATUS_FAILED:
+		return "failed", "failed"
+	case pb.Operation_STATUS_CANCELLED:
+		return "cancelled", "failed"
+	case pb.Operation_STATUS_RUNNING:
+		return "running", "running"
+	case pb.Operation_STATUS_RUNNING_WARNING:
+		return "running with warnings", "running"
+	case pb.Operation_STATUS_QUEUED:
+		return "queued", "running"
+	case pb.Operation_STATUS_WAITING:
+		return "waiting", "running"
+	case pb.Operation_STATUS_UNSPECIFIED, pb.Operation_STATUS_UNKNOWN:
+		return "unknown", "unknown"
+	default:
+		return "unknown", "unknown"
+	}
+}
+
+// diagStrings renders the diagnostics as plain text for inclusion in the report.
+func diagStrings(diags ...[]*pb.Diagnostic) []string {
+	res := []string{}
+	for _, diag := range diagnostics.Concat(diags...) {
+		res = append(res, strings.TrimSpace(diagnostics.String(diag,
+			diagnostics.WithStringColor(&colorstring.Colorize{
+				Colors:  colorstring.DefaultColors,
+				Disable: true,
+			}),
+		)))
+	}
+
+	return res
+}
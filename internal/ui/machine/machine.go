@@ -144,6 +144,15 @@ func (v *View) ShowDiagnostics(diags []*pb.Diagnostic) error {
 	return v.writeDiagnostics(diags)
 }
 
+// ShowDiagnosticCodeExplanation shows the explanation of a diagnostic code.
+func (v *View) ShowDiagnosticCodeExplanation(res *pb.ExplainDiagnosticCodeResponse) error {
+	if err := v.write(res); err != nil {
+		return err
+	}
+
+	return status.ExplainDiagnosticCode(v.settings.GetFailOnWarnings(), res)
+}
+
 // ShowVersion shows the version information.
 func (v *View) ShowVersion(all bool, res *pb.GetVersionResponse) error {
 	if err := v.write(res); err != nil {
@@ -162,6 +171,24 @@ func (v *View) ShowScenariosValidateConfig(res *pb.ValidateScenariosConfiguratio
 	return status.ScenariosValidateConfig(v.settings.GetFailOnWarnings(), res)
 }
 
+// ShowScenarioLint shows the lint response.
+func (v *View) ShowScenarioLint(res *pb.LintScenariosResponse) error {
+	if err := v.write(res); err != nil {
+		return err
+	}
+
+	return status.ScenariosLint(v.settings.GetFailOnWarnings(), res)
+}
+
+// ShowScenarioVariables shows the variables list.
+func (v *View) ShowScenarioVariables(res *pb.ListScenarioVariablesResponse) error {
+	if err := v.write(res); err != nil {
+		return err
+	}
+
+	return status.ListScenarioVariables(v.settings.GetFailOnWarnings(), res)
+}
+
 // ShowScenarioList shows the a list of scenarios.
 func (v *View) ShowScenarioList(res *pb.ListScenariosResponse) error {
 	if err := v.write(res); err != nil {
@@ -180,6 +207,15 @@ func (v *View) ShowScenarioOutline(res *pb.OutlineScenariosResponse) error {
 	return status.OutlineScenarios(v.settings.GetFailOnWarnings(), res)
 }
 
+// ShowScenarioClean shows the result of cleaning generated scenario modules.
+func (v *View) ShowScenarioClean(res *pb.CleanScenariosResponse) error {
+	if err := v.write(res); err != nil {
+		return err
+	}
+
+	return status.CleanScenarios(v.settings.GetFailOnWarnings(), res)
+}
+
 // ShowSampleList shows the a list of samples.
 func (v *View) ShowSampleList(res *pb.ListSamplesResponse) error {
 	if err := v.write(res); err != nil {
@@ -221,8 +257,29 @@ func (v *View) ShowOutput(out *pb.OperationResponses) error {
 	return status.OperationResponses(v.Settings().GetFailOnWarnings(), out)
 }
 
-// ShowOperationEvent does nothing as the machine output doesn't stream events.
-func (v *View) ShowOperationEvent(*pb.Operation_Event) {
+// ShowScenarioStatus shows the state status of scenarios.
+func (v *View) ShowScenarioStatus(res *pb.OperationResponses) error {
+	if err := v.write(res); err != nil {
+		return err
+	}
+
+	return status.OperationResponses(v.Settings().GetFailOnWarnings(), res)
+}
+
+// ShowOperationEvent writes resource progress events as JSON lines to stderr so that long running
+// operations can be followed. All other events are not streamed as the result is written to stdout
+// when the operation is complete.
+func (v *View) ShowOperationEvent(event *pb.Operation_Event) {
+	if event.GetProgress() == nil {
+		return
+	}
+
+	bytes, err := protojson.Marshal(event)
+	if err != nil {
+		return
+	}
+
+	_, _ = v.ui.Stderr.Write(append(bytes, '\n'))
 }
 
 // ShowOperationResponse shows an operation response.
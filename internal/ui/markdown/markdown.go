@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package markdown
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/colorstring"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/ui/basic"
+	"github.com/hashicorp/enos/internal/ui/status"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// View is our markdown view. It renders scenario lists, outlines, status, sample observations and
+// operation results as GitHub flavored markdown so that they can be posted as comments. If the
+// markdown view is given to other commands it will return an error through the basic CLI view.
+type View struct {
+	basic    *basic.View
+	settings *pb.UI_Settings
+}
+
+// Opt is a functional option.
+type Opt func(*View)
+
+// New takes options and returns a new markdown.View.
+func New(opts ...Opt) (*View, error) {
+	v := &View{}
+	for _, opt := range opts {
+		opt(v)
+	}
+	basic, err := basic.New(basic.WithUISettings(v.settings))
+	if err != nil {
+		return nil, err
+	}
+	v.basic = basic
+
+	return v, nil
+}
+
+// WithUISettings configures the view with the UI settings.
+func WithUISettings(settings *pb.UI_Settings) Opt {
+	return func(view *View) {
+		view.settings = settings
+	}
+}
+
+// Settings returns the views UI settings.
+func (v *View) Settings() *pb.UI_Settings {
+	return v.settings
+}
+
+// Close closes any open file handles.
+func (v *View) Close() error {
+	if v == nil || v.basic == nil {
+		return nil
+	}
+
+	return v.basic.Close()
+}
+
+// ShowFormat shows the output of a format request.
+func (v *View) ShowFormat(cfg *pb.FormatRequest_Config, res *pb.FormatResponse) error {
+	return v.ShowError(status.Unimplemented("markdown/ui: ShowFormat"))
+}
+
+// ShowError writes the given error to stdout in the formatted version.
+func (v *View) ShowError(err error) error {
+	return v.basic.ShowError(err)
+}
+
+// ShowDiagnostics writes the given diagnostic to stdout in the formatted version.
+func (v *View) ShowDiagnostics(diags []*pb.Diagnostic) error {
+	return v.basic.ShowDiagnostics(diags)
+}
+
+// ShowDiagnosticCodeExplanation shows the explanation of a diagnostic code.
+func (v *View) ShowDiagnosticCodeExplanation(res *pb.ExplainDiagnosticCodeResponse) error {
+	return v.ShowError(status.Unimplemented("markdown/ui: ShowDiagnosticCodeExplanation"))
+}
+
+// ShowVersion shows the version information.
+func (v *View) ShowVersion(all bool, res *pb.GetVersionResponse) error {
+	return v.ShowError(status.Unimplemented("markdown/ui: ShowVersion"))
+}
+
+// ShowScenariosValidateConfig shows the validation response.
+func (v *View) ShowScenariosValidateConfig(res *pb.ValidateScenariosConfigurationResponse) error {
+	return v.ShowError(status.Unimplemented("markdown/ui: ShowScenariosValidateConfig"))
+}
+
+// ShowScenarioLint shows the lint response.
+func (v *View) ShowScenarioLint(res *pb.LintScenariosResponse) error {
+	return v.ShowError(status.Unimplemented("markdown/ui: ShowScenarioLint"))
+}
+
+// ShowScenarioVariables shows the variables list.
+func (v *View) ShowScenarioVariables(res *pb.ListScenarioVariablesResponse) error {
+	return v.ShowError(status.Unimplemented("markdown/ui: ShowScenarioVariables"))
+}
+
+// ShowScenarioClean shows the result of cleaning generated scenario modules.
+func (v *View) ShowScenarioClean(res *pb.CleanScenariosResponse) error {
+	return v.ShowError(status.Unimplemented("markdown/ui: ShowScenarioClean"))
+}
+
+// ShowSampleList shows the a list of samples.
+func (v *View) ShowSampleList(res *pb.ListSamplesResponse) error {
+	return v.ShowError(status.Unimplemented("markdown/ui: ShowSampleList"))
+}
+
+// ShowDecode shows the decode response unless it's a incremental update.
+func (v *View) ShowDecode(res *pb.DecodeResponse, incremental bool) error {
+	return v.basic.ShowDecode(res, incremental)
+}
+
+// ShowOperationEvent does nothing as the markdown output doesn't stream events.
+func (v *View) ShowOperationEvent(*pb.Operation_Event) {
+}
+
+// output writes the rendered markdown to stdout.
+func (v *View) output(md string) {
+	v.basic.UI().Output(strings.TrimSuffix(md, "\n"))
+}
+
+// table renders a GitHub flavored markdown table.
+func table(header []string, rows [][]string) string {
+	if len(header) == 0 {
+		return ""
+	}
+
+	b := new(strings.Builder)
+	writeRow := func(cells []string) {
+		b.WriteString("|")
+		for i := range header {
+			cell := ""
+			if i < len(cells) {
+				cell = escapeCell(cells[i])
+			}
+			fmt.Fprintf(b, " %s |", cell)
+		}
+		b.WriteString("\n")
+	}
+
+	writeRow(header)
+	b.WriteString("|")
+	for range header {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+	for _, row := range rows {
+		writeRow(row)
+	}
+
+	return b.String()
+}
+
+// escapeCell escapes a value so that it can be written into a table cell.
+func escapeCell(in string) string {
+	in = strings.TrimSpace(in)
+	in = strings.ReplaceAll(in, "|", `\|`)
+	in = strings.ReplaceAll(in, "\r\n", "\n")
+
+	return strings.ReplaceAll(in, "\n", "<br>")
+}
+
+// codeBlock renders the value as a fenced code block.
+func codeBlock(in string) string {
+	fence := "```"
+	for strings.Contains(in, fence) {
+		fence += "`"
+	}
+
+	return fmt.Sprintf("%s\n%s\n%s\n", fence, strings.TrimRight(in, "\n"), fence)
+}
+
+// diagsString renders the diagnostics as plain text.
+func diagsString(diags ...[]*pb.Diagnostic) string {
+	b := new(strings.Builder)
+	for _, diag := range diagnostics.Concat(diags...) {
+		b.WriteString(diagnostics.String(diag,
+			diagnostics.WithStringColor(&colorstring.Colorize{
+				Colors:  colorstring.DefaultColors,
+				Disable: true,
+			}),
+		))
+	}
+
+	return b.String()
+}
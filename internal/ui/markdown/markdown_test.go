@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package markdown
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+func testView(t *testing.T) (*View, func() string) {
+	t.Helper()
+
+	outPath := filepath.Join(t.TempDir(), "out.md")
+	v, err := New(WithUISettings(&pb.UI_Settings{StdoutPath: outPath}))
+	require.NoError(t, err)
+
+	return v, func() string {
+		t.Helper()
+		require.NoError(t, v.Close())
+		out, err := os.ReadFile(outPath)
+		require.NoError(t, err)
+
+		return string(out)
+	}
+}
+
+func testScenarioRef(name string, variants ...string) *pb.Ref_Scenario {
+	vec := &pb.Matrix_Vector{}
+	for i := 0; i < len(variants); i += 2 {
+		vec.Elements = append(vec.Elements, &pb.Matrix_Element{Key: variants[i], Value: variants[i+1]})
+	}
+
+	return &pb.Ref_Scenario{Id: &pb.Scenario_ID{Name: name, Variants: vec}}
+}
+
+// TestTable tests rendering markdown tables.
+func TestTable(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, `| name | value |
+| --- | --- |
+| a | x \| y |
+| b | one<br>two |
+| c |  |
+`, table([]string{"name", "value"}, [][]string{{"a", "x | y"}, {"b", "one\ntwo\n"}, {"c"}}))
+}
+
+// TestShowScenarioList tests that scenarios are grouped with a column for each matrix variant.
+func TestShowScenarioList(t *testing.T) {
+	t.Parallel()
+
+	v, out := testView(t)
+	require.NoError(t, v.ShowScenarioList(&pb.ListScenariosResponse{
+		Scenarios: []*pb.Ref_Scenario{
+			testScenarioRef("smoke", "arch", "amd64", "distro", "ubuntu"),
+			testScenarioRef("smoke", "arch", "arm64", "distro", "rhel"),
+			testScenarioRef("upgrade"),
+		},
+	}))
+	require.Equal(t, `### smoke
+
+| arch | distro |
+| --- | --- |
+| amd64 | ubuntu |
+| arm64 | rhel |
+
+### upgrade
+
+_No variants_
+`, out())
+}
+
+// TestShowScenarioOutline tests showing the scenario outline.
+func TestShowScenarioOutline(t *testing.T) {
+	t.Parallel()
+
+	v, out := testView(t)
+	require.NoError(t, v.ShowScenarioOutline(&pb.OutlineScenariosResponse{
+		Outlines: []*pb.Scenario_Outline{{
+			Scenario: &pb.Ref_Scenario{Id: &pb.Scenario_ID{Name: "smoke", Description: "A smoke test"}},
+			Matrix: &pb.Matrix{Vectors: []*pb.Matrix_Vector{{Elements: []*pb.Matrix_Element{
+				{Key: "arch", Value: "amd64"},
+				{Key: "arch", Value: "arm64"},
+			}}}},
+			Verifies: []*pb.Quality{{Name: "data:durable", Description: "Data is durable"}},
+			Steps: []*pb.Scenario_Outline_Step{{
+				Name:        "create_vpc",
+				Description: "Create a VPC",
+				Verifies:    []*pb.Quality{{Name: "data:durable"}},
+			}},
+		}},
+	}))
+	require.Equal(t, "### smoke\n\nA smoke test\n\n"+
+		"#### Variants\n\n| variant | values |\n| --- | --- |\n| arch | `amd64`, `arm64` |\n\n"+
+		"#### Verifies\n\n| quality | description |\n| --- | --- |\n| data:durable | Data is durable |\n\n"+
+		"#### Steps\n\n| step | description | verifies |\n| --- | --- | --- |\n| create_vpc | Create a VPC | `data:durable` |\n",
+		out())
+}
+
+// TestShowOperationResponses tests showing operation results.
+func TestShowOperationResponses(t *testing.T) {
+	t.Parallel()
+
+	v, out := testView(t)
+	err := v.ShowOperationResponses(&pb.OperationResponses{
+		Responses: []*pb.Operation_Response{
+			{
+				Op:     &pb.Ref_Operation{Scenario: testScenarioRef("smoke", "arch", "amd64")},
+				Status: pb.Operation_STATUS_COMPLETED,
+			},
+			{
+				Op:     &pb.Ref_Operation{Scenario: testScenarioRef("upgrade", "arch", "arm64")},
+				Status: pb.Operation_STATUS_FAILED,
+				Value: &pb.Operation_Response_Launch_{Launch: &pb.Operation_Response_Launch{
+					Apply: &pb.Terraform_Command_Apply_Response{
+						Stderr: "provider crashed",
+						Diagnostics: []*pb.Diagnostic{{
+							Severity: pb.Diagnostic_SEVERITY_ERROR,
+							Summary:  "apply failed",
+						}},
+					},
+				}},
+			},
+			{
+				Op:     &pb.Ref_Operation{Scenario: testScenarioRef("output")},
+				Status: pb.Operation_STATUS_COMPLETED,
+				Value: &pb.Operation_Response_Output_{Output: &pb.Operation_Response_Output{
+					Output: &pb.Terraform_Command_Output_Response{
+						Meta: []*pb.Terraform_Command_Output_Response_Meta{
+							{Name: "token", Type: []byte(`"string"`), Value: []byte(`"hunter2"`), Sensitive: true},
+							{Name: "addr", Type: []byte(`"string"`), Value: []byte(`"10.0.0.1"`)},
+						},
+					},
+				}},
+			},
+		},
+	})
+	require.Error(t, err)
+
+	md := out()
+	require.Contains(t, md, "| scenario | status |\n| --- | --- |\n"+
+		"| smoke [arch:amd64] | ✅ completed |\n"+
+		"| upgrade [arch:arm64] | ❌ failed |\n")
+	require.Contains(t, md, "<details open>\n<summary>❌ failed upgrade [arch:arm64]</summary>")
+	require.Contains(t, md, "apply failed")
+	require.Contains(t, md, "apply stderr:\n\n```\nprovider crashed\n```")
+	require.Contains(t, md, "| addr | `\"10.0.0.1\"` |")
+	require.Contains(t, md, "| token | (sensitive) |")
+	require.NotContains(t, md, "hunter2")
+	require.NotContains(t, md, "smoke [arch:amd64]</summary>")
+}
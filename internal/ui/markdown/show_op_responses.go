@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package markdown
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	"github.com/hashicorp/enos/internal/operation/terraform/format"
+	"github.com/hashicorp/enos/internal/ui/status"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ShowOutput shows output response.
+func (v *View) ShowOutput(out *pb.OperationResponses) error {
+	return v.ShowOperationResponses(out)
+}
+
+// ShowOperationResponse shows an operation response.
+func (v *View) ShowOperationResponse(res *pb.Operation_Response) error {
+	if res == nil {
+		return nil
+	}
+
+	return v.ShowOperationResponses(&pb.OperationResponses{
+		Responses: []*pb.Operation_Response{res},
+	})
+}
+
+// ShowOperationResponses shows the results of multiple operations as a summary table followed by
+// collapsible details for each operation that has diagnostics, stderr, or outputs.
+func (v *View) ShowOperationResponses(res *pb.OperationResponses) error {
+	if res == nil {
+		return nil
+	}
+
+	b := new(strings.Builder)
+	rows := [][]string{}
+	details := new(strings.Builder)
+	for _, r := range res.GetResponses() {
+		scenario := flightplan.NewScenario()
+		scenario.FromRef(r.GetOp().GetScenario())
+
+		opStatus := r.GetStatus()
+		if opStatus == pb.Operation_STATUS_UNSPECIFIED {
+			opStatus = diagnostics.OperationStatus(v.settings.GetFailOnWarnings(), r)
+		}
+		rows = append(rows, []string{scenario.String(), opStatusString(opStatus)})
+
+		v.writeOperationDetails(details, scenario.String(), opStatus, r)
+	}
+
+	if len(rows) > 0 {
+		b.WriteString(table([]string{"scenario", "status"}, rows))
+	}
+	if details.Len() > 0 {
+		b.WriteString("\n")
+		b.WriteString(details.String())
+	}
+	if diags := diagsString(res.GetDecode().GetDiagnostics(), res.GetDiagnostics()); diags != "" {
+		b.WriteString("\n")
+		b.WriteString(codeBlock(diags))
+	}
+
+	if b.Len() > 0 {
+		v.output(b.String())
+	}
+
+	return status.OperationResponses(v.settings.GetFailOnWarnings(), res)
+}
+
+// writeOperationDetails writes a collapsible section with the diagnostics, stderr and outputs of
+// an operation. Nothing is written if the operation has none of them.
+func (v *View) writeOperationDetails(
+	b *strings.Builder,
+	name string,
+	opStatus pb.Operation_Status,
+	res *pb.Operation_Response,
+) {
+	body := new(strings.Builder)
+
+	if diags := diagsString(diagnostics.OpResDiagnostics(res)); diags != "" {
+		body.WriteString(codeBlock(diags))
+	}
+
+	for _, stderr := range opResStderr(res) {
+		if strings.TrimSpace(stderr.value) == "" {
+			continue
+		}
+		fmt.Fprintf(body, "\n%s stderr:\n\n%s", stderr.phase, codeBlock(stderr.value))
+	}
+
+	if metas := res.GetOutput().GetOutput().GetMeta(); len(metas) > 0 {
+		rows := [][]string{}
+		for _, meta := range metas {
+			val, err := format.TerraformOutput(meta, 2)
+			if err != nil {
+				val = fmt.Sprintf("unable to format output: %s", err)
+			}
+			if !meta.GetSensitive() {
+				val = "`" + val + "`"
+			}
+			rows = append(rows, []string{meta.GetName(), val})
+		}
+		fmt.Fprintf(body, "\n%s", table([]string{"output", "value"}, rows))
+	}
+
+	if body.Len() == 0 {
+		return
+	}
+
+	open := ""
+	if opStatus == pb.Operation_STATUS_FAILED {
+		open = " open"
+	}
+	fmt.Fprintf(b, "<details%s>\n<summary>%s %s</summary>\n\n%s\n</details>\n",
+		open, opStatusString(opStatus), name, strings.TrimSpace(body.String()),
+	)
+}
+
+type phaseStderr struct {
+	phase string
+	value string
+}
+
+// opResStderr returns the stderr of every phase of an operation response.
+func opResStderr(res *pb.Operation_Response) []phaseStderr {
+	return []phaseStderr{
+		{"init", res.GetCheck().GetInit().GetStderr()},
+		{"plan", res.GetCheck().GetPlan().GetStderr()},
+		{"init", res.GetLaunch().GetInit().GetStderr()},
+		{"plan", res.GetLaunch().GetPlan().GetStderr()},
+		{"apply", res.GetLaunch().GetApply().GetStderr()},
+		{"init", res.GetRun().GetInit().GetStderr()},
+		{"plan", res.GetRun().GetPlan().GetStderr()},
+		{"apply", res.GetRun().GetApply().GetStderr()},
+		{"destroy", res.GetRun().GetDestroy().GetStderr()},
+		{"init", res.GetDestroy().GetInit().GetStderr()},
+		{"destroy", res.GetDestroy().GetDestroy().GetStderr()},
+		{"exec", res.GetExec().GetExec().GetStderr()},
+		{"init", res.GetState().GetInit().GetStderr()},
+	}
+}
+
+// opStatusString returns a markdown friendly operation status.
+func opStatusString(opStatus pb.Operation_Status) string {
+	switch opStatus {
+	case pb.Operation_STATUS_COMPLETED:
+		return "✅ completed"
+	case pb.Operation_STATUS_COMPLETED_WARNING:
+		return "⚠️ completed with warnings"
+	case pb.Operation_STATUS_FAILED:
+		return "❌ failed"
+	case pb.Operation_STATUS_CANCELLED:
+		return "❌ cancelled"
+	case pb.Operation_STATUS_RUNNING, pb.Operation_STATUS_RUNNING_WARNING:
+		return "🚀 running"
+	case pb.Operation_STATUS_QUEUED, pb.Operation_STATUS_WAITING:
+		return "⏳ waiting"
+	case pb.Operation_STATUS_UNSPECIFIED, pb.Operation_STATUS_UNKNOWN:
+		return "⁉️ unknown"
+	default:
+		return "⁉️ unknown"
+	}
+}
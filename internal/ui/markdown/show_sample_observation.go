@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package markdown
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/enos/internal/ui/status"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ShowSampleObservation shows the sample observation.
+func (v *View) ShowSampleObservation(res *pb.ObserveSampleResponse) error {
+	rows := [][]string{}
+	for _, elm := range res.GetObservation().GetElements() {
+		attrs := []string{}
+		for key, val := range elm.GetAttributes().AsMap() {
+			attrs = append(attrs, fmt.Sprintf("`%s=%v`", key, val))
+		}
+		slices.Sort(attrs)
+
+		rows = append(rows, []string{
+			elm.GetSample().GetId().GetName(),
+			elm.GetSubset().GetId().GetName(),
+			"`" + elm.GetScenario().GetId().GetFilter() + "`",
+			strings.Join(attrs, " "),
+		})
+	}
+
+	if len(rows) > 0 {
+		v.output(table([]string{"sample", "subset", "scenario filter", "attributes"}, rows))
+	}
+	v.basic.WriteDiagnostics(res.GetDecode().GetDiagnostics())
+	v.basic.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.ShowSampleObservation(v.settings.GetFailOnWarnings(), res)
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package markdown
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/enos/internal/flightplan"
+	"github.com/hashicorp/enos/internal/ui/status"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ShowScenarioList shows the list of scenarios. Each scenario is written as a section with a table
+// of its variants, with a column for each matrix variant key.
+func (v *View) ShowScenarioList(res *pb.ListScenariosResponse) error {
+	names := []string{}
+	variants := map[string][]*flightplan.Scenario{}
+	for _, ref := range res.GetScenarios() {
+		scenario := flightplan.NewScenario()
+		scenario.FromRef(ref)
+
+		if _, ok := variants[scenario.Name]; !ok {
+			names = append(names, scenario.Name)
+		}
+		variants[scenario.Name] = append(variants[scenario.Name], scenario)
+	}
+
+	b := new(strings.Builder)
+	for i, name := range names {
+		if i != 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(b, "### %s\n\n", name)
+
+		header := []string{}
+		rows := [][]string{}
+		for _, scenario := range variants[name] {
+			if scenario.Variants == nil || len(scenario.Variants.Elements()) == 0 {
+				continue
+			}
+
+			if len(header) == 0 {
+				for _, elm := range scenario.Variants.Elements() {
+					header = append(header, elm.Key)
+				}
+			}
+
+			row := []string{}
+			for _, elm := range scenario.Variants.Elements() {
+				row = append(row, elm.Val)
+			}
+			rows = append(rows, row)
+		}
+
+		if len(rows) == 0 {
+			b.WriteString("_No variants_\n")
+
+			continue
+		}
+
+		b.WriteString(table(header, rows))
+	}
+
+	if b.Len() > 0 {
+		v.output(b.String())
+	}
+	v.basic.WriteDiagnostics(res.GetDecode().GetDiagnostics())
+	v.basic.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.ListScenarios(v.settings.GetFailOnWarnings(), res)
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package markdown
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/enos/internal/ui/status"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ShowScenarioOutline shows an outline of scenarios.
+func (v *View) ShowScenarioOutline(res *pb.OutlineScenariosResponse) error {
+	b := new(strings.Builder)
+
+	for i, out := range res.GetOutlines() {
+		if i != 0 {
+			b.WriteString("\n")
+		}
+
+		fmt.Fprintf(b, "### %s\n", out.GetScenario().GetId().GetName())
+		if description := strings.TrimSpace(out.GetScenario().GetId().GetDescription()); description != "" {
+			fmt.Fprintf(b, "\n%s\n", description)
+		}
+
+		if vectors := out.GetMatrix().GetVectors(); len(vectors) > 0 {
+			rows := [][]string{}
+			for _, variant := range vectors {
+				values := []string{}
+				for _, elm := range variant.GetElements() {
+					values = append(values, "`"+elm.GetValue()+"`")
+				}
+				// We assume a well formatted matrix from the service
+				rows = append(rows, []string{variant.GetElements()[0].GetKey(), strings.Join(values, ", ")})
+			}
+			fmt.Fprintf(b, "\n#### Variants\n\n%s", table([]string{"variant", "values"}, rows))
+		}
+
+		if verifies := out.GetVerifies(); len(verifies) > 0 {
+			fmt.Fprintf(b, "\n#### Verifies\n\n%s", table([]string{"quality", "description"}, qualityRows(verifies)))
+		}
+
+		if steps := out.GetSteps(); len(steps) > 0 {
+			rows := [][]string{}
+			for _, step := range steps {
+				qualities := []string{}
+				for _, quality := range step.GetVerifies() {
+					qualities = append(qualities, "`"+quality.GetName()+"`")
+				}
+				rows = append(rows, []string{step.GetName(), step.GetDescription(), strings.Join(qualities, ", ")})
+			}
+			fmt.Fprintf(b, "\n#### Steps\n\n%s", table([]string{"step", "description", "verifies"}, rows))
+		}
+	}
+
+	if b.Len() > 0 {
+		v.output(b.String())
+	}
+	v.basic.WriteDiagnostics(res.GetDecode().GetDiagnostics())
+	v.basic.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.OutlineScenarios(v.settings.GetFailOnWarnings(), res)
+}
+
+func qualityRows(qualities []*pb.Quality) [][]string {
+	rows := [][]string{}
+	for _, quality := range qualities {
+		rows = append(rows, []string{quality.GetName(), quality.GetDescription()})
+	}
+
+	return rows
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package markdown
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	"github.com/hashicorp/enos/internal/ui/basic"
+	"github.com/hashicorp/enos/internal/ui/status"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ShowScenarioStatus shows the state status of scenarios.
+func (v *View) ShowScenarioStatus(res *pb.OperationResponses) error {
+	rows := [][]string{}
+	for _, r := range res.GetResponses() {
+		scenario := flightplan.NewScenario()
+		scenario.FromRef(r.GetOp().GetScenario())
+		state := r.GetState()
+
+		rows = append(rows, []string{
+			scenario.String(),
+			basic.ScenarioStateString(r),
+			strconv.FormatInt(state.GetResourceCount(), 10),
+			basic.ScenarioStateStepsString(state),
+			basic.ScenarioStateLastModifiedString(state),
+		})
+	}
+
+	if len(rows) > 0 {
+		v.output(table([]string{"scenario", "state", "resources", "steps", "last modified"}, rows))
+	}
+
+	for _, r := range res.GetResponses() {
+		v.basic.WriteDiagnostics(diagnostics.Concat(
+			r.GetDiagnostics(),
+			r.GetState().GetDiagnostics(),
+			r.GetState().GetInit().GetDiagnostics(),
+			r.GetState().GetShow().GetDiagnostics(),
+		))
+	}
+	v.basic.WriteDiagnostics(res.GetDecode().GetDiagnostics())
+	v.basic.WriteDiagnostics(res.GetDiagnostics())
+
+	return status.OperationResponses(v.settings.GetFailOnWarnings(), res)
+}
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package record records operation event streams and replays them through any view.
+//
+// A recording is JSONL: every line is an OperationRecord that holds either an operation event
+// or the final operation responses.
+package record
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	uipkg "github.com/hashicorp/enos/internal/ui"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+var _ uipkg.View = (*View)(nil)
+
+// View is a view that records every operation event and the operation responses that it is
+// given before passing them on to the view that it wraps.
+type View struct {
+	uipkg.View
+
+	mu   sync.Mutex
+	out  io.WriteCloser
+	last map[string]*pb.Operation_Event
+	err  error
+}
+
+// New takes a view and a path to write the recording to and returns a new recording View.
+func New(view uipkg.View, path string) (*View, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("creating recording: %w", err)
+	}
+
+	return NewWithWriter(view, f), nil
+}
+
+// NewWithWriter takes a view and a writer and returns a new recording View.
+func NewWithWriter(view uipkg.View, out io.WriteCloser) *View {
+	return &View{
+		View: view,
+		out:  out,
+		last: map[string]*pb.Operation_Event{},
+	}
+}
+
+// Close closes the recording and the wrapped view.
+func (v *View) Close() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return errors.Join(v.err, v.out.Close(), v.View.Close())
+}
+
+// ShowOperationEvent records the event and shows it.
+func (v *View) ShowOperationEvent(event *pb.Operation_Event) {
+	v.mu.Lock()
+	// The client periodically shows the last event of an operation again while it waits for
+	// more. Only record the event the first time we see it.
+	id := event.GetOp().GetId()
+	if v.last[id] != event {
+		v.last[id] = event
+		v.write(&pb.OperationRecord{Value: &pb.OperationRecord_Event{Event: event}})
+	}
+	v.mu.Unlock()
+
+	v.View.ShowOperationEvent(event)
+}
+
+// ShowOperationResponses records the responses and shows them.
+func (v *View) ShowOperationResponses(res *pb.OperationResponses) error {
+	v.mu.Lock()
+	v.write(&pb.OperationRecord{Value: &pb.OperationRecord_Responses{Responses: res}})
+	v.mu.Unlock()
+
+	return v.View.ShowOperationResponses(res)
+}
+
+// write writes a record to the recording. Each record is written with a single write so that
+// the recording is usable even if we exit before it has been closed.
+func (v *View) write(rec *pb.OperationRecord) {
+	if v.err != nil {
+		return
+	}
+
+	b, err := protojson.Marshal(rec)
+	if err != nil {
+		v.err = fmt.Errorf("recording operation: %w", err)
+
+		return
+	}
+
+	_, err = v.out.Write(append(b, '\n'))
+	if err != nil {
+		v.err = fmt.Errorf("recording operation: %w", err)
+	}
+}
+
+// ReplayOpt is a functional option for Replay.
+type ReplayOpt func(*replayer)
+
+type replayer struct {
+	realtime bool
+	speed    float64
+}
+
+// WithRealtime paces the replay by the time between events when they were published. The speed
+// is a multiplier, e.g. a speed of 2 replays twice as fast as the events were published.
+func WithRealtime(speed float64) ReplayOpt {
+	return func(r *replayer) {
+		r.realtime = true
+		r.speed = speed
+	}
+}
+
+// Replay reads a recording and shows every operation event and the operation responses
+// with the view.
+func Replay(ctx context.Context, in io.Reader, view uipkg.View, opts ...ReplayOpt) error {
+	r := &replayer{speed: 1}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.speed <= 0 {
+		return fmt.Errorf("replay speed must be greater than zero, got %v", r.speed)
+	}
+
+	reader := bufio.NewReader(in)
+	var lastPublished time.Time
+	for line := 1; ; line++ {
+		b, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("reading recording: %w", err)
+		}
+		eof := errors.Is(err, io.EOF)
+		b = bytes.TrimSpace(b)
+		if len(b) == 0 {
+			if eof {
+				return errors.New("recording does not include operation responses")
+			}
+
+			continue
+		}
+
+		rec := &pb.OperationRecord{}
+		if err := protojson.Unmarshal(b, rec); err != nil {
+			return fmt.Errorf("reading recording line %d: %w", line, err)
+		}
+
+		switch t := rec.GetValue().(type) {
+		case *pb.OperationRecord_Event:
+			published := t.Event.GetPublishedAt().AsTime()
+			if r.realtime && !lastPublished.IsZero() && published.After(lastPublished) {
+				wait := time.Duration(float64(published.Sub(lastPublished)) / r.speed)
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(wait):
+				}
+			}
+			if published.After(lastPublished) {
+				lastPublished = published
+			}
+
+			view.ShowOperationEvent(t.Event)
+		case *pb.OperationRecord_Responses:
+			return view.ShowOperationResponses(t.Responses)
+		default:
+			return fmt.Errorf("reading recording line %d: unknown record", line)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package record
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	uipkg "github.com/hashicorp/enos/internal/ui"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// testView is a view that keeps everything that it has been asked to show.
+type testView struct {
+	uipkg.View
+
+	events []*pb.Operation_Event
+	res    *pb.OperationResponses
+}
+
+func (v *testView) ShowOperationEvent(event *pb.Operation_Event) {
+	v.events = append(v.events, event)
+}
+
+func (v *testView) ShowOperationResponses(res *pb.OperationResponses) error {
+	v.res = res
+
+	return nil
+}
+
+func (v *testView) Close() error {
+	return nil
+}
+
+type nopCloser struct {
+	*bytes.Buffer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// TestRecordReplay tests that recorded operation events and responses are replayed.
+func TestRecordReplay(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	ref := &pb.Ref_Operation{Id: "op"}
+	events := []*pb.Operation_Event{
+		{
+			Op:          ref,
+			Status:      pb.Operation_STATUS_RUNNING,
+			PublishedAt: timestamppb.New(now),
+			Value:       &pb.Operation_Event_Generate{},
+		},
+		{
+			Op:          ref,
+			Status:      pb.Operation_STATUS_COMPLETED,
+			PublishedAt: timestamppb.New(now.Add(10 * time.Millisecond)),
+			Done:        true,
+			Value:       &pb.Operation_Event_Generate{},
+		},
+	}
+	res := &pb.OperationResponses{
+		Responses: []*pb.Operation_Response{{Op: ref, Status: pb.Operation_STATUS_COMPLETED}},
+	}
+
+	buf := nopCloser{&bytes.Buffer{}}
+	recorded := &testView{}
+	v := NewWithWriter(recorded, buf)
+	v.ShowOperationEvent(events[0])
+	v.ShowOperationEvent(events[0]) // shown again while waiting, should not be recorded twice
+	v.ShowOperationEvent(events[1])
+	require.NoError(t, v.ShowOperationResponses(res))
+	require.NoError(t, v.Close())
+	require.Len(t, recorded.events, 3)
+	require.Len(t, strings.Split(strings.TrimSpace(buf.String()), "\n"), 3)
+
+	for name, opts := range map[string][]ReplayOpt{
+		"immediate": nil,
+		"realtime":  {WithRealtime(2)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			replayed := &testView{}
+			require.NoError(t, Replay(context.Background(), strings.NewReader(buf.String()), replayed, opts...))
+			require.Len(t, replayed.events, 2)
+			require.Equal(t, pb.Operation_STATUS_RUNNING, replayed.events[0].GetStatus())
+			require.True(t, replayed.events[1].GetDone())
+			require.Equal(t, "op", replayed.res.GetResponses()[0].GetOp().GetId())
+		})
+	}
+
+	t.Run("truncated", func(t *testing.T) {
+		t.Parallel()
+
+		lines := strings.SplitAfter(buf.String(), "\n")
+		err := Replay(context.Background(), strings.NewReader(lines[0]), &testView{})
+		require.ErrorContains(t, err, "does not include operation responses")
+	})
+}
@@ -0,0 +1,264 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sarif
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+	"github.com/hashicorp/enos/version"
+)
+
+const (
+	schemaURI  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVer   = "2.1.0"
+	toolName   = "enos"
+	toolURI    = "https://github.com/hashicorp/enos"
+	ruleNoCode = "enos"
+	ruleFmt    = "fmt"
+)
+
+// Log is a SARIF 2.1.0 log. Only the subset of the specification that we need to report
+// diagnostics is implemented.
+type Log struct {
+	Schema  string `json:"$schema"`
+	Version string `json:"version"`
+	Runs    []*Run `json:"runs"`
+}
+
+// Run is a single run of a tool.
+type Run struct {
+	Tool    *Tool     `json:"tool"`
+	Results []*Result `json:"results"`
+}
+
+// Tool describes the tool that produced the results.
+type Tool struct {
+	Driver *Driver `json:"driver"`
+}
+
+// Driver is the tool component that produced the results.
+type Driver struct {
+	Name           string  `json:"name"`
+	Version        string  `json:"version,omitempty"`
+	InformationURI string  `json:"informationUri,omitempty"`
+	Rules          []*Rule `json:"rules"`
+}
+
+// Rule describes a diagnostic code.
+type Rule struct {
+	ID               string   `json:"id"`
+	ShortDescription *Message `json:"shortDescription,omitempty"`
+	Help             *Message `json:"help,omitempty"`
+	HelpURI          string   `json:"helpUri,omitempty"`
+}
+
+// Result is a single diagnostic.
+type Result struct {
+	RuleID    string      `json:"ruleId"`
+	Level     string      `json:"level"`
+	Message   *Message    `json:"message"`
+	Locations []*Location `json:"locations,omitempty"`
+}
+
+// Message is a SARIF message.
+type Message struct {
+	Text string `json:"text"`
+}
+
+// Location is the location of a result.
+type Location struct {
+	PhysicalLocation *PhysicalLocation `json:"physicalLocation"`
+}
+
+// PhysicalLocation is a location in a file.
+type PhysicalLocation struct {
+	ArtifactLocation *ArtifactLocation `json:"artifactLocation"`
+	Region           *Region           `json:"region,omitempty"`
+}
+
+// ArtifactLocation is the file of a location.
+type ArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// Region is the region in a file. Lines and columns are 1 based and the end column is exclusive,
+// which matches our HCL ranges.
+type Region struct {
+	StartLine   int64 `json:"startLine"`
+	StartColumn int64 `json:"startColumn,omitempty"`
+	EndLine     int64 `json:"endLine,omitempty"`
+	EndColumn   int64 `json:"endColumn,omitempty"`
+}
+
+// builder builds a SARIF log from diagnostics.
+type builder struct {
+	baseDir string
+	rules   map[string]*Rule
+	results []*Result
+}
+
+func newBuilder() *builder {
+	b := &builder{rules: map[string]*Rule{}}
+	if wd, err := os.Getwd(); err == nil {
+		b.baseDir = wd
+	}
+
+	return b
+}
+
+// addDiagnostics adds a result for each diagnostic.
+func (b *builder) addDiagnostics(diags ...[]*pb.Diagnostic) {
+	for _, diag := range diagnostics.Concat(diags...) {
+		if diag == nil {
+			continue
+		}
+
+		ruleID := diag.GetCode()
+		if ruleID == "" {
+			ruleID = ruleNoCode
+		}
+		b.addRule(ruleID)
+
+		text := diag.GetSummary()
+		if detail := strings.TrimSpace(diag.GetDetail()); detail != "" {
+			text += ": " + detail
+		}
+
+		res := &Result{
+			RuleID:  ruleID,
+			Level:   level(diag.GetSeverity()),
+			Message: &Message{Text: text},
+		}
+		if loc := b.location(diag.GetRange()); loc != nil {
+			res.Locations = []*Location{loc}
+		}
+
+		b.results = append(b.results, res)
+	}
+}
+
+// addUnformatted adds a result for a file that is not formatted.
+func (b *builder) addUnformatted(path string) {
+	b.addRule(ruleFmt)
+	b.results = append(b.results, &Result{
+		RuleID:  ruleFmt,
+		Level:   "error",
+		Message: &Message{Text: "File is not formatted. Run 'enos fmt' to format it."},
+		Locations: []*Location{{
+			PhysicalLocation: &PhysicalLocation{
+				ArtifactLocation: &ArtifactLocation{URI: b.uri(path)},
+				Region:           &Region{StartLine: 1},
+			},
+		}},
+	})
+}
+
+func (b *builder) addRule(id string) {
+	if _, ok := b.rules[id]; ok {
+		return
+	}
+
+	rule := &Rule{ID: id}
+	switch id {
+	case ruleNoCode:
+		rule.ShortDescription = &Message{Text: "Enos diagnostic"}
+	case ruleFmt:
+		rule.ShortDescription = &Message{Text: "Configuration is not formatted"}
+	default:
+		if info, ok := diagnostics.LookupCode(diagnostics.Code(id)); ok {
+			rule.ShortDescription = &Message{Text: info.Summary}
+			if info.Remediation != "" {
+				rule.Help = &Message{Text: info.Remediation}
+			}
+			rule.HelpURI = info.DocURL
+		}
+	}
+
+	b.rules[id] = rule
+}
+
+func (b *builder) location(rng *pb.Range) *Location {
+	if rng == nil || rng.GetFilename() == "" {
+		return nil
+	}
+
+	loc := &Location{
+		PhysicalLocation: &PhysicalLocation{
+			ArtifactLocation: &ArtifactLocation{URI: b.uri(rng.GetFilename())},
+		},
+	}
+
+	if start := rng.GetStart(); start.GetLine() > 0 {
+		loc.PhysicalLocation.Region = &Region{
+			StartLine:   start.GetLine(),
+			StartColumn: start.GetColumn(),
+		}
+		if end := rng.GetEnd(); end.GetLine() >= start.GetLine() {
+			loc.PhysicalLocation.Region.EndLine = end.GetLine()
+			loc.PhysicalLocation.Region.EndColumn = end.GetColumn()
+		}
+	}
+
+	return loc
+}
+
+// uri returns the URI of the file. Files in the working directory are made relative to it so that
+// code scanning tools can map them to files in the repository.
+func (b *builder) uri(path string) string {
+	if b.baseDir != "" && filepath.IsAbs(path) {
+		if rel, err := filepath.Rel(b.baseDir, path); err == nil && !strings.HasPrefix(rel, "..") {
+			path = rel
+		}
+	}
+
+	return filepath.ToSlash(path)
+}
+
+// log returns the SARIF log.
+func (b *builder) log() *Log {
+	driver := &Driver{
+		Name:           toolName,
+		Version:        version.Version,
+		InformationURI: toolURI,
+		Rules:          []*Rule{},
+	}
+	for _, rule := range b.rules {
+		driver.Rules = append(driver.Rules, rule)
+	}
+	slices.SortFunc(driver.Rules, func(a, b *Rule) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	results := b.results
+	if results == nil {
+		results = []*Result{}
+	}
+
+	return &Log{
+		Schema:  schemaURI,
+		Version: sarifVer,
+		Runs: []*Run{{
+			Tool:    &Tool{Driver: driver},
+			Results: results,
+		}},
+	}
+}
+
+func level(sev pb.Diagnostic_Severity) string {
+	switch sev {
+	case pb.Diagnostic_SEVERITY_ERROR:
+		return "error"
+	case pb.Diagnostic_SEVERITY_WARNING:
+		return "warning"
+	case pb.Diagnostic_SEVERITY_UNSPECIFIED, pb.Diagnostic_SEVERITY_UNKNOWN:
+		return "note"
+	default:
+		return "note"
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sarif
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/enos/internal/ui/basic"
+	"github.com/hashicorp/enos/internal/ui/status"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// View is our SARIF view. It writes the diagnostics of validate, lint, and format responses as a
+// SARIF 2.1.0 log so that code scanning tools can annotate the exact lines. If the sarif view is
+// given to other commands it will return an error through the basic CLI view.
+type View struct {
+	basic    *basic.View
+	settings *pb.UI_Settings
+}
+
+// Opt is a functional option.
+type Opt func(*View)
+
+// New takes options and returns a new sarif.View.
+func New(opts ...Opt) (*View, error) {
+	v := &View{}
+	for _, opt := range opts {
+		opt(v)
+	}
+	basic, err := basic.New(basic.WithUISettings(v.settings))
+	if err != nil {
+		return nil, err
+	}
+	v.basic = basic
+
+	return v, nil
+}
+
+// WithUISettings configures the view with the UI settings.
+func WithUISettings(settings *pb.UI_Settings) Opt {
+	return func(view *View) {
+		view.settings = settings
+	}
+}
+
+// Settings returns the views UI settings.
+func (v *View) Settings() *pb.UI_Settings {
+	return v.settings
+}
+
+// Close closes any open file handles.
+func (v *View) Close() error {
+	if v == nil || v.basic == nil {
+		return nil
+	}
+
+	return v.basic.Close()
+}
+
+// ShowFormat shows the output of a format request. When checking, each file that would be changed
+// is reported as a result.
+func (v *View) ShowFormat(cfg *pb.FormatRequest_Config, res *pb.FormatResponse) error {
+	b := newBuilder()
+	for _, r := range res.GetResponses() {
+		if cfg.GetCheck() && r.GetChanged() {
+			b.addUnformatted(r.GetPath())
+		}
+		b.addDiagnostics(r.GetDiagnostics())
+	}
+	b.addDiagnostics(res.GetDiagnostics())
+
+	if err := v.write(b); err != nil {
+		return v.ShowError(err)
+	}
+
+	return status.Format(cfg, res)
+}
+
+// ShowError writes the given error to stdout in the formatted version.
+func (v *View) ShowError(err error) error {
+	return v.basic.ShowError(err)
+}
+
+// ShowDiagnostics writes the given diagnostic to stdout in the formatted version.
+func (v *View) ShowDiagnostics(diags []*pb.Diagnostic) error {
+	return v.basic.ShowDiagnostics(diags)
+}
+
+// ShowDiagnosticCodeExplanation shows the explanation of a diagnostic code.
+func (v *View) ShowDiagnosticCodeExplanation(res *pb.ExplainDiagnosticCodeResponse) error {
+	return v.ShowError(status.Unimplemented("sarif/ui: ShowDiagnosticCodeExplanation"))
+}
+
+// ShowVersion shows the version information.
+func (v *View) ShowVersion(all bool, res *pb.GetVersionResponse) error {
+	return v.ShowError(status.Unimplemented("sarif/ui: ShowVersion"))
+}
+
+// ShowScenariosValidateConfig shows the validation response.
+func (v *View) ShowScenariosValidateConfig(res *pb.ValidateScenariosConfigurationResponse) error {
+	b := newBuilder()
+	b.addDiagnostics(
+		res.GetDiagnostics(),
+		res.GetDecode().GetDiagnostics(),
+		res.GetSampleDecode().GetDiagnostics(),
+	)
+
+	if err := v.write(b); err != nil {
+		return v.ShowError(err)
+	}
+
+	return status.ScenariosValidateConfig(v.settings.GetFailOnWarnings(), res)
+}
+
+// ShowScenarioLint shows the lint response.
+func (v *View) ShowScenarioLint(res *pb.LintScenariosResponse) error {
+	b := newBuilder()
+	b.addDiagnostics(res.GetDecode().GetDiagnostics(), res.GetDiagnostics())
+
+	if err := v.write(b); err != nil {
+		return v.ShowError(err)
+	}
+
+	return status.ScenariosLint(v.settings.GetFailOnWarnings(), res)
+}
+
+// ShowScenarioVariables shows the variables list.
+func (v *View) ShowScenarioVariables(res *pb.ListScenarioVariablesResponse) error {
+	return v.ShowError(status.Unimplemented("sarif/ui: ShowScenarioVariables"))
+}
+
+// ShowScenarioList shows the a list of scenarios.
+func (v *View) ShowScenarioList(res *pb.ListScenariosResponse) error {
+	return v.ShowError(status.Unimplemented("sarif/ui: ShowScenarioList"))
+}
+
+// ShowScenarioOutline shows the scenario outlines.
+func (v *View) ShowScenarioOutline(res *pb.OutlineScenariosResponse) error {
+	return v.ShowError(status.Unimplemented("sarif/ui: ShowScenarioOutline"))
+}
+
+// ShowScenarioClean shows the result of cleaning generated scenario modules.
+func (v *View) ShowScenarioClean(res *pb.CleanScenariosResponse) error {
+	return v.ShowError(status.Unimplemented("sarif/ui: ShowScenarioClean"))
+}
+
+// ShowScenarioStatus shows the state status of scenarios.
+func (v *View) ShowScenarioStatus(res *pb.OperationResponses) error {
+	return v.ShowError(status.Unimplemented("sarif/ui: ShowScenarioStatus"))
+}
+
+// ShowSampleList shows the a list of samples.
+func (v *View) ShowSampleList(res *pb.ListSamplesResponse) error {
+	return v.ShowError(status.Unimplemented("sarif/ui: ShowSampleList"))
+}
+
+// ShowSampleObservation shows the sample observation.
+func (v *View) ShowSampleObservation(res *pb.ObserveSampleResponse) error {
+	return v.ShowError(status.Unimplemented("sarif/ui: ShowSampleObservation"))
+}
+
+// ShowDecode shows the decode response unless it's a incremental update.
+func (v *View) ShowDecode(res *pb.DecodeResponse, incremental bool) error {
+	return v.basic.ShowDecode(res, incremental)
+}
+
+// ShowOutput shows output response.
+func (v *View) ShowOutput(out *pb.OperationResponses) error {
+	return v.ShowError(status.Unimplemented("sarif/ui: ShowOutput"))
+}
+
+// ShowOperationEvent does nothing as the sarif output doesn't stream events.
+func (v *View) ShowOperationEvent(*pb.Operation_Event) {
+}
+
+// ShowOperationResponse shows an operation response.
+func (v *View) ShowOperationResponse(res *pb.Operation_Response) error {
+	return v.ShowError(status.Unimplemented("sarif/ui: ShowOperationResponse"))
+}
+
+// ShowOperationResponses shows the results of multiple operations.
+func (v *View) ShowOperationResponses(res *pb.OperationResponses) error {
+	return v.ShowError(status.Unimplemented("sarif/ui: ShowOperationResponses"))
+}
+
+// write writes the SARIF log to stdout.
+func (v *View) write(b *builder) error {
+	bytes, err := json.MarshalIndent(b.log(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	v.basic.UI().Output(string(bytes))
+
+	return nil
+}
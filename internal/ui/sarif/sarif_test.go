@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sarif
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+func testView(t *testing.T) (*View, func() *Log) {
+	t.Helper()
+
+	outPath := filepath.Join(t.TempDir(), "out.sarif")
+	v, err := New(WithUISettings(&pb.UI_Settings{StdoutPath: outPath}))
+	require.NoError(t, err)
+
+	return v, func() *Log {
+		t.Helper()
+		require.NoError(t, v.Close())
+		out, err := os.ReadFile(outPath)
+		require.NoError(t, err)
+		log := &Log{}
+		require.NoError(t, json.Unmarshal(out, log))
+
+		return log
+	}
+}
+
+// TestShowScenariosValidateConfig tests that diagnostics are mapped to results with regions.
+func TestShowScenariosValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	v, out := testView(t)
+	err = v.ShowScenariosValidateConfig(&pb.ValidateScenariosConfigurationResponse{
+		Decode: &pb.DecodeResponse{
+			Diagnostics: []*pb.Diagnostic{
+				{
+					Severity: pb.Diagnostic_SEVERITY_ERROR,
+					Summary:  "Unsupported argument",
+					Detail:   "An argument named \"foo\" is not expected here.",
+					Code:     string(diagnostics.CodeInvalidVariableValue),
+					Range: &pb.Range{
+						Filename: filepath.Join(wd, "enos", "enos-scenario.hcl"),
+						Start:    &pb.Range_Pos{Line: 12, Column: 5},
+						End:      &pb.Range_Pos{Line: 12, Column: 8},
+					},
+				},
+			},
+		},
+		SampleDecode: &pb.DecodeResponse{
+			Diagnostics: []*pb.Diagnostic{
+				{
+					Severity: pb.Diagnostic_SEVERITY_WARNING,
+					Summary:  "something odd",
+				},
+			},
+		},
+	})
+	require.Error(t, err)
+
+	log := out()
+	require.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	run := log.Runs[0]
+	require.Equal(t, "enos", run.Tool.Driver.Name)
+	require.Len(t, run.Tool.Driver.Rules, 2)
+	require.Equal(t, string(diagnostics.CodeInvalidVariableValue), run.Tool.Driver.Rules[0].ID)
+	require.NotEmpty(t, run.Tool.Driver.Rules[0].HelpURI)
+	require.Equal(t, "enos", run.Tool.Driver.Rules[1].ID)
+
+	require.Len(t, run.Results, 2)
+	require.Equal(t, &Result{
+		RuleID:  string(diagnostics.CodeInvalidVariableValue),
+		Level:   "error",
+		Message: &Message{Text: "Unsupported argument: An argument named \"foo\" is not expected here."},
+		Locations: []*Location{{
+			PhysicalLocation: &PhysicalLocation{
+				ArtifactLocation: &ArtifactLocation{URI: "enos/enos-scenario.hcl"},
+				Region:           &Region{StartLine: 12, StartColumn: 5, EndLine: 12, EndColumn: 8},
+			},
+		}},
+	}, run.Results[0])
+	require.Equal(t, "warning", run.Results[1].Level)
+	require.Empty(t, run.Results[1].Locations)
+}
+
+// TestShowFormat tests that unformatted files are reported when checking.
+func TestShowFormat(t *testing.T) {
+	t.Parallel()
+
+	v, out := testView(t)
+	err := v.ShowFormat(&pb.FormatRequest_Config{Check: true}, &pb.FormatResponse{
+		Responses: []*pb.FormatResponse_Response{
+			{Path: "/tmp/enos.hcl", Changed: true},
+			{Path: "/tmp/enos-formatted.hcl"},
+		},
+	})
+	require.Error(t, err)
+
+	log := out()
+	require.Len(t, log.Runs[0].Results, 1)
+	require.Equal(t, "fmt", log.Runs[0].Results[0].RuleID)
+	require.Equal(t, "/tmp/enos.hcl", log.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+}
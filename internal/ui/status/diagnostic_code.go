@@ -0,0 +1,17 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package status
+
+import (
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+// ExplainDiagnosticCode returns the status response for explaining a diagnostic code.
+func ExplainDiagnosticCode(failOnWarn bool, res *pb.ExplainDiagnosticCodeResponse) error {
+	if HasFailed(failOnWarn, res) {
+		return Error("failed to explain diagnostic code")
+	}
+
+	return nil
+}
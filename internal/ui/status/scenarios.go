@@ -56,6 +56,15 @@ func ListScenarios(failOnWarn bool, res *pb.ListScenariosResponse) error {
 	return nil
 }
 
+// ListScenarioVariables returns the status response for a variables list.
+func ListScenarioVariables(failOnWarn bool, res *pb.ListScenarioVariablesResponse) error {
+	if HasFailed(failOnWarn, res, res.GetDecode()) {
+		return Error("failed to list variables")
+	}
+
+	return nil
+}
+
 // OutlineScenarios returns the status response for a scenario outline.
 func OutlineScenarios(failOnWarn bool, res *pb.OutlineScenariosResponse) error {
 	if HasFailed(failOnWarn, res, res.GetDecode()) {
@@ -64,3 +73,18 @@ func OutlineScenarios(failOnWarn bool, res *pb.OutlineScenariosResponse) error {
 
 	return nil
 }
+
+// CleanScenarios returns the status response for a scenario clean.
+func CleanScenarios(failOnWarn bool, res *pb.CleanScenariosResponse) error {
+	if HasFailed(failOnWarn, res, res.GetDecode()) {
+		return Error("failed to clean scenarios")
+	}
+
+	for _, mod := range res.GetModules() {
+		if HasFailed(failOnWarn, mod) {
+			return Error("failed to clean scenarios")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package status
+
+import pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+
+// ScenariosLint returns the status response for a flight plan lint.
+func ScenariosLint(failOnWarn bool, res *pb.LintScenariosResponse) error {
+	if HasFailed(failOnWarn, res, res.GetDecode()) {
+		return Error("scenario configuration has lint failures")
+	}
+
+	return nil
+}
@@ -0,0 +1,507 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tui
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+
+	"github.com/hashicorp/enos/internal/diagnostics"
+	"github.com/hashicorp/enos/internal/flightplan"
+	"github.com/hashicorp/enos/internal/operation/terraform/format"
+	"github.com/hashicorp/enos/internal/ui/basic"
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+const (
+	// maxTailLines is the maximum number of diagnostic and stderr lines we keep per operation.
+	maxTailLines = 500
+
+	escAltScreenOn  = "\x1b[?1049h"
+	escAltScreenOff = "\x1b[?1049l"
+	escCursorHide   = "\x1b[?25l"
+	escCursorShow   = "\x1b[?25h"
+	escClear        = "\x1b[H\x1b[2J"
+	escReverse      = "\x1b[7m"
+	escReset        = "\x1b[0m"
+)
+
+// View is an interactive full-screen terminal view. Operation events are shown as a live table of
+// operations which can be selected to tail their diagnostics and stderr. Everything else is
+// delegated to the basic view, which is also used to show the final operation responses.
+type View struct {
+	*basic.View
+	settings *pb.UI_Settings
+	stdin    *os.File
+	stdout   *os.File
+	now      func() time.Time
+
+	mu       sync.Mutex
+	ops      []*opState
+	opsByID  map[string]*opState
+	selected int
+	tail     bool
+	running  bool
+	restore  func()
+	stopC    chan struct{}
+	stopWg   sync.WaitGroup
+}
+
+// opState is the state of an operation as seen by the view.
+type opState struct {
+	id       string
+	name     string
+	status   pb.Operation_Status
+	phase    string
+	started  time.Time
+	finished time.Time
+	warnings int
+	lines    []string
+	last     *pb.Operation_Event
+}
+
+// Opt is a functional option.
+type Opt func(*View)
+
+// New takes options and returns a new tui.View.
+func New(opts ...Opt) (*View, error) {
+	v := &View{
+		stdin:   os.Stdin,
+		stdout:  os.Stdout,
+		now:     time.Now,
+		opsByID: map[string]*opState{},
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	basic, err := basic.New(basic.WithUISettings(v.settings))
+	if err != nil {
+		return nil, err
+	}
+	v.View = basic
+
+	return v, nil
+}
+
+// WithUISettings configures the view with the UI settings.
+func WithUISettings(settings *pb.UI_Settings) Opt {
+	return func(view *View) {
+		view.settings = settings
+	}
+}
+
+// IsSupported returns whether or not the full-screen view can be used with the UI settings. When
+// it cannot be used the basic view should be used instead.
+func IsSupported(settings *pb.UI_Settings) bool {
+	return settings.GetIsTty() &&
+		settings.GetStdoutPath() == "" &&
+		term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// Close restores the terminal and closes any open file handles.
+func (v *View) Close() error {
+	v.stop()
+
+	return v.View.Close()
+}
+
+// ShowOperationEvent updates the operation table with the event.
+func (v *View) ShowOperationEvent(event *pb.Operation_Event) {
+	if event == nil {
+		return
+	}
+
+	v.mu.Lock()
+	v.update(event)
+	v.mu.Unlock()
+
+	v.start()
+	v.draw()
+}
+
+// ShowOperationResponses leaves the full-screen view and shows the operation responses.
+func (v *View) ShowOperationResponses(res *pb.OperationResponses) error {
+	v.stop()
+
+	return v.View.ShowOperationResponses(res)
+}
+
+// update applies an operation event to the operation state.
+func (v *View) update(event *pb.Operation_Event) {
+	id := event.GetOp().GetId()
+	op, ok := v.opsByID[id]
+	if !ok {
+		scenario := flightplan.NewScenario()
+		scenario.FromRef(event.GetOp().GetScenario())
+		op = &opState{
+			id:      id,
+			name:    scenario.String(),
+			started: v.now(),
+		}
+		v.opsByID[id] = op
+		v.ops = append(v.ops, op)
+		slices.SortStableFunc(v.ops, func(a, b *opState) int {
+			return strings.Compare(a.name, b.name)
+		})
+	}
+
+	// The client periodically shows the last event of an operation again while it waits for
+	// more. Only apply the event the first time we see it.
+	if op.last == event {
+		return
+	}
+	op.last = event
+
+	if event.GetStatus() != pb.Operation_STATUS_UNSPECIFIED {
+		op.status = event.GetStatus()
+	}
+
+	switch op.status {
+	case pb.Operation_STATUS_COMPLETED,
+		pb.Operation_STATUS_COMPLETED_WARNING,
+		pb.Operation_STATUS_FAILED,
+		pb.Operation_STATUS_CANCELLED:
+		if op.finished.IsZero() {
+			op.finished = v.now()
+		}
+	case pb.Operation_STATUS_UNSPECIFIED,
+		pb.Operation_STATUS_UNKNOWN,
+		pb.Operation_STATUS_QUEUED,
+		pb.Operation_STATUS_WAITING,
+		pb.Operation_STATUS_RUNNING,
+		pb.Operation_STATUS_RUNNING_WARNING:
+	default:
+	}
+
+	if event.GetDone() {
+		return
+	}
+
+	diags := event.GetDiagnostics()
+	stderr := ""
+	switch val := event.GetValue().(type) {
+	case *pb.Operation_Event_Decode:
+		op.phase = "decode"
+		diags = append(diags, val.Decode.GetDiagnostics()...)
+	case *pb.Operation_Event_Generate:
+		op.phase = "generate"
+		diags = append(diags, val.Generate.GetDiagnostics()...)
+	case *pb.Operation_Event_Init:
+		op.phase = "init"
+		diags = append(diags, val.Init.GetDiagnostics()...)
+		stderr = val.Init.GetStderr()
+	case *pb.Operation_Event_Validate:
+		op.phase = "validate"
+		diags = append(diags, val.Validate.GetDiagnostics()...)
+	case *pb.Operation_Event_Plan:
+		op.phase = "plan"
+		diags = append(diags, val.Plan.GetDiagnostics()...)
+		stderr = val.Plan.GetStderr()
+	case *pb.Operation_Event_Apply:
+		op.phase = "apply"
+		diags = append(diags, val.Apply.GetDiagnostics()...)
+		stderr = val.Apply.GetStderr()
+	case *pb.Operation_Event_Destroy:
+		op.phase = "destroy"
+		diags = append(diags, val.Destroy.GetDiagnostics()...)
+		stderr = val.Destroy.GetStderr()
+	case *pb.Operation_Event_Exec:
+		op.phase = "exec"
+		diags = append(diags, val.Exec.GetDiagnostics()...)
+		stderr = val.Exec.GetStderr()
+	case *pb.Operation_Event_Output:
+		op.phase = "output"
+		diags = append(diags, val.Output.GetDiagnostics()...)
+	case *pb.Operation_Event_Show:
+		op.phase = "show"
+		diags = append(diags, val.Show.GetDiagnostics()...)
+	case *pb.Operation_Event_Progress:
+		op.append(format.Progress(val.Progress))
+	case *pb.Operation_Event_Hook:
+		op.phase = val.Hook.GetType() + " hook"
+		diags = append(diags, val.Hook.GetDiagnostics()...)
+		stderr = val.Hook.GetStderr()
+	default:
+	}
+
+	for _, diag := range diags {
+		if diag.GetSeverity() == pb.Diagnostic_SEVERITY_WARNING {
+			op.warnings++
+		}
+		op.append(diagnostics.String(diag, diagnostics.WithStringUISettings(v.settings)))
+	}
+	op.append(stderr)
+}
+
+// append appends text to the operations tail.
+func (o *opState) append(text string) {
+	text = strings.TrimRight(text, "\n")
+	if text == "" {
+		return
+	}
+
+	o.lines = append(o.lines, strings.Split(text, "\n")...)
+	if len(o.lines) > maxTailLines {
+		o.lines = o.lines[len(o.lines)-maxTailLines:]
+	}
+}
+
+// elapsed returns how long the operation has been running.
+func (o *opState) elapsed(now time.Time) time.Duration {
+	end := now
+	if !o.finished.IsZero() {
+		end = o.finished
+	}
+
+	return end.Sub(o.started).Round(time.Second)
+}
+
+// start enters the full-screen view and starts handling input and redrawing the view.
+func (v *View) start() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.running {
+		return
+	}
+	v.running = true
+	v.stopC = make(chan struct{})
+
+	fmt.Fprint(v.stdout, escAltScreenOn+escCursorHide)
+
+	v.restore = func() {}
+	if fd := int(v.stdin.Fd()); term.IsTerminal(fd) {
+		if state, err := term.MakeRaw(fd); err == nil {
+			v.restore = func() {
+				_ = term.Restore(fd, state)
+			}
+
+			go v.readInput()
+		}
+	}
+
+	// Redraw on an interval so that elapsed times stay current.
+	v.stopWg.Add(1)
+	go func() {
+		defer v.stopWg.Done()
+
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-v.stopC:
+				return
+			case <-ticker.C:
+				v.draw()
+			}
+		}
+	}()
+}
+
+// stop leaves the full-screen view and restores the terminal.
+func (v *View) stop() {
+	v.mu.Lock()
+	if !v.running {
+		v.mu.Unlock()
+
+		return
+	}
+	v.running = false
+	close(v.stopC)
+	v.restore()
+	fmt.Fprint(v.stdout, escCursorShow+escAltScreenOff)
+	v.mu.Unlock()
+
+	v.stopWg.Wait()
+}
+
+// readInput handles key presses until the view is stopped. As the terminal is in raw mode we're
+// responsible for turning ctrl-c into an interrupt.
+func (v *View) readInput() {
+	buf := make([]byte, 16)
+	for {
+		n, err := v.stdin.Read(buf)
+		if err != nil {
+			return
+		}
+
+		v.mu.Lock()
+		if !v.running {
+			v.mu.Unlock()
+
+			return
+		}
+		interrupt := v.handleKey(string(buf[:n]))
+		v.mu.Unlock()
+
+		if interrupt {
+			if p, err := os.FindProcess(os.Getpid()); err == nil {
+				_ = p.Signal(os.Interrupt)
+			}
+		}
+
+		v.draw()
+	}
+}
+
+// handleKey updates the view state for the key and returns whether or not we should interrupt.
+func (v *View) handleKey(key string) bool {
+	switch key {
+	case "\x03":
+		return true
+	case "\x1b[A", "k":
+		if v.selected > 0 {
+			v.selected--
+		}
+	case "\x1b[B", "j":
+		if v.selected < len(v.ops)-1 {
+			v.selected++
+		}
+	case "\r", "\n", "t":
+		v.tail = !v.tail
+	case "\x1b", "q":
+		v.tail = false
+	default:
+	}
+
+	return false
+}
+
+// draw redraws the full-screen view.
+func (v *View) draw() {
+	width, height, err := term.GetSize(int(v.stdout.Fd()))
+	if err != nil {
+		width, height = int(v.settings.GetWidth()), 24
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if !v.running {
+		return
+	}
+
+	fmt.Fprint(v.stdout, escClear+strings.ReplaceAll(v.render(width, height), "\n", "\r\n"))
+}
+
+// render returns the view of the operations for the given terminal size.
+func (v *View) render(width int, height int) string {
+	if width < 40 {
+		width = 40
+	}
+	if height < 10 {
+		height = 10
+	}
+
+	now := v.now()
+	counts := map[pb.Operation_Status]int{}
+	for _, op := range v.ops {
+		counts[op.status]++
+	}
+
+	out := &strings.Builder{}
+	fmt.Fprintf(out, "enos: %d operations, %d running, %d completed, %d failed\n",
+		len(v.ops),
+		counts[pb.Operation_STATUS_RUNNING]+counts[pb.Operation_STATUS_RUNNING_WARNING],
+		counts[pb.Operation_STATUS_COMPLETED]+counts[pb.Operation_STATUS_COMPLETED_WARNING],
+		counts[pb.Operation_STATUS_FAILED]+counts[pb.Operation_STATUS_CANCELLED],
+	)
+	out.WriteString(truncate("↑/↓ select, enter tail, esc close, ctrl-c cancel", width) + "\n\n")
+
+	const (
+		statusWidth   = 10
+		phaseWidth    = 9
+		elapsedWidth  = 9
+		warningsWidth = 8
+	)
+	nameWidth := max(width-statusWidth-phaseWidth-elapsedWidth-warningsWidth-6, 10)
+	row := func(name, status, phase, elapsed, warnings string) string {
+		return fmt.Sprintf("  %-*s %-*s %-*s %-*s %*s",
+			nameWidth, truncate(name, nameWidth),
+			statusWidth, status,
+			phaseWidth, phase,
+			elapsedWidth, elapsed,
+			warningsWidth, warnings,
+		)
+	}
+	out.WriteString(row("SCENARIO", "STATUS", "PHASE", "ELAPSED", "WARNINGS") + "\n")
+
+	// Leave room for the tail if it's open
+	tableHeight := height - 5
+	if v.tail {
+		tableHeight = max((height-5)/3, 3)
+	}
+
+	first := 0
+	if v.selected >= tableHeight {
+		first = v.selected - tableHeight + 1
+	}
+	for i := first; i < len(v.ops) && i < first+tableHeight; i++ {
+		op := v.ops[i]
+		line := row(op.name, statusString(op.status), op.phase, op.elapsed(now).String(), fmt.Sprint(op.warnings))
+		if i == v.selected {
+			line = escReverse + ">" + line[1:] + escReset
+		}
+		out.WriteString(line + "\n")
+	}
+
+	if !v.tail || len(v.ops) == 0 {
+		return out.String()
+	}
+
+	op := v.ops[v.selected]
+	out.WriteString("\n" + truncate("── "+op.name+" "+strings.Repeat("─", width), width) + "\n")
+	tailHeight := height - 5 - tableHeight - 2
+	lines := op.lines
+	if len(lines) > tailHeight {
+		lines = lines[len(lines)-tailHeight:]
+	}
+	for _, line := range lines {
+		out.WriteString(truncate(line, width) + "\n")
+	}
+
+	return out.String()
+}
+
+func statusString(status pb.Operation_Status) string {
+	switch status {
+	case pb.Operation_STATUS_QUEUED:
+		return "queued"
+	case pb.Operation_STATUS_WAITING:
+		return "waiting"
+	case pb.Operation_STATUS_RUNNING, pb.Operation_STATUS_RUNNING_WARNING:
+		return "running"
+	case pb.Operation_STATUS_COMPLETED:
+		return "completed"
+	case pb.Operation_STATUS_COMPLETED_WARNING:
+		return "warning"
+	case pb.Operation_STATUS_FAILED:
+		return "failed"
+	case pb.Operation_STATUS_CANCELLED:
+		return "cancelled"
+	case pb.Operation_STATUS_UNSPECIFIED, pb.Operation_STATUS_UNKNOWN:
+		return "unknown"
+	default:
+		return "unknown"
+	}
+}
+
+// truncate truncates the string to the width.
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+
+	return string(runes[:width-1]) + "…"
+}
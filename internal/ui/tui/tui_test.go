@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
+)
+
+func testOpEvent(id string, name string, status pb.Operation_Status) *pb.Operation_Event {
+	return &pb.Operation_Event{
+		Op: &pb.Ref_Operation{
+			Id: id,
+			Scenario: &pb.Ref_Scenario{
+				Id: &pb.Scenario_ID{Name: name},
+			},
+		},
+		Status: status,
+	}
+}
+
+// TestRender tests rendering the operations table and tailing an operation.
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	v, err := New(WithUISettings(&pb.UI_Settings{Width: 100}))
+	require.NoError(t, err)
+	v.now = func() time.Time { return now }
+
+	apply := testOpEvent("2", "upgrade", pb.Operation_STATUS_RUNNING_WARNING)
+	apply.Value = &pb.Operation_Event_Apply{Apply: &pb.Terraform_Command_Apply_Response{
+		Stderr: "something went sideways",
+		Diagnostics: []*pb.Diagnostic{{
+			Severity: pb.Diagnostic_SEVERITY_WARNING,
+			Summary:  "deprecated attribute",
+		}},
+	}}
+
+	v.update(testOpEvent("1", "smoke", pb.Operation_STATUS_RUNNING))
+	v.update(apply)
+	v.update(apply) // Repeated events should be ignored
+	now = now.Add(90 * time.Second)
+	v.update(testOpEvent("1", "smoke", pb.Operation_STATUS_COMPLETED))
+	now = now.Add(30 * time.Second)
+
+	out := v.render(100, 20)
+	require.Contains(t, out, "2 operations, 1 running, 1 completed, 0 failed")
+	lines := strings.Split(out, "\n")
+	require.Regexp(t, `smoke\s+completed\s+1m30s\s+0`, lines[4])
+	require.Regexp(t, `upgrade\s+running\s+apply\s+2m0s\s+1`, lines[5])
+	require.NotContains(t, out, "something went sideways")
+
+	require.False(t, v.handleKey("j"))
+	require.False(t, v.handleKey("\r"))
+	out = v.render(100, 20)
+	require.Contains(t, out, "── upgrade")
+	require.Contains(t, out, "deprecated attribute")
+	require.Equal(t, 1, strings.Count(out, "something went sideways"))
+
+	require.False(t, v.handleKey("\x1b"))
+	require.NotContains(t, v.render(100, 20), "something went sideways")
+	require.True(t, v.handleKey("\x03"))
+}
+
+// TestShowOperationResponses tests that the final responses are shown when the view was never
+// started.
+func TestShowOperationResponses(t *testing.T) {
+	t.Parallel()
+
+	v, err := New(WithUISettings(&pb.UI_Settings{}))
+	require.NoError(t, err)
+	require.NoError(t, v.ShowOperationResponses(&pb.OperationResponses{}))
+	require.NoError(t, v.Close())
+}
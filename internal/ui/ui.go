@@ -10,12 +10,18 @@ import (
 	"github.com/hashicorp/enos/internal/ui/basic"
 	"github.com/hashicorp/enos/internal/ui/html"
 	"github.com/hashicorp/enos/internal/ui/machine"
+	"github.com/hashicorp/enos/internal/ui/markdown"
+	"github.com/hashicorp/enos/internal/ui/sarif"
+	"github.com/hashicorp/enos/internal/ui/tui"
 	pb "github.com/hashicorp/enos/pb/hashicorp/enos/v1"
 )
 
 var (
 	_ View = (*basic.View)(nil)
 	_ View = (*machine.View)(nil)
+	_ View = (*markdown.View)(nil)
+	_ View = (*sarif.View)(nil)
+	_ View = (*tui.View)(nil)
 )
 
 // View is a UI view. ShowX() methods are responsible for taking a command output
@@ -28,13 +34,18 @@ type View interface {
 	Settings() *pb.UI_Settings
 	ShowError(err error) error
 	ShowDiagnostics(diags []*pb.Diagnostic) error
+	ShowDiagnosticCodeExplanation(res *pb.ExplainDiagnosticCodeResponse) error
 	ShowVersion(all bool, res *pb.GetVersionResponse) error
 	ShowFormat(cfg *pb.FormatRequest_Config, res *pb.FormatResponse) error
 	ShowScenarioList(res *pb.ListScenariosResponse) error
 	ShowScenarioOutline(res *pb.OutlineScenariosResponse) error
+	ShowScenarioClean(res *pb.CleanScenariosResponse) error
+	ShowScenarioStatus(res *pb.OperationResponses) error
 	ShowDecode(res *pb.DecodeResponse, incremental bool) error
 	ShowOutput(res *pb.OperationResponses) error
 	ShowScenariosValidateConfig(res *pb.ValidateScenariosConfigurationResponse) error
+	ShowScenarioLint(res *pb.LintScenariosResponse) error
+	ShowScenarioVariables(res *pb.ListScenarioVariablesResponse) error
 	ShowOperationEvent(res *pb.Operation_Event)
 	ShowOperationResponse(res *pb.Operation_Response) error
 	ShowOperationResponses(res *pb.OperationResponses) error
@@ -51,6 +62,17 @@ func New(s *pb.UI_Settings) (View, error) {
 		return basic.New(basic.WithUISettings(s))
 	case pb.UI_Settings_FORMAT_HTML:
 		return html.New(html.WithUISettings(s))
+	case pb.UI_Settings_FORMAT_MARKDOWN:
+		return markdown.New(markdown.WithUISettings(s))
+	case pb.UI_Settings_FORMAT_SARIF:
+		return sarif.New(sarif.WithUISettings(s))
+	case pb.UI_Settings_FORMAT_TUI:
+		// Fall back to the basic view if we're not writing to a terminal
+		if !tui.IsSupported(s) {
+			return basic.New(basic.WithUISettings(s))
+		}
+
+		return tui.New(tui.WithUISettings(s))
 	case pb.UI_Settings_FORMAT_UNSPECIFIED:
 		return basic.New(basic.WithUISettings(s))
 	default:
@@ -33,6 +33,9 @@ const (
 	UI_Settings_FORMAT_BASIC_TEXT  UI_Settings_Format = 1
 	UI_Settings_FORMAT_JSON        UI_Settings_Format = 2
 	UI_Settings_FORMAT_HTML        UI_Settings_Format = 3
+	UI_Settings_FORMAT_TUI         UI_Settings_Format = 4
+	UI_Settings_FORMAT_MARKDOWN    UI_Settings_Format = 5
+	UI_Settings_FORMAT_SARIF       UI_Settings_Format = 6
 )
 
 // Enum value maps for UI_Settings_Format.
@@ -42,12 +45,18 @@ var (
 		1: "FORMAT_BASIC_TEXT",
 		2: "FORMAT_JSON",
 		3: "FORMAT_HTML",
+		4: "FORMAT_TUI",
+		5: "FORMAT_MARKDOWN",
+		6: "FORMAT_SARIF",
 	}
 	UI_Settings_Format_value = map[string]int32{
 		"FORMAT_UNSPECIFIED": 0,
 		"FORMAT_BASIC_TEXT":  1,
 		"FORMAT_JSON":        2,
 		"FORMAT_HTML":        3,
+		"FORMAT_TUI":         4,
+		"FORMAT_MARKDOWN":    5,
+		"FORMAT_SARIF":       6,
 	}
 )
 
@@ -255,7 +264,166 @@ func (x Operation_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Operation_Status.Descriptor instead.
 func (Operation_Status) EnumDescriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 0}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type Operation_FailurePolicy_Type int32
+
+const (
+	Operation_FailurePolicy_TYPE_UNSPECIFIED Operation_FailurePolicy_Type = 0
+	Operation_FailurePolicy_TYPE_KEEP        Operation_FailurePolicy_Type = 1
+	Operation_FailurePolicy_TYPE_DESTROY     Operation_FailurePolicy_Type = 2
+	Operation_FailurePolicy_TYPE_KEEP_FOR    Operation_FailurePolicy_Type = 3
+)
+
+// Enum value maps for Operation_FailurePolicy_Type.
+var (
+	Operation_FailurePolicy_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_KEEP",
+		2: "TYPE_DESTROY",
+		3: "TYPE_KEEP_FOR",
+	}
+	Operation_FailurePolicy_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_KEEP":        1,
+		"TYPE_DESTROY":     2,
+		"TYPE_KEEP_FOR":    3,
+	}
+)
+
+func (x Operation_FailurePolicy_Type) Enum() *Operation_FailurePolicy_Type {
+	p := new(Operation_FailurePolicy_Type)
+	*p = x
+	return p
+}
+
+func (x Operation_FailurePolicy_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Operation_FailurePolicy_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_hashicorp_enos_v1_enos_proto_enumTypes[4].Descriptor()
+}
+
+func (Operation_FailurePolicy_Type) Type() protoreflect.EnumType {
+	return &file_hashicorp_enos_v1_enos_proto_enumTypes[4]
+}
+
+func (x Operation_FailurePolicy_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Operation_FailurePolicy_Type.Descriptor instead.
+func (Operation_FailurePolicy_Type) EnumDescriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 0, 0}
+}
+
+type Operation_Response_FailurePolicy_Outcome int32
+
+const (
+	Operation_Response_FailurePolicy_OUTCOME_UNSPECIFIED    Operation_Response_FailurePolicy_Outcome = 0
+	Operation_Response_FailurePolicy_OUTCOME_KEPT           Operation_Response_FailurePolicy_Outcome = 1
+	Operation_Response_FailurePolicy_OUTCOME_DESTROYED      Operation_Response_FailurePolicy_Outcome = 2
+	Operation_Response_FailurePolicy_OUTCOME_DESTROY_FAILED Operation_Response_FailurePolicy_Outcome = 3
+)
+
+// Enum value maps for Operation_Response_FailurePolicy_Outcome.
+var (
+	Operation_Response_FailurePolicy_Outcome_name = map[int32]string{
+		0: "OUTCOME_UNSPECIFIED",
+		1: "OUTCOME_KEPT",
+		2: "OUTCOME_DESTROYED",
+		3: "OUTCOME_DESTROY_FAILED",
+	}
+	Operation_Response_FailurePolicy_Outcome_value = map[string]int32{
+		"OUTCOME_UNSPECIFIED":    0,
+		"OUTCOME_KEPT":           1,
+		"OUTCOME_DESTROYED":      2,
+		"OUTCOME_DESTROY_FAILED": 3,
+	}
+)
+
+func (x Operation_Response_FailurePolicy_Outcome) Enum() *Operation_Response_FailurePolicy_Outcome {
+	p := new(Operation_Response_FailurePolicy_Outcome)
+	*p = x
+	return p
+}
+
+func (x Operation_Response_FailurePolicy_Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Operation_Response_FailurePolicy_Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_hashicorp_enos_v1_enos_proto_enumTypes[5].Descriptor()
+}
+
+func (Operation_Response_FailurePolicy_Outcome) Type() protoreflect.EnumType {
+	return &file_hashicorp_enos_v1_enos_proto_enumTypes[5]
+}
+
+func (x Operation_Response_FailurePolicy_Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Operation_Response_FailurePolicy_Outcome.Descriptor instead.
+func (Operation_Response_FailurePolicy_Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 5, 0}
+}
+
+type Terraform_Command_Progress_Type int32
+
+const (
+	Terraform_Command_Progress_TYPE_UNSPECIFIED    Terraform_Command_Progress_Type = 0
+	Terraform_Command_Progress_TYPE_APPLY_START    Terraform_Command_Progress_Type = 1
+	Terraform_Command_Progress_TYPE_APPLY_PROGRESS Terraform_Command_Progress_Type = 2
+	Terraform_Command_Progress_TYPE_APPLY_COMPLETE Terraform_Command_Progress_Type = 3
+	Terraform_Command_Progress_TYPE_APPLY_ERRORED  Terraform_Command_Progress_Type = 4
+)
+
+// Enum value maps for Terraform_Command_Progress_Type.
+var (
+	Terraform_Command_Progress_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_APPLY_START",
+		2: "TYPE_APPLY_PROGRESS",
+		3: "TYPE_APPLY_COMPLETE",
+		4: "TYPE_APPLY_ERRORED",
+	}
+	Terraform_Command_Progress_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":    0,
+		"TYPE_APPLY_START":    1,
+		"TYPE_APPLY_PROGRESS": 2,
+		"TYPE_APPLY_COMPLETE": 3,
+		"TYPE_APPLY_ERRORED":  4,
+	}
+)
+
+func (x Terraform_Command_Progress_Type) Enum() *Terraform_Command_Progress_Type {
+	p := new(Terraform_Command_Progress_Type)
+	*p = x
+	return p
+}
+
+func (x Terraform_Command_Progress_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Terraform_Command_Progress_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_hashicorp_enos_v1_enos_proto_enumTypes[6].Descriptor()
+}
+
+func (Terraform_Command_Progress_Type) Type() protoreflect.EnumType {
+	return &file_hashicorp_enos_v1_enos_proto_enumTypes[6]
+}
+
+func (x Terraform_Command_Progress_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Terraform_Command_Progress_Type.Descriptor instead.
+func (Terraform_Command_Progress_Type) EnumDescriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 5, 0}
 }
 
 type Matrix_Exclude_Mode int32
@@ -299,11 +467,11 @@ func (x Matrix_Exclude_Mode) String() string {
 }
 
 func (Matrix_Exclude_Mode) Descriptor() protoreflect.EnumDescriptor {
-	return file_hashicorp_enos_v1_enos_proto_enumTypes[4].Descriptor()
+	return file_hashicorp_enos_v1_enos_proto_enumTypes[7].Descriptor()
 }
 
 func (Matrix_Exclude_Mode) Type() protoreflect.EnumType {
-	return &file_hashicorp_enos_v1_enos_proto_enumTypes[4]
+	return &file_hashicorp_enos_v1_enos_proto_enumTypes[7]
 }
 
 func (x Matrix_Exclude_Mode) Number() protoreflect.EnumNumber {
@@ -312,7 +480,123 @@ func (x Matrix_Exclude_Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Matrix_Exclude_Mode.Descriptor instead.
 func (Matrix_Exclude_Mode) EnumDescriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 2, 0}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11, 2, 0}
+}
+
+type Variable_Source int32
+
+const (
+	Variable_SOURCE_UNSPECIFIED Variable_Source = 0
+	Variable_SOURCE_DEFAULT     Variable_Source = 1
+	Variable_SOURCE_VARS_FILE   Variable_Source = 2
+	Variable_SOURCE_ENV_VAR     Variable_Source = 3
+	// SOURCE_UNSET is a declared variable without a value or default.
+	Variable_SOURCE_UNSET Variable_Source = 4
+	// SOURCE_UNDECLARED is a value that has been set for a variable that is not declared.
+	Variable_SOURCE_UNDECLARED Variable_Source = 5
+)
+
+// Enum value maps for Variable_Source.
+var (
+	Variable_Source_name = map[int32]string{
+		0: "SOURCE_UNSPECIFIED",
+		1: "SOURCE_DEFAULT",
+		2: "SOURCE_VARS_FILE",
+		3: "SOURCE_ENV_VAR",
+		4: "SOURCE_UNSET",
+		5: "SOURCE_UNDECLARED",
+	}
+	Variable_Source_value = map[string]int32{
+		"SOURCE_UNSPECIFIED": 0,
+		"SOURCE_DEFAULT":     1,
+		"SOURCE_VARS_FILE":   2,
+		"SOURCE_ENV_VAR":     3,
+		"SOURCE_UNSET":       4,
+		"SOURCE_UNDECLARED":  5,
+	}
+)
+
+func (x Variable_Source) Enum() *Variable_Source {
+	p := new(Variable_Source)
+	*p = x
+	return p
+}
+
+func (x Variable_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Variable_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_hashicorp_enos_v1_enos_proto_enumTypes[8].Descriptor()
+}
+
+func (Variable_Source) Type() protoreflect.EnumType {
+	return &file_hashicorp_enos_v1_enos_proto_enumTypes[8]
+}
+
+func (x Variable_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Variable_Source.Descriptor instead.
+func (Variable_Source) EnumDescriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type CleanScenariosResponse_Module_State int32
+
+const (
+	CleanScenariosResponse_Module_STATE_UNSPECIFIED CleanScenariosResponse_Module_State = 0
+	// STATE_EMPTY means there is no state or the state has no resources
+	CleanScenariosResponse_Module_STATE_EMPTY CleanScenariosResponse_Module_State = 1
+	// STATE_NOT_EMPTY means the local state has resources
+	CleanScenariosResponse_Module_STATE_NOT_EMPTY CleanScenariosResponse_Module_State = 2
+	// STATE_UNKNOWN means the module uses a remote backend and the state
+	// cannot be determined without Terraform.
+	CleanScenariosResponse_Module_STATE_UNKNOWN CleanScenariosResponse_Module_State = 3
+)
+
+// Enum value maps for CleanScenariosResponse_Module_State.
+var (
+	CleanScenariosResponse_Module_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_EMPTY",
+		2: "STATE_NOT_EMPTY",
+		3: "STATE_UNKNOWN",
+	}
+	CleanScenariosResponse_Module_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"STATE_EMPTY":       1,
+		"STATE_NOT_EMPTY":   2,
+		"STATE_UNKNOWN":     3,
+	}
+)
+
+func (x CleanScenariosResponse_Module_State) Enum() *CleanScenariosResponse_Module_State {
+	p := new(CleanScenariosResponse_Module_State)
+	*p = x
+	return p
+}
+
+func (x CleanScenariosResponse_Module_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CleanScenariosResponse_Module_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_hashicorp_enos_v1_enos_proto_enumTypes[9].Descriptor()
+}
+
+func (CleanScenariosResponse_Module_State) Type() protoreflect.EnumType {
+	return &file_hashicorp_enos_v1_enos_proto_enumTypes[9]
+}
+
+func (x CleanScenariosResponse_Module_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CleanScenariosResponse_Module_State.Descriptor instead.
+func (CleanScenariosResponse_Module_State) EnumDescriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{64, 0, 0}
 }
 
 // UI contains messages related to the UI calling the server. This information
@@ -369,6 +653,7 @@ type Diagnostic struct {
 	Detail   string              `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
 	Range    *Range              `protobuf:"bytes,4,opt,name=range,proto3" json:"range,omitempty"`
 	Snippet  *Diagnostic_Snippet `protobuf:"bytes,5,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Code     string              `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"`
 }
 
 func (x *Diagnostic) Reset() {
@@ -438,6 +723,84 @@ func (x *Diagnostic) GetSnippet() *Diagnostic_Snippet {
 	return nil
 }
 
+func (x *Diagnostic) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DiagnosticCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code        string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Summary     string `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Remediation string `protobuf:"bytes,3,opt,name=remediation,proto3" json:"remediation,omitempty"`
+	DocUrl      string `protobuf:"bytes,4,opt,name=doc_url,proto3" json:"doc_url,omitempty"`
+}
+
+func (x *DiagnosticCode) Reset() {
+	*x = DiagnosticCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticCode) ProtoMessage() {}
+
+func (x *DiagnosticCode) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticCode.ProtoReflect.Descriptor instead.
+func (*DiagnosticCode) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DiagnosticCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DiagnosticCode) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *DiagnosticCode) GetRemediation() string {
+	if x != nil {
+		return x.Remediation
+	}
+	return ""
+}
+
+func (x *DiagnosticCode) GetDocUrl() string {
+	if x != nil {
+		return x.DocUrl
+	}
+	return ""
+}
+
 type Range struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -451,7 +814,7 @@ type Range struct {
 func (x *Range) Reset() {
 	*x = Range{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[2]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -464,7 +827,7 @@ func (x *Range) String() string {
 func (*Range) ProtoMessage() {}
 
 func (x *Range) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[2]
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,7 +840,7 @@ func (x *Range) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Range.ProtoReflect.Descriptor instead.
 func (*Range) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{2}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Range) GetFilename() string {
@@ -525,7 +888,7 @@ type Workspace struct {
 func (x *Workspace) Reset() {
 	*x = Workspace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[3]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -538,7 +901,7 @@ func (x *Workspace) String() string {
 func (*Workspace) ProtoMessage() {}
 
 func (x *Workspace) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[3]
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,7 +914,7 @@ func (x *Workspace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Workspace.ProtoReflect.Descriptor instead.
 func (*Workspace) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{3}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Workspace) GetFlightplan() *FlightPlan {
@@ -597,7 +960,7 @@ type FlightPlan struct {
 func (x *FlightPlan) Reset() {
 	*x = FlightPlan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[4]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -610,7 +973,7 @@ func (x *FlightPlan) String() string {
 func (*FlightPlan) ProtoMessage() {}
 
 func (x *FlightPlan) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[4]
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +986,7 @@ func (x *FlightPlan) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlightPlan.ProtoReflect.Descriptor instead.
 func (*FlightPlan) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{4}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FlightPlan) GetBaseDir() string {
@@ -666,7 +1029,7 @@ type DecodeResponse struct {
 func (x *DecodeResponse) Reset() {
 	*x = DecodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[5]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -679,7 +1042,7 @@ func (x *DecodeResponse) String() string {
 func (*DecodeResponse) ProtoMessage() {}
 
 func (x *DecodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[5]
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,7 +1055,7 @@ func (x *DecodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecodeResponse.ProtoReflect.Descriptor instead.
 func (*DecodeResponse) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{5}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DecodeResponse) GetDiagnostics() []*Diagnostic {
@@ -719,7 +1082,7 @@ type Scenario struct {
 func (x *Scenario) Reset() {
 	*x = Scenario{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[6]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -732,7 +1095,7 @@ func (x *Scenario) String() string {
 func (*Scenario) ProtoMessage() {}
 
 func (x *Scenario) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[6]
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +1108,7 @@ func (x *Scenario) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Scenario.ProtoReflect.Descriptor instead.
 func (*Scenario) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{6}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{7}
 }
 
 // Operator is our operation operator
@@ -760,7 +1123,7 @@ type Operator struct {
 func (x *Operator) Reset() {
 	*x = Operator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[7]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -773,7 +1136,7 @@ func (x *Operator) String() string {
 func (*Operator) ProtoMessage() {}
 
 func (x *Operator) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[7]
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1149,7 @@ func (x *Operator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Operator.ProtoReflect.Descriptor instead.
 func (*Operator) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{7}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Operator) GetConfig() *Operator_Config {
@@ -806,7 +1169,7 @@ type Operation struct {
 func (x *Operation) Reset() {
 	*x = Operation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[8]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -819,7 +1182,7 @@ func (x *Operation) String() string {
 func (*Operation) ProtoMessage() {}
 
 func (x *Operation) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[8]
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,7 +1195,7 @@ func (x *Operation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Operation.ProtoReflect.Descriptor instead.
 func (*Operation) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9}
 }
 
 type Terraform struct {
@@ -844,7 +1207,7 @@ type Terraform struct {
 func (x *Terraform) Reset() {
 	*x = Terraform{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[9]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -857,7 +1220,7 @@ func (x *Terraform) String() string {
 func (*Terraform) ProtoMessage() {}
 
 func (x *Terraform) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[9]
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,7 +1233,7 @@ func (x *Terraform) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Terraform.ProtoReflect.Descriptor instead.
 func (*Terraform) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10}
 }
 
 // Matrix represents our DSL matrix
@@ -887,7 +1250,7 @@ type Matrix struct {
 func (x *Matrix) Reset() {
 	*x = Matrix{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[10]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -900,7 +1263,7 @@ func (x *Matrix) String() string {
 func (*Matrix) ProtoMessage() {}
 
 func (x *Matrix) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[10]
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,7 +1276,7 @@ func (x *Matrix) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Matrix.ProtoReflect.Descriptor instead.
 func (*Matrix) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Matrix) GetVectors() []*Matrix_Vector {
@@ -951,7 +1314,7 @@ type Sample struct {
 func (x *Sample) Reset() {
 	*x = Sample{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[11]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -964,7 +1327,7 @@ func (x *Sample) String() string {
 func (*Sample) ProtoMessage() {}
 
 func (x *Sample) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[11]
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -977,7 +1340,7 @@ func (x *Sample) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sample.ProtoReflect.Descriptor instead.
 func (*Sample) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Sample) GetId() *Sample_ID {
@@ -1010,7 +1373,7 @@ type Ref struct {
 func (x *Ref) Reset() {
 	*x = Ref{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[12]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1023,7 +1386,7 @@ func (x *Ref) String() string {
 func (*Ref) ProtoMessage() {}
 
 func (x *Ref) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[12]
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,59 +1399,20 @@ func (x *Ref) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ref.ProtoReflect.Descriptor instead.
 func (*Ref) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12}
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{13}
 }
 
-type GetVersionRequest struct {
+type LintScenariosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Workspace *Workspace   `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Config    *Lint_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *GetVersionRequest) Reset() {
-	*x = GetVersionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetVersionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetVersionRequest) ProtoMessage() {}
-
-func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
-func (*GetVersionRequest) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{13}
-}
-
-type GetVersionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Version     string        `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	GitSha      string        `protobuf:"bytes,3,opt,name=git_sha,proto3" json:"git_sha,omitempty"`
-}
-
-func (x *GetVersionResponse) Reset() {
-	*x = GetVersionResponse{}
+func (x *LintScenariosRequest) Reset() {
+	*x = LintScenariosRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1096,13 +1420,13 @@ func (x *GetVersionResponse) Reset() {
 	}
 }
 
-func (x *GetVersionResponse) String() string {
+func (x *LintScenariosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVersionResponse) ProtoMessage() {}
+func (*LintScenariosRequest) ProtoMessage() {}
 
-func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
+func (x *LintScenariosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1114,46 +1438,36 @@ func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
-func (*GetVersionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LintScenariosRequest.ProtoReflect.Descriptor instead.
+func (*LintScenariosRequest) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetVersionResponse) GetDiagnostics() []*Diagnostic {
+func (x *LintScenariosRequest) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.Diagnostics
+		return x.Workspace
 	}
 	return nil
 }
 
-func (x *GetVersionResponse) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *GetVersionResponse) GetGitSha() string {
+func (x *LintScenariosRequest) GetConfig() *Lint_Config {
 	if x != nil {
-		return x.GitSha
+		return x.Config
 	}
-	return ""
+	return nil
 }
 
-type ValidateScenariosConfigurationRequest struct {
+type LintScenariosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Workspace           *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
-	Filter              *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	SampleFilter        *Sample_Filter   `protobuf:"bytes,3,opt,name=sample_filter,json=sampleFilter,proto3" json:"sample_filter,omitempty"`
-	NoValidateScenarios bool             `protobuf:"varint,4,opt,name=no_validate_scenarios,json=noValidateScenarios,proto3" json:"no_validate_scenarios,omitempty"`
-	NoValidateSamples   bool             `protobuf:"varint,5,opt,name=no_validate_samples,json=noValidateSamples,proto3" json:"no_validate_samples,omitempty"`
+	Diagnostics []*Diagnostic   `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
 }
 
-func (x *ValidateScenariosConfigurationRequest) Reset() {
-	*x = ValidateScenariosConfigurationRequest{}
+func (x *LintScenariosResponse) Reset() {
+	*x = LintScenariosResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1161,13 +1475,13 @@ func (x *ValidateScenariosConfigurationRequest) Reset() {
 	}
 }
 
-func (x *ValidateScenariosConfigurationRequest) String() string {
+func (x *LintScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateScenariosConfigurationRequest) ProtoMessage() {}
+func (*LintScenariosResponse) ProtoMessage() {}
 
-func (x *ValidateScenariosConfigurationRequest) ProtoReflect() protoreflect.Message {
+func (x *LintScenariosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1179,58 +1493,33 @@ func (x *ValidateScenariosConfigurationRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateScenariosConfigurationRequest.ProtoReflect.Descriptor instead.
-func (*ValidateScenariosConfigurationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LintScenariosResponse.ProtoReflect.Descriptor instead.
+func (*LintScenariosResponse) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ValidateScenariosConfigurationRequest) GetWorkspace() *Workspace {
-	if x != nil {
-		return x.Workspace
-	}
-	return nil
-}
-
-func (x *ValidateScenariosConfigurationRequest) GetFilter() *Scenario_Filter {
+func (x *LintScenariosResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Filter
+		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *ValidateScenariosConfigurationRequest) GetSampleFilter() *Sample_Filter {
+func (x *LintScenariosResponse) GetDecode() *DecodeResponse {
 	if x != nil {
-		return x.SampleFilter
+		return x.Decode
 	}
 	return nil
 }
 
-func (x *ValidateScenariosConfigurationRequest) GetNoValidateScenarios() bool {
-	if x != nil {
-		return x.NoValidateScenarios
-	}
-	return false
-}
-
-func (x *ValidateScenariosConfigurationRequest) GetNoValidateSamples() bool {
-	if x != nil {
-		return x.NoValidateSamples
-	}
-	return false
-}
-
-type ValidateScenariosConfigurationResponse struct {
+type Lint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Diagnostics  []*Diagnostic   `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Decode       *DecodeResponse `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
-	SampleDecode *DecodeResponse `protobuf:"bytes,3,opt,name=sample_decode,json=sampleDecode,proto3" json:"sample_decode,omitempty"`
 }
 
-func (x *ValidateScenariosConfigurationResponse) Reset() {
-	*x = ValidateScenariosConfigurationResponse{}
+func (x *Lint) Reset() {
+	*x = Lint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1238,13 +1527,13 @@ func (x *ValidateScenariosConfigurationResponse) Reset() {
 	}
 }
 
-func (x *ValidateScenariosConfigurationResponse) String() string {
+func (x *Lint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateScenariosConfigurationResponse) ProtoMessage() {}
+func (*Lint) ProtoMessage() {}
 
-func (x *ValidateScenariosConfigurationResponse) ProtoReflect() protoreflect.Message {
+func (x *Lint) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1256,43 +1545,21 @@ func (x *ValidateScenariosConfigurationResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateScenariosConfigurationResponse.ProtoReflect.Descriptor instead.
-func (*ValidateScenariosConfigurationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Lint.ProtoReflect.Descriptor instead.
+func (*Lint) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ValidateScenariosConfigurationResponse) GetDiagnostics() []*Diagnostic {
-	if x != nil {
-		return x.Diagnostics
-	}
-	return nil
-}
-
-func (x *ValidateScenariosConfigurationResponse) GetDecode() *DecodeResponse {
-	if x != nil {
-		return x.Decode
-	}
-	return nil
-}
-
-func (x *ValidateScenariosConfigurationResponse) GetSampleDecode() *DecodeResponse {
-	if x != nil {
-		return x.SampleDecode
-	}
-	return nil
-}
-
-type ListScenariosRequest struct {
+type ListScenarioVariablesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
-	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Workspace *Workspace `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
 }
 
-func (x *ListScenariosRequest) Reset() {
-	*x = ListScenariosRequest{}
+func (x *ListScenarioVariablesRequest) Reset() {
+	*x = ListScenarioVariablesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1300,13 +1567,13 @@ func (x *ListScenariosRequest) Reset() {
 	}
 }
 
-func (x *ListScenariosRequest) String() string {
+func (x *ListScenarioVariablesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListScenariosRequest) ProtoMessage() {}
+func (*ListScenarioVariablesRequest) ProtoMessage() {}
 
-func (x *ListScenariosRequest) ProtoReflect() protoreflect.Message {
+func (x *ListScenarioVariablesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1318,37 +1585,30 @@ func (x *ListScenariosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListScenariosRequest.ProtoReflect.Descriptor instead.
-func (*ListScenariosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListScenarioVariablesRequest.ProtoReflect.Descriptor instead.
+func (*ListScenarioVariablesRequest) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListScenariosRequest) GetWorkspace() *Workspace {
+func (x *ListScenarioVariablesRequest) GetWorkspace() *Workspace {
 	if x != nil {
 		return x.Workspace
 	}
 	return nil
 }
 
-func (x *ListScenariosRequest) GetFilter() *Scenario_Filter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
-}
-
-type ListScenariosResponse struct {
+type ListScenarioVariablesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Diagnostics []*Diagnostic   `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 	Decode      *DecodeResponse `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
-	Scenarios   []*Ref_Scenario `protobuf:"bytes,3,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	Variables   []*Variable     `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty"`
 }
 
-func (x *ListScenariosResponse) Reset() {
-	*x = ListScenariosResponse{}
+func (x *ListScenarioVariablesResponse) Reset() {
+	*x = ListScenarioVariablesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1356,13 +1616,13 @@ func (x *ListScenariosResponse) Reset() {
 	}
 }
 
-func (x *ListScenariosResponse) String() string {
+func (x *ListScenarioVariablesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListScenariosResponse) ProtoMessage() {}
+func (*ListScenarioVariablesResponse) ProtoMessage() {}
 
-func (x *ListScenariosResponse) ProtoReflect() protoreflect.Message {
+func (x *ListScenarioVariablesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1374,46 +1634,51 @@ func (x *ListScenariosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListScenariosResponse.ProtoReflect.Descriptor instead.
-func (*ListScenariosResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListScenarioVariablesResponse.ProtoReflect.Descriptor instead.
+func (*ListScenarioVariablesResponse) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListScenariosResponse) GetDiagnostics() []*Diagnostic {
+func (x *ListScenarioVariablesResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *ListScenariosResponse) GetDecode() *DecodeResponse {
+func (x *ListScenarioVariablesResponse) GetDecode() *DecodeResponse {
 	if x != nil {
 		return x.Decode
 	}
 	return nil
 }
 
-func (x *ListScenariosResponse) GetScenarios() []*Ref_Scenario {
+func (x *ListScenarioVariablesResponse) GetVariables() []*Variable {
 	if x != nil {
-		return x.Scenarios
+		return x.Variables
 	}
 	return nil
 }
 
-type EnosServiceListScenariosResponse struct {
+// Variable is a flight plan variable and its resolved value.
+type Variable struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
-	//
-	//	*EnosServiceListScenariosResponse_Scenario
-	//	*EnosServiceListScenariosResponse_Decode
-	Response isEnosServiceListScenariosResponse_Response `protobuf_oneof:"response"`
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type        string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// value is the HCL representation of the resolved value. It is empty for sensitive variables.
+	Value     string          `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Sensitive bool            `protobuf:"varint,5,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	Source    Variable_Source `protobuf:"varint,6,opt,name=source,proto3,enum=hashicorp.enos.v1.Variable_Source" json:"source,omitempty"`
+	// range is the location of the value in a variables file or of the variable default.
+	Range *Range `protobuf:"bytes,7,opt,name=range,proto3" json:"range,omitempty"`
 }
 
-func (x *EnosServiceListScenariosResponse) Reset() {
-	*x = EnosServiceListScenariosResponse{}
+func (x *Variable) Reset() {
+	*x = Variable{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1421,13 +1686,13 @@ func (x *EnosServiceListScenariosResponse) Reset() {
 	}
 }
 
-func (x *EnosServiceListScenariosResponse) String() string {
+func (x *Variable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnosServiceListScenariosResponse) ProtoMessage() {}
+func (*Variable) ProtoMessage() {}
 
-func (x *EnosServiceListScenariosResponse) ProtoReflect() protoreflect.Message {
+func (x *Variable) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1439,59 +1704,70 @@ func (x *EnosServiceListScenariosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnosServiceListScenariosResponse.ProtoReflect.Descriptor instead.
-func (*EnosServiceListScenariosResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Variable.ProtoReflect.Descriptor instead.
+func (*Variable) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{19}
 }
 
-func (m *EnosServiceListScenariosResponse) GetResponse() isEnosServiceListScenariosResponse_Response {
-	if m != nil {
-		return m.Response
+func (x *Variable) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *EnosServiceListScenariosResponse) GetScenario() *Ref_Scenario {
-	if x, ok := x.GetResponse().(*EnosServiceListScenariosResponse_Scenario); ok {
-		return x.Scenario
+func (x *Variable) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *EnosServiceListScenariosResponse) GetDecode() *DecodeResponse {
-	if x, ok := x.GetResponse().(*EnosServiceListScenariosResponse_Decode); ok {
-		return x.Decode
+func (x *Variable) GetType() string {
+	if x != nil {
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-type isEnosServiceListScenariosResponse_Response interface {
-	isEnosServiceListScenariosResponse_Response()
+func (x *Variable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
 }
 
-type EnosServiceListScenariosResponse_Scenario struct {
-	Scenario *Ref_Scenario `protobuf:"bytes,1,opt,name=scenario,proto3,oneof"`
+func (x *Variable) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
 }
 
-type EnosServiceListScenariosResponse_Decode struct {
-	Decode *DecodeResponse `protobuf:"bytes,3,opt,name=decode,proto3,oneof"`
+func (x *Variable) GetSource() Variable_Source {
+	if x != nil {
+		return x.Source
+	}
+	return Variable_SOURCE_UNSPECIFIED
 }
 
-func (*EnosServiceListScenariosResponse_Scenario) isEnosServiceListScenariosResponse_Response() {}
-
-func (*EnosServiceListScenariosResponse_Decode) isEnosServiceListScenariosResponse_Response() {}
+func (x *Variable) GetRange() *Range {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
 
-type GenerateScenariosRequest struct {
+type ExplainDiagnosticCodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
-	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *GenerateScenariosRequest) Reset() {
-	*x = GenerateScenariosRequest{}
+func (x *ExplainDiagnosticCodeRequest) Reset() {
+	*x = ExplainDiagnosticCodeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1499,13 +1775,13 @@ func (x *GenerateScenariosRequest) Reset() {
 	}
 }
 
-func (x *GenerateScenariosRequest) String() string {
+func (x *ExplainDiagnosticCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateScenariosRequest) ProtoMessage() {}
+func (*ExplainDiagnosticCodeRequest) ProtoMessage() {}
 
-func (x *GenerateScenariosRequest) ProtoReflect() protoreflect.Message {
+func (x *ExplainDiagnosticCodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1517,37 +1793,29 @@ func (x *GenerateScenariosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateScenariosRequest.ProtoReflect.Descriptor instead.
-func (*GenerateScenariosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExplainDiagnosticCodeRequest.ProtoReflect.Descriptor instead.
+func (*ExplainDiagnosticCodeRequest) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GenerateScenariosRequest) GetWorkspace() *Workspace {
-	if x != nil {
-		return x.Workspace
-	}
-	return nil
-}
-
-func (x *GenerateScenariosRequest) GetFilter() *Scenario_Filter {
+func (x *ExplainDiagnosticCodeRequest) GetCode() string {
 	if x != nil {
-		return x.Filter
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type GenerateScenariosResponse struct {
+type ExplainDiagnosticCodeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Decode      *DecodeResponse  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
-	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	Diagnostics []*Diagnostic     `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Codes       []*DiagnosticCode `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
 }
 
-func (x *GenerateScenariosResponse) Reset() {
-	*x = GenerateScenariosResponse{}
+func (x *ExplainDiagnosticCodeResponse) Reset() {
+	*x = ExplainDiagnosticCodeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1555,13 +1823,13 @@ func (x *GenerateScenariosResponse) Reset() {
 	}
 }
 
-func (x *GenerateScenariosResponse) String() string {
+func (x *ExplainDiagnosticCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateScenariosResponse) ProtoMessage() {}
+func (*ExplainDiagnosticCodeResponse) ProtoMessage() {}
 
-func (x *GenerateScenariosResponse) ProtoReflect() protoreflect.Message {
+func (x *ExplainDiagnosticCodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1573,43 +1841,33 @@ func (x *GenerateScenariosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateScenariosResponse.ProtoReflect.Descriptor instead.
-func (*GenerateScenariosResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExplainDiagnosticCodeResponse.ProtoReflect.Descriptor instead.
+func (*ExplainDiagnosticCodeResponse) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GenerateScenariosResponse) GetDiagnostics() []*Diagnostic {
+func (x *ExplainDiagnosticCodeResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *GenerateScenariosResponse) GetDecode() *DecodeResponse {
-	if x != nil {
-		return x.Decode
-	}
-	return nil
-}
-
-func (x *GenerateScenariosResponse) GetOperations() []*Ref_Operation {
+func (x *ExplainDiagnosticCodeResponse) GetCodes() []*DiagnosticCode {
 	if x != nil {
-		return x.Operations
+		return x.Codes
 	}
 	return nil
 }
 
-type CheckScenariosRequest struct {
+type GetVersionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
-	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *CheckScenariosRequest) Reset() {
-	*x = CheckScenariosRequest{}
+func (x *GetVersionRequest) Reset() {
+	*x = GetVersionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1617,13 +1875,13 @@ func (x *CheckScenariosRequest) Reset() {
 	}
 }
 
-func (x *CheckScenariosRequest) String() string {
+func (x *GetVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckScenariosRequest) ProtoMessage() {}
+func (*GetVersionRequest) ProtoMessage() {}
 
-func (x *CheckScenariosRequest) ProtoReflect() protoreflect.Message {
+func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1635,37 +1893,23 @@ func (x *CheckScenariosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckScenariosRequest.ProtoReflect.Descriptor instead.
-func (*CheckScenariosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetVersionRequest) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CheckScenariosRequest) GetWorkspace() *Workspace {
-	if x != nil {
-		return x.Workspace
-	}
-	return nil
-}
-
-func (x *CheckScenariosRequest) GetFilter() *Scenario_Filter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
-}
-
-type CheckScenariosResponse struct {
+type GetVersionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Decode      *DecodeResponse  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
-	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Version     string        `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	GitSha      string        `protobuf:"bytes,3,opt,name=git_sha,proto3" json:"git_sha,omitempty"`
 }
 
-func (x *CheckScenariosResponse) Reset() {
-	*x = CheckScenariosResponse{}
+func (x *GetVersionResponse) Reset() {
+	*x = GetVersionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1673,13 +1917,13 @@ func (x *CheckScenariosResponse) Reset() {
 	}
 }
 
-func (x *CheckScenariosResponse) String() string {
+func (x *GetVersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckScenariosResponse) ProtoMessage() {}
+func (*GetVersionResponse) ProtoMessage() {}
 
-func (x *CheckScenariosResponse) ProtoReflect() protoreflect.Message {
+func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1691,43 +1935,46 @@ func (x *CheckScenariosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckScenariosResponse.ProtoReflect.Descriptor instead.
-func (*CheckScenariosResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetVersionResponse) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CheckScenariosResponse) GetDiagnostics() []*Diagnostic {
+func (x *GetVersionResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *CheckScenariosResponse) GetDecode() *DecodeResponse {
+func (x *GetVersionResponse) GetVersion() string {
 	if x != nil {
-		return x.Decode
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *CheckScenariosResponse) GetOperations() []*Ref_Operation {
+func (x *GetVersionResponse) GetGitSha() string {
 	if x != nil {
-		return x.Operations
+		return x.GitSha
 	}
-	return nil
+	return ""
 }
 
-type LaunchScenariosRequest struct {
+type ValidateScenariosConfigurationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
-	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Workspace           *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Filter              *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	SampleFilter        *Sample_Filter   `protobuf:"bytes,3,opt,name=sample_filter,json=sampleFilter,proto3" json:"sample_filter,omitempty"`
+	NoValidateScenarios bool             `protobuf:"varint,4,opt,name=no_validate_scenarios,json=noValidateScenarios,proto3" json:"no_validate_scenarios,omitempty"`
+	NoValidateSamples   bool             `protobuf:"varint,5,opt,name=no_validate_samples,json=noValidateSamples,proto3" json:"no_validate_samples,omitempty"`
 }
 
-func (x *LaunchScenariosRequest) Reset() {
-	*x = LaunchScenariosRequest{}
+func (x *ValidateScenariosConfigurationRequest) Reset() {
+	*x = ValidateScenariosConfigurationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1735,13 +1982,13 @@ func (x *LaunchScenariosRequest) Reset() {
 	}
 }
 
-func (x *LaunchScenariosRequest) String() string {
+func (x *ValidateScenariosConfigurationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LaunchScenariosRequest) ProtoMessage() {}
+func (*ValidateScenariosConfigurationRequest) ProtoMessage() {}
 
-func (x *LaunchScenariosRequest) ProtoReflect() protoreflect.Message {
+func (x *ValidateScenariosConfigurationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1753,37 +2000,58 @@ func (x *LaunchScenariosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LaunchScenariosRequest.ProtoReflect.Descriptor instead.
-func (*LaunchScenariosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateScenariosConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ValidateScenariosConfigurationRequest) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *LaunchScenariosRequest) GetWorkspace() *Workspace {
+func (x *ValidateScenariosConfigurationRequest) GetWorkspace() *Workspace {
 	if x != nil {
 		return x.Workspace
 	}
 	return nil
 }
 
-func (x *LaunchScenariosRequest) GetFilter() *Scenario_Filter {
+func (x *ValidateScenariosConfigurationRequest) GetFilter() *Scenario_Filter {
 	if x != nil {
 		return x.Filter
 	}
 	return nil
 }
 
-type LaunchScenariosResponse struct {
+func (x *ValidateScenariosConfigurationRequest) GetSampleFilter() *Sample_Filter {
+	if x != nil {
+		return x.SampleFilter
+	}
+	return nil
+}
+
+func (x *ValidateScenariosConfigurationRequest) GetNoValidateScenarios() bool {
+	if x != nil {
+		return x.NoValidateScenarios
+	}
+	return false
+}
+
+func (x *ValidateScenariosConfigurationRequest) GetNoValidateSamples() bool {
+	if x != nil {
+		return x.NoValidateSamples
+	}
+	return false
+}
+
+type ValidateScenariosConfigurationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Decode      *DecodeResponse  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
-	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	Diagnostics  []*Diagnostic   `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode       *DecodeResponse `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	SampleDecode *DecodeResponse `protobuf:"bytes,3,opt,name=sample_decode,json=sampleDecode,proto3" json:"sample_decode,omitempty"`
 }
 
-func (x *LaunchScenariosResponse) Reset() {
-	*x = LaunchScenariosResponse{}
+func (x *ValidateScenariosConfigurationResponse) Reset() {
+	*x = ValidateScenariosConfigurationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1791,13 +2059,13 @@ func (x *LaunchScenariosResponse) Reset() {
 	}
 }
 
-func (x *LaunchScenariosResponse) String() string {
+func (x *ValidateScenariosConfigurationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LaunchScenariosResponse) ProtoMessage() {}
+func (*ValidateScenariosConfigurationResponse) ProtoMessage() {}
 
-func (x *LaunchScenariosResponse) ProtoReflect() protoreflect.Message {
+func (x *ValidateScenariosConfigurationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1809,43 +2077,45 @@ func (x *LaunchScenariosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LaunchScenariosResponse.ProtoReflect.Descriptor instead.
-func (*LaunchScenariosResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateScenariosConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ValidateScenariosConfigurationResponse) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *LaunchScenariosResponse) GetDiagnostics() []*Diagnostic {
+func (x *ValidateScenariosConfigurationResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *LaunchScenariosResponse) GetDecode() *DecodeResponse {
+func (x *ValidateScenariosConfigurationResponse) GetDecode() *DecodeResponse {
 	if x != nil {
 		return x.Decode
 	}
 	return nil
 }
 
-func (x *LaunchScenariosResponse) GetOperations() []*Ref_Operation {
+func (x *ValidateScenariosConfigurationResponse) GetSampleDecode() *DecodeResponse {
 	if x != nil {
-		return x.Operations
+		return x.SampleDecode
 	}
 	return nil
 }
 
-type DestroyScenariosRequest struct {
+type ListScenariosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
 	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Only list scenario variants that could be affected by changes to these files
+	AffectedBy []string `protobuf:"bytes,3,rep,name=affected_by,proto3" json:"affected_by,omitempty"`
 }
 
-func (x *DestroyScenariosRequest) Reset() {
-	*x = DestroyScenariosRequest{}
+func (x *ListScenariosRequest) Reset() {
+	*x = ListScenariosRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1853,13 +2123,13 @@ func (x *DestroyScenariosRequest) Reset() {
 	}
 }
 
-func (x *DestroyScenariosRequest) String() string {
+func (x *ListScenariosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DestroyScenariosRequest) ProtoMessage() {}
+func (*ListScenariosRequest) ProtoMessage() {}
 
-func (x *DestroyScenariosRequest) ProtoReflect() protoreflect.Message {
+func (x *ListScenariosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1871,37 +2141,44 @@ func (x *DestroyScenariosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DestroyScenariosRequest.ProtoReflect.Descriptor instead.
-func (*DestroyScenariosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListScenariosRequest.ProtoReflect.Descriptor instead.
+func (*ListScenariosRequest) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DestroyScenariosRequest) GetWorkspace() *Workspace {
+func (x *ListScenariosRequest) GetWorkspace() *Workspace {
 	if x != nil {
 		return x.Workspace
 	}
 	return nil
 }
 
-func (x *DestroyScenariosRequest) GetFilter() *Scenario_Filter {
+func (x *ListScenariosRequest) GetFilter() *Scenario_Filter {
 	if x != nil {
 		return x.Filter
 	}
 	return nil
 }
 
-type DestroyScenariosResponse struct {
+func (x *ListScenariosRequest) GetAffectedBy() []string {
+	if x != nil {
+		return x.AffectedBy
+	}
+	return nil
+}
+
+type ListScenariosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Decode      *DecodeResponse  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
-	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	Diagnostics []*Diagnostic   `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Scenarios   []*Ref_Scenario `protobuf:"bytes,3,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
 }
 
-func (x *DestroyScenariosResponse) Reset() {
-	*x = DestroyScenariosResponse{}
+func (x *ListScenariosResponse) Reset() {
+	*x = ListScenariosResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1909,13 +2186,13 @@ func (x *DestroyScenariosResponse) Reset() {
 	}
 }
 
-func (x *DestroyScenariosResponse) String() string {
+func (x *ListScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DestroyScenariosResponse) ProtoMessage() {}
+func (*ListScenariosResponse) ProtoMessage() {}
 
-func (x *DestroyScenariosResponse) ProtoReflect() protoreflect.Message {
+func (x *ListScenariosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1927,43 +2204,46 @@ func (x *DestroyScenariosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DestroyScenariosResponse.ProtoReflect.Descriptor instead.
-func (*DestroyScenariosResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListScenariosResponse.ProtoReflect.Descriptor instead.
+func (*ListScenariosResponse) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DestroyScenariosResponse) GetDiagnostics() []*Diagnostic {
+func (x *ListScenariosResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *DestroyScenariosResponse) GetDecode() *DecodeResponse {
+func (x *ListScenariosResponse) GetDecode() *DecodeResponse {
 	if x != nil {
 		return x.Decode
 	}
 	return nil
 }
 
-func (x *DestroyScenariosResponse) GetOperations() []*Ref_Operation {
+func (x *ListScenariosResponse) GetScenarios() []*Ref_Scenario {
 	if x != nil {
-		return x.Operations
+		return x.Scenarios
 	}
 	return nil
 }
 
-type RunScenariosRequest struct {
+type EnosServiceListScenariosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
-	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*EnosServiceListScenariosResponse_Scenario
+	//	*EnosServiceListScenariosResponse_Decode
+	Response isEnosServiceListScenariosResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *RunScenariosRequest) Reset() {
-	*x = RunScenariosRequest{}
+func (x *EnosServiceListScenariosResponse) Reset() {
+	*x = EnosServiceListScenariosResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1971,13 +2251,13 @@ func (x *RunScenariosRequest) Reset() {
 	}
 }
 
-func (x *RunScenariosRequest) String() string {
+func (x *EnosServiceListScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunScenariosRequest) ProtoMessage() {}
+func (*EnosServiceListScenariosResponse) ProtoMessage() {}
 
-func (x *RunScenariosRequest) ProtoReflect() protoreflect.Message {
+func (x *EnosServiceListScenariosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1989,89 +2269,49 @@ func (x *RunScenariosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunScenariosRequest.ProtoReflect.Descriptor instead.
-func (*RunScenariosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnosServiceListScenariosResponse.ProtoReflect.Descriptor instead.
+func (*EnosServiceListScenariosResponse) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *RunScenariosRequest) GetWorkspace() *Workspace {
-	if x != nil {
-		return x.Workspace
+func (m *EnosServiceListScenariosResponse) GetResponse() isEnosServiceListScenariosResponse_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-func (x *RunScenariosRequest) GetFilter() *Scenario_Filter {
-	if x != nil {
-		return x.Filter
+func (x *EnosServiceListScenariosResponse) GetScenario() *Ref_Scenario {
+	if x, ok := x.GetResponse().(*EnosServiceListScenariosResponse_Scenario); ok {
+		return x.Scenario
 	}
 	return nil
 }
 
-type RunScenariosResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Decode      *DecodeResponse  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
-	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
-}
-
-func (x *RunScenariosResponse) Reset() {
-	*x = RunScenariosResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *EnosServiceListScenariosResponse) GetDecode() *DecodeResponse {
+	if x, ok := x.GetResponse().(*EnosServiceListScenariosResponse_Decode); ok {
+		return x.Decode
 	}
+	return nil
 }
 
-func (x *RunScenariosResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RunScenariosResponse) ProtoMessage() {}
-
-func (x *RunScenariosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type isEnosServiceListScenariosResponse_Response interface {
+	isEnosServiceListScenariosResponse_Response()
 }
 
-// Deprecated: Use RunScenariosResponse.ProtoReflect.Descriptor instead.
-func (*RunScenariosResponse) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{29}
+type EnosServiceListScenariosResponse_Scenario struct {
+	Scenario *Ref_Scenario `protobuf:"bytes,1,opt,name=scenario,proto3,oneof"`
 }
 
-func (x *RunScenariosResponse) GetDiagnostics() []*Diagnostic {
-	if x != nil {
-		return x.Diagnostics
-	}
-	return nil
+type EnosServiceListScenariosResponse_Decode struct {
+	Decode *DecodeResponse `protobuf:"bytes,3,opt,name=decode,proto3,oneof"`
 }
 
-func (x *RunScenariosResponse) GetDecode() *DecodeResponse {
-	if x != nil {
-		return x.Decode
-	}
-	return nil
-}
+func (*EnosServiceListScenariosResponse_Scenario) isEnosServiceListScenariosResponse_Response() {}
 
-func (x *RunScenariosResponse) GetOperations() []*Ref_Operation {
-	if x != nil {
-		return x.Operations
-	}
-	return nil
-}
+func (*EnosServiceListScenariosResponse_Decode) isEnosServiceListScenariosResponse_Response() {}
 
-type ExecScenariosRequest struct {
+type GenerateScenariosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2080,23 +2320,23 @@ type ExecScenariosRequest struct {
 	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *ExecScenariosRequest) Reset() {
-	*x = ExecScenariosRequest{}
+func (x *GenerateScenariosRequest) Reset() {
+	*x = GenerateScenariosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[30]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecScenariosRequest) String() string {
+func (x *GenerateScenariosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecScenariosRequest) ProtoMessage() {}
+func (*GenerateScenariosRequest) ProtoMessage() {}
 
-func (x *ExecScenariosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[30]
+func (x *GenerateScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2107,26 +2347,26 @@ func (x *ExecScenariosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecScenariosRequest.ProtoReflect.Descriptor instead.
-func (*ExecScenariosRequest) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GenerateScenariosRequest.ProtoReflect.Descriptor instead.
+func (*GenerateScenariosRequest) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ExecScenariosRequest) GetWorkspace() *Workspace {
+func (x *GenerateScenariosRequest) GetWorkspace() *Workspace {
 	if x != nil {
 		return x.Workspace
 	}
 	return nil
 }
 
-func (x *ExecScenariosRequest) GetFilter() *Scenario_Filter {
+func (x *GenerateScenariosRequest) GetFilter() *Scenario_Filter {
 	if x != nil {
 		return x.Filter
 	}
 	return nil
 }
 
-type ExecScenariosResponse struct {
+type GenerateScenariosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2136,23 +2376,23 @@ type ExecScenariosResponse struct {
 	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
 }
 
-func (x *ExecScenariosResponse) Reset() {
-	*x = ExecScenariosResponse{}
+func (x *GenerateScenariosResponse) Reset() {
+	*x = GenerateScenariosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[31]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecScenariosResponse) String() string {
+func (x *GenerateScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecScenariosResponse) ProtoMessage() {}
+func (*GenerateScenariosResponse) ProtoMessage() {}
 
-func (x *ExecScenariosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[31]
+func (x *GenerateScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2163,33 +2403,33 @@ func (x *ExecScenariosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecScenariosResponse.ProtoReflect.Descriptor instead.
-func (*ExecScenariosResponse) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GenerateScenariosResponse.ProtoReflect.Descriptor instead.
+func (*GenerateScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ExecScenariosResponse) GetDiagnostics() []*Diagnostic {
+func (x *GenerateScenariosResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *ExecScenariosResponse) GetDecode() *DecodeResponse {
+func (x *GenerateScenariosResponse) GetDecode() *DecodeResponse {
 	if x != nil {
 		return x.Decode
 	}
 	return nil
 }
 
-func (x *ExecScenariosResponse) GetOperations() []*Ref_Operation {
+func (x *GenerateScenariosResponse) GetOperations() []*Ref_Operation {
 	if x != nil {
 		return x.Operations
 	}
 	return nil
 }
 
-type OutputScenariosRequest struct {
+type CheckScenariosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2198,23 +2438,23 @@ type OutputScenariosRequest struct {
 	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *OutputScenariosRequest) Reset() {
-	*x = OutputScenariosRequest{}
+func (x *CheckScenariosRequest) Reset() {
+	*x = CheckScenariosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[32]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OutputScenariosRequest) String() string {
+func (x *CheckScenariosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutputScenariosRequest) ProtoMessage() {}
+func (*CheckScenariosRequest) ProtoMessage() {}
 
-func (x *OutputScenariosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[32]
+func (x *CheckScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,26 +2465,26 @@ func (x *OutputScenariosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutputScenariosRequest.ProtoReflect.Descriptor instead.
-func (*OutputScenariosRequest) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CheckScenariosRequest.ProtoReflect.Descriptor instead.
+func (*CheckScenariosRequest) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *OutputScenariosRequest) GetWorkspace() *Workspace {
+func (x *CheckScenariosRequest) GetWorkspace() *Workspace {
 	if x != nil {
 		return x.Workspace
 	}
 	return nil
 }
 
-func (x *OutputScenariosRequest) GetFilter() *Scenario_Filter {
+func (x *CheckScenariosRequest) GetFilter() *Scenario_Filter {
 	if x != nil {
 		return x.Filter
 	}
 	return nil
 }
 
-type OutputScenariosResponse struct {
+type CheckScenariosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2254,23 +2494,23 @@ type OutputScenariosResponse struct {
 	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
 }
 
-func (x *OutputScenariosResponse) Reset() {
-	*x = OutputScenariosResponse{}
+func (x *CheckScenariosResponse) Reset() {
+	*x = CheckScenariosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[33]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OutputScenariosResponse) String() string {
+func (x *CheckScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutputScenariosResponse) ProtoMessage() {}
+func (*CheckScenariosResponse) ProtoMessage() {}
 
-func (x *OutputScenariosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[33]
+func (x *CheckScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2281,57 +2521,62 @@ func (x *OutputScenariosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutputScenariosResponse.ProtoReflect.Descriptor instead.
-func (*OutputScenariosResponse) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use CheckScenariosResponse.ProtoReflect.Descriptor instead.
+func (*CheckScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *OutputScenariosResponse) GetDiagnostics() []*Diagnostic {
+func (x *CheckScenariosResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *OutputScenariosResponse) GetDecode() *DecodeResponse {
+func (x *CheckScenariosResponse) GetDecode() *DecodeResponse {
 	if x != nil {
 		return x.Decode
 	}
 	return nil
 }
 
-func (x *OutputScenariosResponse) GetOperations() []*Ref_Operation {
+func (x *CheckScenariosResponse) GetOperations() []*Ref_Operation {
 	if x != nil {
 		return x.Operations
 	}
 	return nil
 }
 
-type ListSamplesRequest struct {
+type LaunchScenariosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Workspace *Workspace `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// failure_policy overrides the failure policy of each scenario
+	FailurePolicy *Operation_FailurePolicy `protobuf:"bytes,3,opt,name=failure_policy,proto3" json:"failure_policy,omitempty"`
+	// ttl is how long each launched scenario should exist before it is reaped
+	Ttl *durationpb.Duration `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func (x *ListSamplesRequest) Reset() {
-	*x = ListSamplesRequest{}
+func (x *LaunchScenariosRequest) Reset() {
+	*x = LaunchScenariosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[34]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSamplesRequest) String() string {
+func (x *LaunchScenariosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSamplesRequest) ProtoMessage() {}
+func (*LaunchScenariosRequest) ProtoMessage() {}
 
-func (x *ListSamplesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[34]
+func (x *LaunchScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,45 +2587,66 @@ func (x *ListSamplesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSamplesRequest.ProtoReflect.Descriptor instead.
-func (*ListSamplesRequest) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use LaunchScenariosRequest.ProtoReflect.Descriptor instead.
+func (*LaunchScenariosRequest) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListSamplesRequest) GetWorkspace() *Workspace {
+func (x *LaunchScenariosRequest) GetWorkspace() *Workspace {
 	if x != nil {
 		return x.Workspace
 	}
 	return nil
 }
 
-type ListSamplesResponse struct {
+func (x *LaunchScenariosRequest) GetFilter() *Scenario_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *LaunchScenariosRequest) GetFailurePolicy() *Operation_FailurePolicy {
+	if x != nil {
+		return x.FailurePolicy
+	}
+	return nil
+}
+
+func (x *LaunchScenariosRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type LaunchScenariosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic   `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Decode      *DecodeResponse `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
-	Samples     []*Ref_Sample   `protobuf:"bytes,3,rep,name=samples,proto3" json:"samples,omitempty"`
+	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
 }
 
-func (x *ListSamplesResponse) Reset() {
-	*x = ListSamplesResponse{}
+func (x *LaunchScenariosResponse) Reset() {
+	*x = LaunchScenariosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[35]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSamplesResponse) String() string {
+func (x *LaunchScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSamplesResponse) ProtoMessage() {}
+func (*LaunchScenariosResponse) ProtoMessage() {}
 
-func (x *ListSamplesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[35]
+func (x *LaunchScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2391,58 +2657,62 @@ func (x *ListSamplesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSamplesResponse.ProtoReflect.Descriptor instead.
-func (*ListSamplesResponse) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use LaunchScenariosResponse.ProtoReflect.Descriptor instead.
+func (*LaunchScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListSamplesResponse) GetDiagnostics() []*Diagnostic {
+func (x *LaunchScenariosResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *ListSamplesResponse) GetDecode() *DecodeResponse {
+func (x *LaunchScenariosResponse) GetDecode() *DecodeResponse {
 	if x != nil {
 		return x.Decode
 	}
 	return nil
 }
 
-func (x *ListSamplesResponse) GetSamples() []*Ref_Sample {
+func (x *LaunchScenariosResponse) GetOperations() []*Ref_Operation {
 	if x != nil {
-		return x.Samples
+		return x.Operations
 	}
 	return nil
 }
 
-type ObserveSampleRequest struct {
+type DestroyScenariosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Workspace *Workspace     `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
-	Filter    *Sample_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// all_launched destroys every previously generated module in the out directory
+	// that has state, regardless of whether or not its scenario is still in the
+	// flight plan. The filter is ignored.
+	AllLaunched bool `protobuf:"varint,3,opt,name=all_launched,proto3" json:"all_launched,omitempty"`
 }
 
-func (x *ObserveSampleRequest) Reset() {
-	*x = ObserveSampleRequest{}
+func (x *DestroyScenariosRequest) Reset() {
+	*x = DestroyScenariosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[36]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ObserveSampleRequest) String() string {
+func (x *DestroyScenariosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ObserveSampleRequest) ProtoMessage() {}
+func (*DestroyScenariosRequest) ProtoMessage() {}
 
-func (x *ObserveSampleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[36]
+func (x *DestroyScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2453,52 +2723,59 @@ func (x *ObserveSampleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ObserveSampleRequest.ProtoReflect.Descriptor instead.
-func (*ObserveSampleRequest) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use DestroyScenariosRequest.ProtoReflect.Descriptor instead.
+func (*DestroyScenariosRequest) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ObserveSampleRequest) GetWorkspace() *Workspace {
+func (x *DestroyScenariosRequest) GetWorkspace() *Workspace {
 	if x != nil {
 		return x.Workspace
 	}
 	return nil
 }
 
-func (x *ObserveSampleRequest) GetFilter() *Sample_Filter {
+func (x *DestroyScenariosRequest) GetFilter() *Scenario_Filter {
 	if x != nil {
 		return x.Filter
 	}
 	return nil
 }
 
-type ObserveSampleResponse struct {
+func (x *DestroyScenariosRequest) GetAllLaunched() bool {
+	if x != nil {
+		return x.AllLaunched
+	}
+	return false
+}
+
+type DestroyScenariosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic       `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Decode      *DecodeResponse     `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
-	Observation *Sample_Observation `protobuf:"bytes,3,opt,name=observation,proto3" json:"observation,omitempty"`
+	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
 }
 
-func (x *ObserveSampleResponse) Reset() {
-	*x = ObserveSampleResponse{}
+func (x *DestroyScenariosResponse) Reset() {
+	*x = DestroyScenariosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[37]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ObserveSampleResponse) String() string {
+func (x *DestroyScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ObserveSampleResponse) ProtoMessage() {}
+func (*DestroyScenariosResponse) ProtoMessage() {}
 
-func (x *ObserveSampleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[37]
+func (x *DestroyScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2509,43 +2786,93 @@ func (x *ObserveSampleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ObserveSampleResponse.ProtoReflect.Descriptor instead.
-func (*ObserveSampleResponse) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use DestroyScenariosResponse.ProtoReflect.Descriptor instead.
+func (*DestroyScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ObserveSampleResponse) GetDiagnostics() []*Diagnostic {
+func (x *DestroyScenariosResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *ObserveSampleResponse) GetDecode() *DecodeResponse {
+func (x *DestroyScenariosResponse) GetDecode() *DecodeResponse {
 	if x != nil {
 		return x.Decode
 	}
 	return nil
 }
 
-func (x *ObserveSampleResponse) GetObservation() *Sample_Observation {
+func (x *DestroyScenariosResponse) GetOperations() []*Ref_Operation {
 	if x != nil {
-		return x.Observation
+		return x.Operations
 	}
 	return nil
 }
 
-type FormatRequest struct {
+// ReapScenariosRequest destroys every launched scenario in the workspace out
+// directory that has outlived its TTL.
+type ReapScenariosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Files  []*FormatRequest_File `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
-	Config *FormatRequest_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Workspace *Workspace `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
 }
 
-func (x *FormatRequest) Reset() {
-	*x = FormatRequest{}
+func (x *ReapScenariosRequest) Reset() {
+	*x = ReapScenariosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReapScenariosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReapScenariosRequest) ProtoMessage() {}
+
+func (x *ReapScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReapScenariosRequest.ProtoReflect.Descriptor instead.
+func (*ReapScenariosRequest) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReapScenariosRequest) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+type ReapScenariosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (x *ReapScenariosResponse) Reset() {
+	*x = ReapScenariosResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2553,13 +2880,13 @@ func (x *FormatRequest) Reset() {
 	}
 }
 
-func (x *FormatRequest) String() string {
+func (x *ReapScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FormatRequest) ProtoMessage() {}
+func (*ReapScenariosResponse) ProtoMessage() {}
 
-func (x *FormatRequest) ProtoReflect() protoreflect.Message {
+func (x *ReapScenariosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2571,36 +2898,45 @@ func (x *FormatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FormatRequest.ProtoReflect.Descriptor instead.
-func (*FormatRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReapScenariosResponse.ProtoReflect.Descriptor instead.
+func (*ReapScenariosResponse) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *FormatRequest) GetFiles() []*FormatRequest_File {
+func (x *ReapScenariosResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Files
+		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *FormatRequest) GetConfig() *FormatRequest_Config {
+func (x *ReapScenariosResponse) GetDecode() *DecodeResponse {
 	if x != nil {
-		return x.Config
+		return x.Decode
 	}
 	return nil
 }
 
-type FormatResponse struct {
+func (x *ReapScenariosResponse) GetOperations() []*Ref_Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type RunScenariosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic              `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Responses   []*FormatResponse_Response `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// failure_policy overrides the failure policy of each scenario
+	FailurePolicy *Operation_FailurePolicy `protobuf:"bytes,3,opt,name=failure_policy,proto3" json:"failure_policy,omitempty"`
 }
 
-func (x *FormatResponse) Reset() {
-	*x = FormatResponse{}
+func (x *RunScenariosRequest) Reset() {
+	*x = RunScenariosRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2608,13 +2944,13 @@ func (x *FormatResponse) Reset() {
 	}
 }
 
-func (x *FormatResponse) String() string {
+func (x *RunScenariosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FormatResponse) ProtoMessage() {}
+func (*RunScenariosRequest) ProtoMessage() {}
 
-func (x *FormatResponse) ProtoReflect() protoreflect.Message {
+func (x *RunScenariosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2626,35 +2962,44 @@ func (x *FormatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FormatResponse.ProtoReflect.Descriptor instead.
-func (*FormatResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunScenariosRequest.ProtoReflect.Descriptor instead.
+func (*RunScenariosRequest) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *FormatResponse) GetDiagnostics() []*Diagnostic {
+func (x *RunScenariosRequest) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.Diagnostics
+		return x.Workspace
 	}
 	return nil
 }
 
-func (x *FormatResponse) GetResponses() []*FormatResponse_Response {
+func (x *RunScenariosRequest) GetFilter() *Scenario_Filter {
 	if x != nil {
-		return x.Responses
+		return x.Filter
 	}
 	return nil
 }
 
-type OperationEventStreamRequest struct {
+func (x *RunScenariosRequest) GetFailurePolicy() *Operation_FailurePolicy {
+	if x != nil {
+		return x.FailurePolicy
+	}
+	return nil
+}
+
+type RunScenariosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Op *Ref_Operation `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
 }
 
-func (x *OperationEventStreamRequest) Reset() {
-	*x = OperationEventStreamRequest{}
+func (x *RunScenariosResponse) Reset() {
+	*x = RunScenariosResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2662,13 +3007,13 @@ func (x *OperationEventStreamRequest) Reset() {
 	}
 }
 
-func (x *OperationEventStreamRequest) String() string {
+func (x *RunScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationEventStreamRequest) ProtoMessage() {}
+func (*RunScenariosResponse) ProtoMessage() {}
 
-func (x *OperationEventStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *RunScenariosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2680,29 +3025,43 @@ func (x *OperationEventStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationEventStreamRequest.ProtoReflect.Descriptor instead.
-func (*OperationEventStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunScenariosResponse.ProtoReflect.Descriptor instead.
+func (*RunScenariosResponse) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *OperationEventStreamRequest) GetOp() *Ref_Operation {
+func (x *RunScenariosResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Op
+		return x.Diagnostics
 	}
 	return nil
 }
 
-type OperationEventStreamResponse struct {
+func (x *RunScenariosResponse) GetDecode() *DecodeResponse {
+	if x != nil {
+		return x.Decode
+	}
+	return nil
+}
+
+func (x *RunScenariosResponse) GetOperations() []*Ref_Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type ExecScenariosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Event       *Operation_Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *OperationEventStreamResponse) Reset() {
-	*x = OperationEventStreamResponse{}
+func (x *ExecScenariosRequest) Reset() {
+	*x = ExecScenariosRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2710,13 +3069,13 @@ func (x *OperationEventStreamResponse) Reset() {
 	}
 }
 
-func (x *OperationEventStreamResponse) String() string {
+func (x *ExecScenariosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationEventStreamResponse) ProtoMessage() {}
+func (*ExecScenariosRequest) ProtoMessage() {}
 
-func (x *OperationEventStreamResponse) ProtoReflect() protoreflect.Message {
+func (x *ExecScenariosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2728,35 +3087,37 @@ func (x *OperationEventStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationEventStreamResponse.ProtoReflect.Descriptor instead.
-func (*OperationEventStreamResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecScenariosRequest.ProtoReflect.Descriptor instead.
+func (*ExecScenariosRequest) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *OperationEventStreamResponse) GetDiagnostics() []*Diagnostic {
+func (x *ExecScenariosRequest) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.Diagnostics
+		return x.Workspace
 	}
 	return nil
 }
 
-func (x *OperationEventStreamResponse) GetEvent() *Operation_Event {
+func (x *ExecScenariosRequest) GetFilter() *Scenario_Filter {
 	if x != nil {
-		return x.Event
+		return x.Filter
 	}
 	return nil
 }
 
-type OperationRequest struct {
+type ExecScenariosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Op *Ref_Operation `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
 }
 
-func (x *OperationRequest) Reset() {
-	*x = OperationRequest{}
+func (x *ExecScenariosResponse) Reset() {
+	*x = ExecScenariosResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2764,13 +3125,13 @@ func (x *OperationRequest) Reset() {
 	}
 }
 
-func (x *OperationRequest) String() string {
+func (x *ExecScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationRequest) ProtoMessage() {}
+func (*ExecScenariosResponse) ProtoMessage() {}
 
-func (x *OperationRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecScenariosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2782,29 +3143,49 @@ func (x *OperationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationRequest.ProtoReflect.Descriptor instead.
-func (*OperationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecScenariosResponse.ProtoReflect.Descriptor instead.
+func (*ExecScenariosResponse) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *OperationRequest) GetOp() *Ref_Operation {
+func (x *ExecScenariosResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Op
+		return x.Diagnostics
 	}
 	return nil
 }
 
-type OperationResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Diagnostics []*Diagnostic       `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Response    *Operation_Response `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+func (x *ExecScenariosResponse) GetDecode() *DecodeResponse {
+	if x != nil {
+		return x.Decode
+	}
+	return nil
 }
 
-func (x *OperationResponse) Reset() {
-	*x = OperationResponse{}
+func (x *ExecScenariosResponse) GetOperations() []*Ref_Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// ExecScenarioStreamRequest is a message from the client to an interactive exec. The
+// first message must always be start.
+type ExecScenarioStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//
+	//	*ExecScenarioStreamRequest_Start_
+	//	*ExecScenarioStreamRequest_Stdin_
+	//	*ExecScenarioStreamRequest_Resize
+	Request isExecScenarioStreamRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *ExecScenarioStreamRequest) Reset() {
+	*x = ExecScenarioStreamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2812,13 +3193,13 @@ func (x *OperationResponse) Reset() {
 	}
 }
 
-func (x *OperationResponse) String() string {
+func (x *ExecScenarioStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationResponse) ProtoMessage() {}
+func (*ExecScenarioStreamRequest) ProtoMessage() {}
 
-func (x *OperationResponse) ProtoReflect() protoreflect.Message {
+func (x *ExecScenarioStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2830,37 +3211,78 @@ func (x *OperationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationResponse.ProtoReflect.Descriptor instead.
-func (*OperationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecScenarioStreamRequest.ProtoReflect.Descriptor instead.
+func (*ExecScenarioStreamRequest) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *OperationResponse) GetDiagnostics() []*Diagnostic {
-	if x != nil {
-		return x.Diagnostics
+func (m *ExecScenarioStreamRequest) GetRequest() isExecScenarioStreamRequest_Request {
+	if m != nil {
+		return m.Request
 	}
 	return nil
 }
 
-func (x *OperationResponse) GetResponse() *Operation_Response {
-	if x != nil {
-		return x.Response
+func (x *ExecScenarioStreamRequest) GetStart() *ExecScenarioStreamRequest_Start {
+	if x, ok := x.GetRequest().(*ExecScenarioStreamRequest_Start_); ok {
+		return x.Start
 	}
 	return nil
 }
 
-type OperationResponses struct {
+func (x *ExecScenarioStreamRequest) GetStdin() *ExecScenarioStreamRequest_Stdin {
+	if x, ok := x.GetRequest().(*ExecScenarioStreamRequest_Stdin_); ok {
+		return x.Stdin
+	}
+	return nil
+}
+
+func (x *ExecScenarioStreamRequest) GetResize() *ExecScenarioStreamRequest_TerminalSize {
+	if x, ok := x.GetRequest().(*ExecScenarioStreamRequest_Resize); ok {
+		return x.Resize
+	}
+	return nil
+}
+
+type isExecScenarioStreamRequest_Request interface {
+	isExecScenarioStreamRequest_Request()
+}
+
+type ExecScenarioStreamRequest_Start_ struct {
+	Start *ExecScenarioStreamRequest_Start `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type ExecScenarioStreamRequest_Stdin_ struct {
+	Stdin *ExecScenarioStreamRequest_Stdin `protobuf:"bytes,2,opt,name=stdin,proto3,oneof"`
+}
+
+type ExecScenarioStreamRequest_Resize struct {
+	Resize *ExecScenarioStreamRequest_TerminalSize `protobuf:"bytes,3,opt,name=resize,proto3,oneof"`
+}
+
+func (*ExecScenarioStreamRequest_Start_) isExecScenarioStreamRequest_Request() {}
+
+func (*ExecScenarioStreamRequest_Stdin_) isExecScenarioStreamRequest_Request() {}
+
+func (*ExecScenarioStreamRequest_Resize) isExecScenarioStreamRequest_Request() {}
+
+// ExecScenarioStreamResponse is a message from the server to the client of an interactive
+// exec. The last message will always be exit.
+type ExecScenarioStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic         `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Decode      *DecodeResponse       `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
-	Responses   []*Operation_Response `protobuf:"bytes,3,rep,name=responses,proto3" json:"responses,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*ExecScenarioStreamResponse_Stdout
+	//	*ExecScenarioStreamResponse_Stderr
+	//	*ExecScenarioStreamResponse_Exit_
+	Response isExecScenarioStreamResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *OperationResponses) Reset() {
-	*x = OperationResponses{}
+func (x *ExecScenarioStreamResponse) Reset() {
+	*x = ExecScenarioStreamResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2868,13 +3290,13 @@ func (x *OperationResponses) Reset() {
 	}
 }
 
-func (x *OperationResponses) String() string {
+func (x *ExecScenarioStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationResponses) ProtoMessage() {}
+func (*ExecScenarioStreamResponse) ProtoMessage() {}
 
-func (x *OperationResponses) ProtoReflect() protoreflect.Message {
+func (x *ExecScenarioStreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2886,33 +3308,62 @@ func (x *OperationResponses) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationResponses.ProtoReflect.Descriptor instead.
-func (*OperationResponses) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecScenarioStreamResponse.ProtoReflect.Descriptor instead.
+func (*ExecScenarioStreamResponse) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *OperationResponses) GetDiagnostics() []*Diagnostic {
-	if x != nil {
-		return x.Diagnostics
+func (m *ExecScenarioStreamResponse) GetResponse() isExecScenarioStreamResponse_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-func (x *OperationResponses) GetDecode() *DecodeResponse {
-	if x != nil {
-		return x.Decode
+func (x *ExecScenarioStreamResponse) GetStdout() []byte {
+	if x, ok := x.GetResponse().(*ExecScenarioStreamResponse_Stdout); ok {
+		return x.Stdout
 	}
 	return nil
 }
 
-func (x *OperationResponses) GetResponses() []*Operation_Response {
-	if x != nil {
-		return x.Responses
+func (x *ExecScenarioStreamResponse) GetStderr() []byte {
+	if x, ok := x.GetResponse().(*ExecScenarioStreamResponse_Stderr); ok {
+		return x.Stderr
 	}
 	return nil
 }
 
-type OutlineScenariosRequest struct {
+func (x *ExecScenarioStreamResponse) GetExit() *ExecScenarioStreamResponse_Exit {
+	if x, ok := x.GetResponse().(*ExecScenarioStreamResponse_Exit_); ok {
+		return x.Exit
+	}
+	return nil
+}
+
+type isExecScenarioStreamResponse_Response interface {
+	isExecScenarioStreamResponse_Response()
+}
+
+type ExecScenarioStreamResponse_Stdout struct {
+	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3,oneof"`
+}
+
+type ExecScenarioStreamResponse_Stderr struct {
+	Stderr []byte `protobuf:"bytes,2,opt,name=stderr,proto3,oneof"`
+}
+
+type ExecScenarioStreamResponse_Exit_ struct {
+	Exit *ExecScenarioStreamResponse_Exit `protobuf:"bytes,3,opt,name=exit,proto3,oneof"`
+}
+
+func (*ExecScenarioStreamResponse_Stdout) isExecScenarioStreamResponse_Response() {}
+
+func (*ExecScenarioStreamResponse_Stderr) isExecScenarioStreamResponse_Response() {}
+
+func (*ExecScenarioStreamResponse_Exit_) isExecScenarioStreamResponse_Response() {}
+
+type OutputScenariosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2921,8 +3372,8 @@ type OutlineScenariosRequest struct {
 	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *OutlineScenariosRequest) Reset() {
-	*x = OutlineScenariosRequest{}
+func (x *OutputScenariosRequest) Reset() {
+	*x = OutputScenariosRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2930,13 +3381,13 @@ func (x *OutlineScenariosRequest) Reset() {
 	}
 }
 
-func (x *OutlineScenariosRequest) String() string {
+func (x *OutputScenariosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutlineScenariosRequest) ProtoMessage() {}
+func (*OutputScenariosRequest) ProtoMessage() {}
 
-func (x *OutlineScenariosRequest) ProtoReflect() protoreflect.Message {
+func (x *OutputScenariosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2948,38 +3399,37 @@ func (x *OutlineScenariosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutlineScenariosRequest.ProtoReflect.Descriptor instead.
-func (*OutlineScenariosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutputScenariosRequest.ProtoReflect.Descriptor instead.
+func (*OutputScenariosRequest) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *OutlineScenariosRequest) GetWorkspace() *Workspace {
+func (x *OutputScenariosRequest) GetWorkspace() *Workspace {
 	if x != nil {
 		return x.Workspace
 	}
 	return nil
 }
 
-func (x *OutlineScenariosRequest) GetFilter() *Scenario_Filter {
+func (x *OutputScenariosRequest) GetFilter() *Scenario_Filter {
 	if x != nil {
 		return x.Filter
 	}
 	return nil
 }
 
-type OutlineScenariosResponse struct {
+type OutputScenariosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic       `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Decode      *DecodeResponse     `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
-	Outlines    []*Scenario_Outline `protobuf:"bytes,3,rep,name=outlines,proto3" json:"outlines,omitempty"`
-	Verifies    []*Quality          `protobuf:"bytes,4,rep,name=verifies,proto3" json:"verifies,omitempty"`
+	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
 }
 
-func (x *OutlineScenariosResponse) Reset() {
-	*x = OutlineScenariosResponse{}
+func (x *OutputScenariosResponse) Reset() {
+	*x = OutputScenariosResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2987,13 +3437,13 @@ func (x *OutlineScenariosResponse) Reset() {
 	}
 }
 
-func (x *OutlineScenariosResponse) String() string {
+func (x *OutputScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutlineScenariosResponse) ProtoMessage() {}
+func (*OutputScenariosResponse) ProtoMessage() {}
 
-func (x *OutlineScenariosResponse) ProtoReflect() protoreflect.Message {
+func (x *OutputScenariosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3005,51 +3455,43 @@ func (x *OutlineScenariosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutlineScenariosResponse.ProtoReflect.Descriptor instead.
-func (*OutlineScenariosResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutputScenariosResponse.ProtoReflect.Descriptor instead.
+func (*OutputScenariosResponse) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *OutlineScenariosResponse) GetDiagnostics() []*Diagnostic {
+func (x *OutputScenariosResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *OutlineScenariosResponse) GetDecode() *DecodeResponse {
+func (x *OutputScenariosResponse) GetDecode() *DecodeResponse {
 	if x != nil {
 		return x.Decode
 	}
 	return nil
 }
 
-func (x *OutlineScenariosResponse) GetOutlines() []*Scenario_Outline {
-	if x != nil {
-		return x.Outlines
-	}
-	return nil
-}
-
-func (x *OutlineScenariosResponse) GetVerifies() []*Quality {
+func (x *OutputScenariosResponse) GetOperations() []*Ref_Operation {
 	if x != nil {
-		return x.Verifies
+		return x.Operations
 	}
 	return nil
 }
 
-// Quality describes a quality chracteristic that a scenario step can verify.
-type Quality struct {
+type StatusScenariosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *Quality) Reset() {
-	*x = Quality{}
+func (x *StatusScenariosRequest) Reset() {
+	*x = StatusScenariosRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3057,13 +3499,13 @@ func (x *Quality) Reset() {
 	}
 }
 
-func (x *Quality) String() string {
+func (x *StatusScenariosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Quality) ProtoMessage() {}
+func (*StatusScenariosRequest) ProtoMessage() {}
 
-func (x *Quality) ProtoReflect() protoreflect.Message {
+func (x *StatusScenariosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3075,47 +3517,37 @@ func (x *Quality) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Quality.ProtoReflect.Descriptor instead.
-func (*Quality) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatusScenariosRequest.ProtoReflect.Descriptor instead.
+func (*StatusScenariosRequest) Descriptor() ([]byte, []int) {
 	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Quality) GetName() string {
+func (x *StatusScenariosRequest) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.Name
+		return x.Workspace
 	}
-	return ""
+	return nil
 }
 
-func (x *Quality) GetDescription() string {
+func (x *StatusScenariosRequest) GetFilter() *Scenario_Filter {
 	if x != nil {
-		return x.Description
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-type UI_Settings struct {
+type StatusScenariosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Width    uint32             `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
-	IsTty    bool               `protobuf:"varint,2,opt,name=is_tty,proto3" json:"is_tty,omitempty"`
-	UseColor bool               `protobuf:"varint,3,opt,name=use_color,proto3" json:"use_color,omitempty"`
-	Format   UI_Settings_Format `protobuf:"varint,4,opt,name=format,proto3,enum=hashicorp.enos.v1.UI_Settings_Format" json:"format,omitempty"`
-	Level    UI_Settings_Level  `protobuf:"varint,5,opt,name=level,proto3,enum=hashicorp.enos.v1.UI_Settings_Level" json:"level,omitempty"`
-	// stdout_path if defined, we'll write our stdout messages to a file in
-	// the given path
-	StdoutPath string `protobuf:"bytes,6,opt,name=stdout_path,proto3" json:"stdout_path,omitempty"`
-	// stderr_path if defined, we'll write our stderr messages to a file in
-	// the given path
-	StderrPath string `protobuf:"bytes,7,opt,name=stderr_path,proto3" json:"stderr_path,omitempty"`
-	// Render warnings as failures
-	FailOnWarnings bool `protobuf:"varint,8,opt,name=fail_on_warnings,proto3" json:"fail_on_warnings,omitempty"`
+	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Operations  []*Ref_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
 }
 
-func (x *UI_Settings) Reset() {
-	*x = UI_Settings{}
+func (x *StatusScenariosResponse) Reset() {
+	*x = StatusScenariosResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3123,13 +3555,13 @@ func (x *UI_Settings) Reset() {
 	}
 }
 
-func (x *UI_Settings) String() string {
+func (x *StatusScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UI_Settings) ProtoMessage() {}
+func (*StatusScenariosResponse) ProtoMessage() {}
 
-func (x *UI_Settings) ProtoReflect() protoreflect.Message {
+func (x *StatusScenariosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3141,97 +3573,106 @@ func (x *UI_Settings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UI_Settings.ProtoReflect.Descriptor instead.
-func (*UI_Settings) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use StatusScenariosResponse.ProtoReflect.Descriptor instead.
+func (*StatusScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *UI_Settings) GetWidth() uint32 {
+func (x *StatusScenariosResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Width
+		return x.Diagnostics
 	}
-	return 0
+	return nil
 }
 
-func (x *UI_Settings) GetIsTty() bool {
+func (x *StatusScenariosResponse) GetDecode() *DecodeResponse {
 	if x != nil {
-		return x.IsTty
+		return x.Decode
 	}
-	return false
+	return nil
 }
 
-func (x *UI_Settings) GetUseColor() bool {
+func (x *StatusScenariosResponse) GetOperations() []*Ref_Operation {
 	if x != nil {
-		return x.UseColor
+		return x.Operations
 	}
-	return false
+	return nil
 }
 
-func (x *UI_Settings) GetFormat() UI_Settings_Format {
-	if x != nil {
-		return x.Format
-	}
-	return UI_Settings_FORMAT_UNSPECIFIED
-}
+type ListSamplesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *UI_Settings) GetLevel() UI_Settings_Level {
-	if x != nil {
-		return x.Level
-	}
-	return UI_Settings_LEVEL_UNSPECIFIED
+	Workspace *Workspace `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
 }
 
-func (x *UI_Settings) GetStdoutPath() string {
-	if x != nil {
-		return x.StdoutPath
+func (x *ListSamplesRequest) Reset() {
+	*x = ListSamplesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UI_Settings) GetStderrPath() string {
-	if x != nil {
-		return x.StderrPath
-	}
-	return ""
+func (x *ListSamplesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UI_Settings) GetFailOnWarnings() bool {
-	if x != nil {
-		return x.FailOnWarnings
-	}
-	return false
-}
+func (*ListSamplesRequest) ProtoMessage() {}
 
-type Diagnostic_Snippet struct {
+func (x *ListSamplesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSamplesRequest.ProtoReflect.Descriptor instead.
+func (*ListSamplesRequest) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListSamplesRequest) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+type ListSamplesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Context              string                        `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Code                 string                        `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	StartLine            int64                         `protobuf:"varint,3,opt,name=start_line,proto3" json:"start_line,omitempty"`
-	HighlightStartOffset int64                         `protobuf:"varint,4,opt,name=highlight_start_offset,proto3" json:"highlight_start_offset,omitempty"`
-	HighlightEndOffset   int64                         `protobuf:"varint,5,opt,name=highlight_end_offset,proto3" json:"highlight_end_offset,omitempty"`
-	Values               []*Diagnostic_ExpressionValue `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty"`
+	Diagnostics []*Diagnostic   `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Samples     []*Ref_Sample   `protobuf:"bytes,3,rep,name=samples,proto3" json:"samples,omitempty"`
 }
 
-func (x *Diagnostic_Snippet) Reset() {
-	*x = Diagnostic_Snippet{}
+func (x *ListSamplesResponse) Reset() {
+	*x = ListSamplesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[49]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Diagnostic_Snippet) String() string {
+func (x *ListSamplesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Diagnostic_Snippet) ProtoMessage() {}
+func (*ListSamplesResponse) ProtoMessage() {}
 
-func (x *Diagnostic_Snippet) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[49]
+func (x *ListSamplesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3242,79 +3683,58 @@ func (x *Diagnostic_Snippet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Diagnostic_Snippet.ProtoReflect.Descriptor instead.
-func (*Diagnostic_Snippet) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{1, 0}
-}
-
-func (x *Diagnostic_Snippet) GetContext() string {
-	if x != nil {
-		return x.Context
-	}
-	return ""
-}
-
-func (x *Diagnostic_Snippet) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
-}
-
-func (x *Diagnostic_Snippet) GetStartLine() int64 {
-	if x != nil {
-		return x.StartLine
-	}
-	return 0
+// Deprecated: Use ListSamplesResponse.ProtoReflect.Descriptor instead.
+func (*ListSamplesResponse) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Diagnostic_Snippet) GetHighlightStartOffset() int64 {
+func (x *ListSamplesResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.HighlightStartOffset
+		return x.Diagnostics
 	}
-	return 0
+	return nil
 }
 
-func (x *Diagnostic_Snippet) GetHighlightEndOffset() int64 {
+func (x *ListSamplesResponse) GetDecode() *DecodeResponse {
 	if x != nil {
-		return x.HighlightEndOffset
+		return x.Decode
 	}
-	return 0
+	return nil
 }
 
-func (x *Diagnostic_Snippet) GetValues() []*Diagnostic_ExpressionValue {
+func (x *ListSamplesResponse) GetSamples() []*Ref_Sample {
 	if x != nil {
-		return x.Values
+		return x.Samples
 	}
 	return nil
 }
 
-type Diagnostic_ExpressionValue struct {
+type ObserveSampleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Traversal string `protobuf:"bytes,1,opt,name=traversal,proto3" json:"traversal,omitempty"`
-	Statement string `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	Workspace *Workspace     `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Filter    *Sample_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *Diagnostic_ExpressionValue) Reset() {
-	*x = Diagnostic_ExpressionValue{}
+func (x *ObserveSampleRequest) Reset() {
+	*x = ObserveSampleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[50]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Diagnostic_ExpressionValue) String() string {
+func (x *ObserveSampleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Diagnostic_ExpressionValue) ProtoMessage() {}
+func (*ObserveSampleRequest) ProtoMessage() {}
 
-func (x *Diagnostic_ExpressionValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[50]
+func (x *ObserveSampleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3325,52 +3745,52 @@ func (x *Diagnostic_ExpressionValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Diagnostic_ExpressionValue.ProtoReflect.Descriptor instead.
-func (*Diagnostic_ExpressionValue) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use ObserveSampleRequest.ProtoReflect.Descriptor instead.
+func (*ObserveSampleRequest) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *Diagnostic_ExpressionValue) GetTraversal() string {
+func (x *ObserveSampleRequest) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.Traversal
+		return x.Workspace
 	}
-	return ""
+	return nil
 }
 
-func (x *Diagnostic_ExpressionValue) GetStatement() string {
+func (x *ObserveSampleRequest) GetFilter() *Sample_Filter {
 	if x != nil {
-		return x.Statement
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-type Range_Pos struct {
+type ObserveSampleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Line   int64 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
-	Column int64 `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
-	Byte   int64 `protobuf:"varint,3,opt,name=byte,proto3" json:"byte,omitempty"`
+	Diagnostics []*Diagnostic       `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse     `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Observation *Sample_Observation `protobuf:"bytes,3,opt,name=observation,proto3" json:"observation,omitempty"`
 }
 
-func (x *Range_Pos) Reset() {
-	*x = Range_Pos{}
+func (x *ObserveSampleResponse) Reset() {
+	*x = ObserveSampleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[51]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Range_Pos) String() string {
+func (x *ObserveSampleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Range_Pos) ProtoMessage() {}
+func (*ObserveSampleResponse) ProtoMessage() {}
 
-func (x *Range_Pos) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[51]
+func (x *ObserveSampleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3381,62 +3801,58 @@ func (x *Range_Pos) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Range_Pos.ProtoReflect.Descriptor instead.
-func (*Range_Pos) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use ObserveSampleResponse.ProtoReflect.Descriptor instead.
+func (*ObserveSampleResponse) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *Range_Pos) GetLine() int64 {
+func (x *ObserveSampleResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Line
+		return x.Diagnostics
 	}
-	return 0
+	return nil
 }
 
-func (x *Range_Pos) GetColumn() int64 {
+func (x *ObserveSampleResponse) GetDecode() *DecodeResponse {
 	if x != nil {
-		return x.Column
+		return x.Decode
 	}
-	return 0
+	return nil
 }
 
-func (x *Range_Pos) GetByte() int64 {
+func (x *ObserveSampleResponse) GetObservation() *Sample_Observation {
 	if x != nil {
-		return x.Byte
+		return x.Observation
 	}
-	return 0
+	return nil
 }
 
-// ID is the unique identification of the scenario and its variants.
-type Scenario_ID struct {
+type FormatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Variants    *Matrix_Vector `protobuf:"bytes,2,opt,name=variants,proto3" json:"variants,omitempty"`
-	Uid         string         `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
-	Filter      string         `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
-	Description string         `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Files  []*FormatRequest_File `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Config *FormatRequest_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *Scenario_ID) Reset() {
-	*x = Scenario_ID{}
+func (x *FormatRequest) Reset() {
+	*x = FormatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[54]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Scenario_ID) String() string {
+func (x *FormatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Scenario_ID) ProtoMessage() {}
+func (*FormatRequest) ProtoMessage() {}
 
-func (x *Scenario_ID) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[54]
+func (x *FormatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3447,76 +3863,51 @@ func (x *Scenario_ID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Scenario_ID.ProtoReflect.Descriptor instead.
-func (*Scenario_ID) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{6, 0}
-}
-
-func (x *Scenario_ID) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use FormatRequest.ProtoReflect.Descriptor instead.
+func (*FormatRequest) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *Scenario_ID) GetVariants() *Matrix_Vector {
+func (x *FormatRequest) GetFiles() []*FormatRequest_File {
 	if x != nil {
-		return x.Variants
+		return x.Files
 	}
 	return nil
 }
 
-func (x *Scenario_ID) GetUid() string {
-	if x != nil {
-		return x.Uid
-	}
-	return ""
-}
-
-func (x *Scenario_ID) GetFilter() string {
-	if x != nil {
-		return x.Filter
-	}
-	return ""
-}
-
-func (x *Scenario_ID) GetDescription() string {
+func (x *FormatRequest) GetConfig() *FormatRequest_Config {
 	if x != nil {
-		return x.Description
+		return x.Config
 	}
-	return ""
+	return nil
 }
 
-// Filter is a scenario filter.
-type Scenario_Filter struct {
+type FormatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name               string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	SelectAll          *Scenario_Filter_SelectAll `protobuf:"bytes,2,opt,name=select_all,proto3" json:"select_all,omitempty"`
-	Include            *Matrix_Vector             `protobuf:"bytes,3,opt,name=include,proto3" json:"include,omitempty"`
-	Exclude            []*Matrix_Exclude          `protobuf:"bytes,4,rep,name=exclude,proto3" json:"exclude,omitempty"`
-	IntersectionMatrix *Matrix                    `protobuf:"bytes,5,opt,name=intersection_matrix,proto3" json:"intersection_matrix,omitempty"`
+	Diagnostics []*Diagnostic              `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Responses   []*FormatResponse_Response `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
 }
 
-func (x *Scenario_Filter) Reset() {
-	*x = Scenario_Filter{}
+func (x *FormatResponse) Reset() {
+	*x = FormatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[55]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Scenario_Filter) String() string {
+func (x *FormatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Scenario_Filter) ProtoMessage() {}
+func (*FormatResponse) ProtoMessage() {}
 
-func (x *Scenario_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[55]
+func (x *FormatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3527,59 +3918,83 @@ func (x *Scenario_Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Scenario_Filter.ProtoReflect.Descriptor instead.
-func (*Scenario_Filter) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{6, 1}
+// Deprecated: Use FormatResponse.ProtoReflect.Descriptor instead.
+func (*FormatResponse) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Scenario_Filter) GetName() string {
+func (x *FormatResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Name
+		return x.Diagnostics
 	}
-	return ""
+	return nil
 }
 
-func (x *Scenario_Filter) GetSelectAll() *Scenario_Filter_SelectAll {
+func (x *FormatResponse) GetResponses() []*FormatResponse_Response {
 	if x != nil {
-		return x.SelectAll
+		return x.Responses
 	}
 	return nil
 }
 
-func (x *Scenario_Filter) GetInclude() *Matrix_Vector {
-	if x != nil {
-		return x.Include
+type OperationEventStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op *Ref_Operation `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+}
+
+func (x *OperationEventStreamRequest) Reset() {
+	*x = OperationEventStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Scenario_Filter) GetExclude() []*Matrix_Exclude {
-	if x != nil {
-		return x.Exclude
+func (x *OperationEventStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationEventStreamRequest) ProtoMessage() {}
+
+func (x *OperationEventStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Scenario_Filter) GetIntersectionMatrix() *Matrix {
+// Deprecated: Use OperationEventStreamRequest.ProtoReflect.Descriptor instead.
+func (*OperationEventStreamRequest) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *OperationEventStreamRequest) GetOp() *Ref_Operation {
 	if x != nil {
-		return x.IntersectionMatrix
+		return x.Op
 	}
 	return nil
 }
 
-type Scenario_Outline struct {
+type OperationEventStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Scenario *Ref_Scenario            `protobuf:"bytes,1,opt,name=scenario,proto3" json:"scenario,omitempty"`
-	Matrix   *Matrix                  `protobuf:"bytes,2,opt,name=matrix,proto3" json:"matrix,omitempty"`
-	Steps    []*Scenario_Outline_Step `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
-	Verifies []*Quality               `protobuf:"bytes,4,rep,name=verifies,proto3" json:"verifies,omitempty"`
+	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Event       *Operation_Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
 }
 
-func (x *Scenario_Outline) Reset() {
-	*x = Scenario_Outline{}
+func (x *OperationEventStreamResponse) Reset() {
+	*x = OperationEventStreamResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3587,13 +4002,13 @@ func (x *Scenario_Outline) Reset() {
 	}
 }
 
-func (x *Scenario_Outline) String() string {
+func (x *OperationEventStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Scenario_Outline) ProtoMessage() {}
+func (*OperationEventStreamResponse) ProtoMessage() {}
 
-func (x *Scenario_Outline) ProtoReflect() protoreflect.Message {
+func (x *OperationEventStreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3605,47 +4020,35 @@ func (x *Scenario_Outline) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Scenario_Outline.ProtoReflect.Descriptor instead.
-func (*Scenario_Outline) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{6, 2}
-}
-
-func (x *Scenario_Outline) GetScenario() *Ref_Scenario {
-	if x != nil {
-		return x.Scenario
-	}
-	return nil
-}
-
-func (x *Scenario_Outline) GetMatrix() *Matrix {
-	if x != nil {
-		return x.Matrix
-	}
-	return nil
+// Deprecated: Use OperationEventStreamResponse.ProtoReflect.Descriptor instead.
+func (*OperationEventStreamResponse) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *Scenario_Outline) GetSteps() []*Scenario_Outline_Step {
+func (x *OperationEventStreamResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Steps
+		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *Scenario_Outline) GetVerifies() []*Quality {
+func (x *OperationEventStreamResponse) GetEvent() *Operation_Event {
 	if x != nil {
-		return x.Verifies
+		return x.Event
 	}
 	return nil
 }
 
-type Scenario_Filter_SelectAll struct {
+type OperationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Op *Ref_Operation `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
 }
 
-func (x *Scenario_Filter_SelectAll) Reset() {
-	*x = Scenario_Filter_SelectAll{}
+func (x *OperationRequest) Reset() {
+	*x = OperationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3653,13 +4056,13 @@ func (x *Scenario_Filter_SelectAll) Reset() {
 	}
 }
 
-func (x *Scenario_Filter_SelectAll) String() string {
+func (x *OperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Scenario_Filter_SelectAll) ProtoMessage() {}
+func (*OperationRequest) ProtoMessage() {}
 
-func (x *Scenario_Filter_SelectAll) ProtoReflect() protoreflect.Message {
+func (x *OperationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3671,23 +4074,29 @@ func (x *Scenario_Filter_SelectAll) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Scenario_Filter_SelectAll.ProtoReflect.Descriptor instead.
-func (*Scenario_Filter_SelectAll) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{6, 1, 0}
+// Deprecated: Use OperationRequest.ProtoReflect.Descriptor instead.
+func (*OperationRequest) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{57}
 }
 
-type Scenario_Outline_Step struct {
+func (x *OperationRequest) GetOp() *Ref_Operation {
+	if x != nil {
+		return x.Op
+	}
+	return nil
+}
+
+type OperationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Verifies    []*Quality `protobuf:"bytes,3,rep,name=verifies,proto3" json:"verifies,omitempty"`
+	Diagnostics []*Diagnostic       `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Response    *Operation_Response `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *Scenario_Outline_Step) Reset() {
-	*x = Scenario_Outline_Step{}
+func (x *OperationResponse) Reset() {
+	*x = OperationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3695,13 +4104,13 @@ func (x *Scenario_Outline_Step) Reset() {
 	}
 }
 
-func (x *Scenario_Outline_Step) String() string {
+func (x *OperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Scenario_Outline_Step) ProtoMessage() {}
+func (*OperationResponse) ProtoMessage() {}
 
-func (x *Scenario_Outline_Step) ProtoReflect() protoreflect.Message {
+func (x *OperationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3713,43 +4122,37 @@ func (x *Scenario_Outline_Step) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Scenario_Outline_Step.ProtoReflect.Descriptor instead.
-func (*Scenario_Outline_Step) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{6, 2, 0}
-}
-
-func (x *Scenario_Outline_Step) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use OperationResponse.ProtoReflect.Descriptor instead.
+func (*OperationResponse) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *Scenario_Outline_Step) GetDescription() string {
+func (x *OperationResponse) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Description
+		return x.Diagnostics
 	}
-	return ""
+	return nil
 }
 
-func (x *Scenario_Outline_Step) GetVerifies() []*Quality {
+func (x *OperationResponse) GetResponse() *Operation_Response {
 	if x != nil {
-		return x.Verifies
+		return x.Response
 	}
 	return nil
 }
 
-type Operator_Config struct {
+type OperationResponses struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// How many operation workers to run
-	WorkerCount int32 `protobuf:"varint,1,opt,name=worker_count,json=workerCount,proto3" json:"worker_count,omitempty"`
+	Diagnostics []*Diagnostic         `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse       `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Responses   []*Operation_Response `protobuf:"bytes,3,rep,name=responses,proto3" json:"responses,omitempty"`
 }
 
-func (x *Operator_Config) Reset() {
-	*x = Operator_Config{}
+func (x *OperationResponses) Reset() {
+	*x = OperationResponses{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3757,13 +4160,13 @@ func (x *Operator_Config) Reset() {
 	}
 }
 
-func (x *Operator_Config) String() string {
+func (x *OperationResponses) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operator_Config) ProtoMessage() {}
+func (*OperationResponses) ProtoMessage() {}
 
-func (x *Operator_Config) ProtoReflect() protoreflect.Message {
+func (x *OperationResponses) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3775,42 +4178,49 @@ func (x *Operator_Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operator_Config.ProtoReflect.Descriptor instead.
-func (*Operator_Config) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use OperationResponses.ProtoReflect.Descriptor instead.
+func (*OperationResponses) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *Operator_Config) GetWorkerCount() int32 {
+func (x *OperationResponses) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.WorkerCount
+		return x.Diagnostics
 	}
-	return 0
+	return nil
 }
 
-// Request is an operation request. This is passed when making requests to
-// a server.
-type Operation_Request struct {
+func (x *OperationResponses) GetDecode() *DecodeResponse {
+	if x != nil {
+		return x.Decode
+	}
+	return nil
+}
+
+func (x *OperationResponses) GetResponses() []*Operation_Response {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+// OperationRecord is a single entry of an operation recording. A recording is
+// every operation event that the client received followed by the final
+// operation responses.
+type OperationRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Scenario  *Ref_Scenario `protobuf:"bytes,1,opt,name=scenario,proto3" json:"scenario,omitempty"`
-	Workspace *Workspace    `protobuf:"bytes,2,opt,name=workspace,proto3" json:"workspace,omitempty"`
-	Id        string        `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 	// Types that are assignable to Value:
 	//
-	//	*Operation_Request_Generate_
-	//	*Operation_Request_Check_
-	//	*Operation_Request_Launch_
-	//	*Operation_Request_Destroy_
-	//	*Operation_Request_Run_
-	//	*Operation_Request_Exec_
-	//	*Operation_Request_Output_
-	Value isOperation_Request_Value `protobuf_oneof:"value"`
+	//	*OperationRecord_Event
+	//	*OperationRecord_Responses
+	Value isOperationRecord_Value `protobuf_oneof:"value"`
 }
 
-func (x *Operation_Request) Reset() {
-	*x = Operation_Request{}
+func (x *OperationRecord) Reset() {
+	*x = OperationRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3818,13 +4228,13 @@ func (x *Operation_Request) Reset() {
 	}
 }
 
-func (x *Operation_Request) String() string {
+func (x *OperationRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Request) ProtoMessage() {}
+func (*OperationRecord) ProtoMessage() {}
 
-func (x *Operation_Request) ProtoReflect() protoreflect.Message {
+func (x *OperationRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3836,178 +4246,204 @@ func (x *Operation_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Request.ProtoReflect.Descriptor instead.
-func (*Operation_Request) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use OperationRecord.ProtoReflect.Descriptor instead.
+func (*OperationRecord) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *Operation_Request) GetScenario() *Ref_Scenario {
-	if x != nil {
-		return x.Scenario
+func (m *OperationRecord) GetValue() isOperationRecord_Value {
+	if m != nil {
+		return m.Value
 	}
 	return nil
 }
 
-func (x *Operation_Request) GetWorkspace() *Workspace {
-	if x != nil {
-		return x.Workspace
+func (x *OperationRecord) GetEvent() *Operation_Event {
+	if x, ok := x.GetValue().(*OperationRecord_Event); ok {
+		return x.Event
 	}
 	return nil
 }
 
-func (x *Operation_Request) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *OperationRecord) GetResponses() *OperationResponses {
+	if x, ok := x.GetValue().(*OperationRecord_Responses); ok {
+		return x.Responses
 	}
-	return ""
+	return nil
 }
 
-func (m *Operation_Request) GetValue() isOperation_Request_Value {
-	if m != nil {
-		return m.Value
-	}
-	return nil
+type isOperationRecord_Value interface {
+	isOperationRecord_Value()
 }
 
-func (x *Operation_Request) GetGenerate() *Operation_Request_Generate {
-	if x, ok := x.GetValue().(*Operation_Request_Generate_); ok {
-		return x.Generate
-	}
-	return nil
+type OperationRecord_Event struct {
+	Event *Operation_Event `protobuf:"bytes,1,opt,name=event,proto3,oneof"`
 }
 
-func (x *Operation_Request) GetCheck() *Operation_Request_Check {
-	if x, ok := x.GetValue().(*Operation_Request_Check_); ok {
-		return x.Check
-	}
-	return nil
+type OperationRecord_Responses struct {
+	Responses *OperationResponses `protobuf:"bytes,2,opt,name=responses,proto3,oneof"`
 }
 
-func (x *Operation_Request) GetLaunch() *Operation_Request_Launch {
-	if x, ok := x.GetValue().(*Operation_Request_Launch_); ok {
-		return x.Launch
-	}
-	return nil
+func (*OperationRecord_Event) isOperationRecord_Value() {}
+
+func (*OperationRecord_Responses) isOperationRecord_Value() {}
+
+type OutlineScenariosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *Operation_Request) GetDestroy() *Operation_Request_Destroy {
-	if x, ok := x.GetValue().(*Operation_Request_Destroy_); ok {
-		return x.Destroy
+func (x *OutlineScenariosRequest) Reset() {
+	*x = OutlineScenariosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Operation_Request) GetRun() *Operation_Request_Run {
-	if x, ok := x.GetValue().(*Operation_Request_Run_); ok {
-		return x.Run
+func (x *OutlineScenariosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutlineScenariosRequest) ProtoMessage() {}
+
+func (x *OutlineScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Operation_Request) GetExec() *Operation_Request_Exec {
-	if x, ok := x.GetValue().(*Operation_Request_Exec_); ok {
-		return x.Exec
+// Deprecated: Use OutlineScenariosRequest.ProtoReflect.Descriptor instead.
+func (*OutlineScenariosRequest) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *OutlineScenariosRequest) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
 	}
 	return nil
 }
 
-func (x *Operation_Request) GetOutput() *Operation_Request_Output {
-	if x, ok := x.GetValue().(*Operation_Request_Output_); ok {
-		return x.Output
+func (x *OutlineScenariosRequest) GetFilter() *Scenario_Filter {
+	if x != nil {
+		return x.Filter
 	}
 	return nil
 }
 
-type isOperation_Request_Value interface {
-	isOperation_Request_Value()
-}
+type OutlineScenariosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type Operation_Request_Generate_ struct {
-	Generate *Operation_Request_Generate `protobuf:"bytes,11,opt,name=generate,proto3,oneof"`
+	Diagnostics []*Diagnostic       `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse     `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Outlines    []*Scenario_Outline `protobuf:"bytes,3,rep,name=outlines,proto3" json:"outlines,omitempty"`
+	Verifies    []*Quality          `protobuf:"bytes,4,rep,name=verifies,proto3" json:"verifies,omitempty"`
 }
 
-type Operation_Request_Check_ struct {
-	Check *Operation_Request_Check `protobuf:"bytes,12,opt,name=check,proto3,oneof"`
+func (x *OutlineScenariosResponse) Reset() {
+	*x = OutlineScenariosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type Operation_Request_Launch_ struct {
-	Launch *Operation_Request_Launch `protobuf:"bytes,13,opt,name=launch,proto3,oneof"`
+func (x *OutlineScenariosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Operation_Request_Destroy_ struct {
-	Destroy *Operation_Request_Destroy `protobuf:"bytes,14,opt,name=destroy,proto3,oneof"`
-}
+func (*OutlineScenariosResponse) ProtoMessage() {}
 
-type Operation_Request_Run_ struct {
-	Run *Operation_Request_Run `protobuf:"bytes,15,opt,name=run,proto3,oneof"`
+func (x *OutlineScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Operation_Request_Exec_ struct {
-	Exec *Operation_Request_Exec `protobuf:"bytes,16,opt,name=exec,proto3,oneof"`
+// Deprecated: Use OutlineScenariosResponse.ProtoReflect.Descriptor instead.
+func (*OutlineScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{62}
 }
 
-type Operation_Request_Output_ struct {
-	Output *Operation_Request_Output `protobuf:"bytes,17,opt,name=output,proto3,oneof"`
+func (x *OutlineScenariosResponse) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
 }
 
-func (*Operation_Request_Generate_) isOperation_Request_Value() {}
-
-func (*Operation_Request_Check_) isOperation_Request_Value() {}
-
-func (*Operation_Request_Launch_) isOperation_Request_Value() {}
-
-func (*Operation_Request_Destroy_) isOperation_Request_Value() {}
-
-func (*Operation_Request_Run_) isOperation_Request_Value() {}
+func (x *OutlineScenariosResponse) GetDecode() *DecodeResponse {
+	if x != nil {
+		return x.Decode
+	}
+	return nil
+}
 
-func (*Operation_Request_Exec_) isOperation_Request_Value() {}
+func (x *OutlineScenariosResponse) GetOutlines() []*Scenario_Outline {
+	if x != nil {
+		return x.Outlines
+	}
+	return nil
+}
 
-func (*Operation_Request_Output_) isOperation_Request_Value() {}
+func (x *OutlineScenariosResponse) GetVerifies() []*Quality {
+	if x != nil {
+		return x.Verifies
+	}
+	return nil
+}
 
-// Response is an operation response from a server. The value of the response
-// will either be the full response for the operation type or a partial
-// event update.
-type Operation_Response struct {
+type CleanScenariosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Op          *Ref_Operation   `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
-	Status      Operation_Status `protobuf:"varint,3,opt,name=status,proto3,enum=hashicorp.enos.v1.Operation_Status" json:"status,omitempty"`
-	// NOTE: If you add a new or modify value type be sure to update theoperation
-	// operation helpers in the diagnostics package and the response writer init
-	// the ui package.
-	//
-	// Types that are assignable to Value:
-	//
-	//	*Operation_Response_Generate_
-	//	*Operation_Response_Check_
-	//	*Operation_Response_Launch_
-	//	*Operation_Response_Destroy_
-	//	*Operation_Response_Run_
-	//	*Operation_Response_Exec_
-	//	*Operation_Response_Output_
-	Value isOperation_Response_Value `protobuf_oneof:"value"`
+	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// dry_run reports what would be cleaned without removing anything
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+	// force removes generated modules even if they might have non-empty state
+	Force bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (x *Operation_Response) Reset() {
-	*x = Operation_Response{}
+func (x *CleanScenariosRequest) Reset() {
+	*x = CleanScenariosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[61]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Response) String() string {
+func (x *CleanScenariosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Response) ProtoMessage() {}
+func (*CleanScenariosRequest) ProtoMessage() {}
 
-func (x *Operation_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[61]
+func (x *CleanScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4018,184 +4454,203 @@ func (x *Operation_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Response.ProtoReflect.Descriptor instead.
-func (*Operation_Response) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 1}
+// Deprecated: Use CleanScenariosRequest.ProtoReflect.Descriptor instead.
+func (*CleanScenariosRequest) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *Operation_Response) GetDiagnostics() []*Diagnostic {
+func (x *CleanScenariosRequest) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.Diagnostics
+		return x.Workspace
 	}
 	return nil
 }
 
-func (x *Operation_Response) GetOp() *Ref_Operation {
+func (x *CleanScenariosRequest) GetFilter() *Scenario_Filter {
 	if x != nil {
-		return x.Op
+		return x.Filter
 	}
 	return nil
 }
 
-func (x *Operation_Response) GetStatus() Operation_Status {
+func (x *CleanScenariosRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Status
+		return x.DryRun
 	}
-	return Operation_STATUS_UNSPECIFIED
+	return false
 }
 
-func (m *Operation_Response) GetValue() isOperation_Response_Value {
-	if m != nil {
-		return m.Value
+func (x *CleanScenariosRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
 	}
-	return nil
+	return false
 }
 
-func (x *Operation_Response) GetGenerate() *Operation_Response_Generate {
-	if x, ok := x.GetValue().(*Operation_Response_Generate_); ok {
-		return x.Generate
-	}
-	return nil
+type CleanScenariosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic                    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse                  `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Modules     []*CleanScenariosResponse_Module `protobuf:"bytes,3,rep,name=modules,proto3" json:"modules,omitempty"`
+	DryRun      bool                             `protobuf:"varint,4,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *Operation_Response) GetCheck() *Operation_Response_Check {
-	if x, ok := x.GetValue().(*Operation_Response_Check_); ok {
-		return x.Check
+func (x *CleanScenariosResponse) Reset() {
+	*x = CleanScenariosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Operation_Response) GetLaunch() *Operation_Response_Launch {
-	if x, ok := x.GetValue().(*Operation_Response_Launch_); ok {
-		return x.Launch
-	}
-	return nil
+func (x *CleanScenariosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Operation_Response) GetDestroy() *Operation_Response_Destroy {
-	if x, ok := x.GetValue().(*Operation_Response_Destroy_); ok {
-		return x.Destroy
+func (*CleanScenariosResponse) ProtoMessage() {}
+
+func (x *CleanScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Operation_Response) GetRun() *Operation_Response_Run {
-	if x, ok := x.GetValue().(*Operation_Response_Run_); ok {
-		return x.Run
+// Deprecated: Use CleanScenariosResponse.ProtoReflect.Descriptor instead.
+func (*CleanScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CleanScenariosResponse) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *Operation_Response) GetExec() *Operation_Response_Exec {
-	if x, ok := x.GetValue().(*Operation_Response_Exec_); ok {
-		return x.Exec
+func (x *CleanScenariosResponse) GetDecode() *DecodeResponse {
+	if x != nil {
+		return x.Decode
 	}
 	return nil
 }
 
-func (x *Operation_Response) GetOutput() *Operation_Response_Output {
-	if x, ok := x.GetValue().(*Operation_Response_Output_); ok {
-		return x.Output
+func (x *CleanScenariosResponse) GetModules() []*CleanScenariosResponse_Module {
+	if x != nil {
+		return x.Modules
 	}
 	return nil
 }
 
-type isOperation_Response_Value interface {
-	isOperation_Response_Value()
+func (x *CleanScenariosResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
 }
 
-type Operation_Response_Generate_ struct {
-	Generate *Operation_Response_Generate `protobuf:"bytes,11,opt,name=generate,proto3,oneof"`
-}
+// Quality describes a quality chracteristic that a scenario step can verify.
+type Quality struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type Operation_Response_Check_ struct {
-	Check *Operation_Response_Check `protobuf:"bytes,12,opt,name=check,proto3,oneof"`
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-type Operation_Response_Launch_ struct {
-	Launch *Operation_Response_Launch `protobuf:"bytes,13,opt,name=launch,proto3,oneof"`
+func (x *Quality) Reset() {
+	*x = Quality{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type Operation_Response_Destroy_ struct {
-	Destroy *Operation_Response_Destroy `protobuf:"bytes,14,opt,name=destroy,proto3,oneof"`
+func (x *Quality) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Operation_Response_Run_ struct {
-	Run *Operation_Response_Run `protobuf:"bytes,15,opt,name=run,proto3,oneof"`
-}
+func (*Quality) ProtoMessage() {}
 
-type Operation_Response_Exec_ struct {
-	Exec *Operation_Response_Exec `protobuf:"bytes,16,opt,name=exec,proto3,oneof"`
+func (x *Quality) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Operation_Response_Output_ struct {
-	Output *Operation_Response_Output `protobuf:"bytes,17,opt,name=output,proto3,oneof"`
+// Deprecated: Use Quality.ProtoReflect.Descriptor instead.
+func (*Quality) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{65}
 }
 
-func (*Operation_Response_Generate_) isOperation_Response_Value() {}
-
-func (*Operation_Response_Check_) isOperation_Response_Value() {}
-
-func (*Operation_Response_Launch_) isOperation_Response_Value() {}
-
-func (*Operation_Response_Destroy_) isOperation_Response_Value() {}
-
-func (*Operation_Response_Run_) isOperation_Response_Value() {}
-
-func (*Operation_Response_Exec_) isOperation_Response_Value() {}
+func (x *Quality) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-func (*Operation_Response_Output_) isOperation_Response_Value() {}
+func (x *Quality) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
 
-// Events operation updates that can be streamed to the client while an operation
-// is in progress
-type Operation_Event struct {
+type UI_Settings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Op          *Ref_Operation   `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
-	Status      Operation_Status `protobuf:"varint,3,opt,name=status,proto3,enum=hashicorp.enos.v1.Operation_Status" json:"status,omitempty"`
-	// done is how we tell event listeners that event stream is completed. The
-	// worker running the operation is responsible for setting this field when
-	// the associated workFunc is completed.
-	Done        bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
-	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
-	// NOTE: If you add a new value type you need to make sure you update the
-	// diagnostics and ui packages to handle the new event types.
-	//
-	// Types that are assignable to Value:
-	//
-	//	*Operation_Event_Decode
-	//	*Operation_Event_Generate
-	//	*Operation_Event_Init
-	//	*Operation_Event_Validate
-	//	*Operation_Event_Plan
-	//	*Operation_Event_Apply
-	//	*Operation_Event_Destroy
-	//	*Operation_Event_Exec
-	//	*Operation_Event_Output
-	//	*Operation_Event_Show
-	Value isOperation_Event_Value `protobuf_oneof:"value"`
+	Width    uint32             `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	IsTty    bool               `protobuf:"varint,2,opt,name=is_tty,proto3" json:"is_tty,omitempty"`
+	UseColor bool               `protobuf:"varint,3,opt,name=use_color,proto3" json:"use_color,omitempty"`
+	Format   UI_Settings_Format `protobuf:"varint,4,opt,name=format,proto3,enum=hashicorp.enos.v1.UI_Settings_Format" json:"format,omitempty"`
+	Level    UI_Settings_Level  `protobuf:"varint,5,opt,name=level,proto3,enum=hashicorp.enos.v1.UI_Settings_Level" json:"level,omitempty"`
+	// stdout_path if defined, we'll write our stdout messages to a file in
+	// the given path
+	StdoutPath string `protobuf:"bytes,6,opt,name=stdout_path,proto3" json:"stdout_path,omitempty"`
+	// stderr_path if defined, we'll write our stderr messages to a file in
+	// the given path
+	StderrPath string `protobuf:"bytes,7,opt,name=stderr_path,proto3" json:"stderr_path,omitempty"`
+	// Render warnings as failures
+	FailOnWarnings bool `protobuf:"varint,8,opt,name=fail_on_warnings,proto3" json:"fail_on_warnings,omitempty"`
 }
 
-func (x *Operation_Event) Reset() {
-	*x = Operation_Event{}
+func (x *UI_Settings) Reset() {
+	*x = UI_Settings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[62]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Event) String() string {
+func (x *UI_Settings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Event) ProtoMessage() {}
+func (*UI_Settings) ProtoMessage() {}
 
-func (x *Operation_Event) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[62]
+func (x *UI_Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4206,210 +4661,180 @@ func (x *Operation_Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Event.ProtoReflect.Descriptor instead.
-func (*Operation_Event) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 2}
+// Deprecated: Use UI_Settings.ProtoReflect.Descriptor instead.
+func (*UI_Settings) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{0, 0}
 }
 
-func (x *Operation_Event) GetDiagnostics() []*Diagnostic {
+func (x *UI_Settings) GetWidth() uint32 {
 	if x != nil {
-		return x.Diagnostics
+		return x.Width
 	}
-	return nil
+	return 0
 }
 
-func (x *Operation_Event) GetOp() *Ref_Operation {
+func (x *UI_Settings) GetIsTty() bool {
 	if x != nil {
-		return x.Op
+		return x.IsTty
 	}
-	return nil
+	return false
 }
 
-func (x *Operation_Event) GetStatus() Operation_Status {
+func (x *UI_Settings) GetUseColor() bool {
 	if x != nil {
-		return x.Status
+		return x.UseColor
 	}
-	return Operation_STATUS_UNSPECIFIED
+	return false
 }
 
-func (x *Operation_Event) GetDone() bool {
+func (x *UI_Settings) GetFormat() UI_Settings_Format {
 	if x != nil {
-		return x.Done
+		return x.Format
 	}
-	return false
+	return UI_Settings_FORMAT_UNSPECIFIED
 }
 
-func (x *Operation_Event) GetPublishedAt() *timestamppb.Timestamp {
+func (x *UI_Settings) GetLevel() UI_Settings_Level {
 	if x != nil {
-		return x.PublishedAt
+		return x.Level
 	}
-	return nil
+	return UI_Settings_LEVEL_UNSPECIFIED
 }
 
-func (m *Operation_Event) GetValue() isOperation_Event_Value {
-	if m != nil {
-		return m.Value
+func (x *UI_Settings) GetStdoutPath() string {
+	if x != nil {
+		return x.StdoutPath
 	}
-	return nil
+	return ""
 }
 
-func (x *Operation_Event) GetDecode() *DecodeResponse {
-	if x, ok := x.GetValue().(*Operation_Event_Decode); ok {
-		return x.Decode
+func (x *UI_Settings) GetStderrPath() string {
+	if x != nil {
+		return x.StderrPath
 	}
-	return nil
+	return ""
 }
 
-func (x *Operation_Event) GetGenerate() *Operation_Response_Generate {
-	if x, ok := x.GetValue().(*Operation_Event_Generate); ok {
-		return x.Generate
+func (x *UI_Settings) GetFailOnWarnings() bool {
+	if x != nil {
+		return x.FailOnWarnings
 	}
-	return nil
+	return false
 }
 
-func (x *Operation_Event) GetInit() *Terraform_Command_Init_Response {
-	if x, ok := x.GetValue().(*Operation_Event_Init); ok {
-		return x.Init
-	}
-	return nil
+type Diagnostic_Snippet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context              string                        `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Code                 string                        `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	StartLine            int64                         `protobuf:"varint,3,opt,name=start_line,proto3" json:"start_line,omitempty"`
+	HighlightStartOffset int64                         `protobuf:"varint,4,opt,name=highlight_start_offset,proto3" json:"highlight_start_offset,omitempty"`
+	HighlightEndOffset   int64                         `protobuf:"varint,5,opt,name=highlight_end_offset,proto3" json:"highlight_end_offset,omitempty"`
+	Values               []*Diagnostic_ExpressionValue `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty"`
 }
 
-func (x *Operation_Event) GetValidate() *Terraform_Command_Validate_Response {
-	if x, ok := x.GetValue().(*Operation_Event_Validate); ok {
-		return x.Validate
+func (x *Diagnostic_Snippet) Reset() {
+	*x = Diagnostic_Snippet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Operation_Event) GetPlan() *Terraform_Command_Plan_Response {
-	if x, ok := x.GetValue().(*Operation_Event_Plan); ok {
-		return x.Plan
-	}
-	return nil
+func (x *Diagnostic_Snippet) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Operation_Event) GetApply() *Terraform_Command_Apply_Response {
-	if x, ok := x.GetValue().(*Operation_Event_Apply); ok {
-		return x.Apply
+func (*Diagnostic_Snippet) ProtoMessage() {}
+
+func (x *Diagnostic_Snippet) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Operation_Event) GetDestroy() *Terraform_Command_Destroy_Response {
-	if x, ok := x.GetValue().(*Operation_Event_Destroy); ok {
-		return x.Destroy
-	}
-	return nil
+// Deprecated: Use Diagnostic_Snippet.ProtoReflect.Descriptor instead.
+func (*Diagnostic_Snippet) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{1, 0}
 }
 
-func (x *Operation_Event) GetExec() *Terraform_Command_Exec_Response {
-	if x, ok := x.GetValue().(*Operation_Event_Exec); ok {
-		return x.Exec
+func (x *Diagnostic_Snippet) GetContext() string {
+	if x != nil {
+		return x.Context
 	}
-	return nil
+	return ""
 }
 
-func (x *Operation_Event) GetOutput() *Terraform_Command_Output_Response {
-	if x, ok := x.GetValue().(*Operation_Event_Output); ok {
-		return x.Output
+func (x *Diagnostic_Snippet) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-func (x *Operation_Event) GetShow() *Terraform_Command_Show_Response {
-	if x, ok := x.GetValue().(*Operation_Event_Show); ok {
-		return x.Show
+func (x *Diagnostic_Snippet) GetStartLine() int64 {
+	if x != nil {
+		return x.StartLine
 	}
-	return nil
+	return 0
 }
 
-type isOperation_Event_Value interface {
-	isOperation_Event_Value()
-}
-
-type Operation_Event_Decode struct {
-	Decode *DecodeResponse `protobuf:"bytes,10,opt,name=decode,proto3,oneof"`
-}
-
-type Operation_Event_Generate struct {
-	Generate *Operation_Response_Generate `protobuf:"bytes,11,opt,name=generate,proto3,oneof"`
-}
-
-type Operation_Event_Init struct {
-	Init *Terraform_Command_Init_Response `protobuf:"bytes,12,opt,name=init,proto3,oneof"`
-}
-
-type Operation_Event_Validate struct {
-	Validate *Terraform_Command_Validate_Response `protobuf:"bytes,13,opt,name=validate,proto3,oneof"`
-}
-
-type Operation_Event_Plan struct {
-	Plan *Terraform_Command_Plan_Response `protobuf:"bytes,14,opt,name=plan,proto3,oneof"`
-}
-
-type Operation_Event_Apply struct {
-	Apply *Terraform_Command_Apply_Response `protobuf:"bytes,15,opt,name=apply,proto3,oneof"`
-}
-
-type Operation_Event_Destroy struct {
-	Destroy *Terraform_Command_Destroy_Response `protobuf:"bytes,16,opt,name=destroy,proto3,oneof"`
-}
-
-type Operation_Event_Exec struct {
-	Exec *Terraform_Command_Exec_Response `protobuf:"bytes,17,opt,name=exec,proto3,oneof"`
+func (x *Diagnostic_Snippet) GetHighlightStartOffset() int64 {
+	if x != nil {
+		return x.HighlightStartOffset
+	}
+	return 0
 }
 
-type Operation_Event_Output struct {
-	Output *Terraform_Command_Output_Response `protobuf:"bytes,18,opt,name=output,proto3,oneof"`
+func (x *Diagnostic_Snippet) GetHighlightEndOffset() int64 {
+	if x != nil {
+		return x.HighlightEndOffset
+	}
+	return 0
 }
 
-type Operation_Event_Show struct {
-	Show *Terraform_Command_Show_Response `protobuf:"bytes,19,opt,name=show,proto3,oneof"`
+func (x *Diagnostic_Snippet) GetValues() []*Diagnostic_ExpressionValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
 }
 
-func (*Operation_Event_Decode) isOperation_Event_Value() {}
-
-func (*Operation_Event_Generate) isOperation_Event_Value() {}
-
-func (*Operation_Event_Init) isOperation_Event_Value() {}
-
-func (*Operation_Event_Validate) isOperation_Event_Value() {}
-
-func (*Operation_Event_Plan) isOperation_Event_Value() {}
-
-func (*Operation_Event_Apply) isOperation_Event_Value() {}
-
-func (*Operation_Event_Destroy) isOperation_Event_Value() {}
-
-func (*Operation_Event_Exec) isOperation_Event_Value() {}
-
-func (*Operation_Event_Output) isOperation_Event_Value() {}
-
-func (*Operation_Event_Show) isOperation_Event_Value() {}
-
-type Operation_Request_Generate struct {
+type Diagnostic_ExpressionValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Traversal string `protobuf:"bytes,1,opt,name=traversal,proto3" json:"traversal,omitempty"`
+	Statement string `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
 }
 
-func (x *Operation_Request_Generate) Reset() {
-	*x = Operation_Request_Generate{}
+func (x *Diagnostic_ExpressionValue) Reset() {
+	*x = Diagnostic_ExpressionValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[63]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Request_Generate) String() string {
+func (x *Diagnostic_ExpressionValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Request_Generate) ProtoMessage() {}
+func (*Diagnostic_ExpressionValue) ProtoMessage() {}
 
-func (x *Operation_Request_Generate) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[63]
+func (x *Diagnostic_ExpressionValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4420,34 +4845,52 @@ func (x *Operation_Request_Generate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Request_Generate.ProtoReflect.Descriptor instead.
-func (*Operation_Request_Generate) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 0, 0}
+// Deprecated: Use Diagnostic_ExpressionValue.ProtoReflect.Descriptor instead.
+func (*Diagnostic_ExpressionValue) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{1, 1}
 }
 
-type Operation_Request_Check struct {
+func (x *Diagnostic_ExpressionValue) GetTraversal() string {
+	if x != nil {
+		return x.Traversal
+	}
+	return ""
+}
+
+func (x *Diagnostic_ExpressionValue) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+type Range_Pos struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Line   int64 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Column int64 `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	Byte   int64 `protobuf:"varint,3,opt,name=byte,proto3" json:"byte,omitempty"`
 }
 
-func (x *Operation_Request_Check) Reset() {
-	*x = Operation_Request_Check{}
+func (x *Range_Pos) Reset() {
+	*x = Range_Pos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[64]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Request_Check) String() string {
+func (x *Range_Pos) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Request_Check) ProtoMessage() {}
+func (*Range_Pos) ProtoMessage() {}
 
-func (x *Operation_Request_Check) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[64]
+func (x *Range_Pos) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4458,34 +4901,62 @@ func (x *Operation_Request_Check) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Request_Check.ProtoReflect.Descriptor instead.
-func (*Operation_Request_Check) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 0, 1}
+// Deprecated: Use Range_Pos.ProtoReflect.Descriptor instead.
+func (*Range_Pos) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{3, 0}
 }
 
-type Operation_Request_Launch struct {
+func (x *Range_Pos) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *Range_Pos) GetColumn() int64 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *Range_Pos) GetByte() int64 {
+	if x != nil {
+		return x.Byte
+	}
+	return 0
+}
+
+// ID is the unique identification of the scenario and its variants.
+type Scenario_ID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name        string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Variants    *Matrix_Vector `protobuf:"bytes,2,opt,name=variants,proto3" json:"variants,omitempty"`
+	Uid         string         `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	Filter      string         `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Description string         `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *Operation_Request_Launch) Reset() {
-	*x = Operation_Request_Launch{}
+func (x *Scenario_ID) Reset() {
+	*x = Scenario_ID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[65]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Request_Launch) String() string {
+func (x *Scenario_ID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Request_Launch) ProtoMessage() {}
+func (*Scenario_ID) ProtoMessage() {}
 
-func (x *Operation_Request_Launch) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[65]
+func (x *Scenario_ID) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4496,72 +4967,83 @@ func (x *Operation_Request_Launch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Request_Launch.ProtoReflect.Descriptor instead.
-func (*Operation_Request_Launch) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 0, 2}
+// Deprecated: Use Scenario_ID.ProtoReflect.Descriptor instead.
+func (*Scenario_ID) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{7, 0}
 }
 
-type Operation_Request_Destroy struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Scenario_ID) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *Operation_Request_Destroy) Reset() {
-	*x = Operation_Request_Destroy{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[66]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Scenario_ID) GetVariants() *Matrix_Vector {
+	if x != nil {
+		return x.Variants
 	}
+	return nil
 }
 
-func (x *Operation_Request_Destroy) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Scenario_ID) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
 }
 
-func (*Operation_Request_Destroy) ProtoMessage() {}
-
-func (x *Operation_Request_Destroy) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[66]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Scenario_ID) GetFilter() string {
+	if x != nil {
+		return x.Filter
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Operation_Request_Destroy.ProtoReflect.Descriptor instead.
-func (*Operation_Request_Destroy) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 0, 3}
+func (x *Scenario_ID) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-type Operation_Request_Run struct {
+// Filter is a scenario filter.
+type Scenario_Filter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name               string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SelectAll          *Scenario_Filter_SelectAll `protobuf:"bytes,2,opt,name=select_all,proto3" json:"select_all,omitempty"`
+	Include            *Matrix_Vector             `protobuf:"bytes,3,opt,name=include,proto3" json:"include,omitempty"`
+	Exclude            []*Matrix_Exclude          `protobuf:"bytes,4,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	IntersectionMatrix *Matrix                    `protobuf:"bytes,5,opt,name=intersection_matrix,proto3" json:"intersection_matrix,omitempty"`
+	// variants match variant values. Unlike include and exclude they support
+	// more than one value per variant key, globs, and regular expressions.
+	Variants []*Scenario_Filter_Match `protobuf:"bytes,6,rep,name=variants,proto3" json:"variants,omitempty"`
+	// verifies matches the qualities that a scenario verifies.
+	Verifies []*Scenario_Filter_Match `protobuf:"bytes,7,rep,name=verifies,proto3" json:"verifies,omitempty"`
+	// modules matches the modules used by a scenario's steps.
+	Modules []*Scenario_Filter_Match `protobuf:"bytes,8,rep,name=modules,proto3" json:"modules,omitempty"`
 }
 
-func (x *Operation_Request_Run) Reset() {
-	*x = Operation_Request_Run{}
+func (x *Scenario_Filter) Reset() {
+	*x = Scenario_Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[67]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Request_Run) String() string {
+func (x *Scenario_Filter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Request_Run) ProtoMessage() {}
+func (*Scenario_Filter) ProtoMessage() {}
 
-func (x *Operation_Request_Run) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[67]
+func (x *Scenario_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4572,34 +5054,95 @@ func (x *Operation_Request_Run) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Request_Run.ProtoReflect.Descriptor instead.
-func (*Operation_Request_Run) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 0, 4}
-}
+// Deprecated: Use Scenario_Filter.ProtoReflect.Descriptor instead.
+func (*Scenario_Filter) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{7, 1}
+}
 
-type Operation_Request_Exec struct {
+func (x *Scenario_Filter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Scenario_Filter) GetSelectAll() *Scenario_Filter_SelectAll {
+	if x != nil {
+		return x.SelectAll
+	}
+	return nil
+}
+
+func (x *Scenario_Filter) GetInclude() *Matrix_Vector {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *Scenario_Filter) GetExclude() []*Matrix_Exclude {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+func (x *Scenario_Filter) GetIntersectionMatrix() *Matrix {
+	if x != nil {
+		return x.IntersectionMatrix
+	}
+	return nil
+}
+
+func (x *Scenario_Filter) GetVariants() []*Scenario_Filter_Match {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Scenario_Filter) GetVerifies() []*Scenario_Filter_Match {
+	if x != nil {
+		return x.Verifies
+	}
+	return nil
+}
+
+func (x *Scenario_Filter) GetModules() []*Scenario_Filter_Match {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type Scenario_Outline struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Scenario *Ref_Scenario            `protobuf:"bytes,1,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Matrix   *Matrix                  `protobuf:"bytes,2,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	Steps    []*Scenario_Outline_Step `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	Verifies []*Quality               `protobuf:"bytes,4,rep,name=verifies,proto3" json:"verifies,omitempty"`
 }
 
-func (x *Operation_Request_Exec) Reset() {
-	*x = Operation_Request_Exec{}
+func (x *Scenario_Outline) Reset() {
+	*x = Scenario_Outline{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[68]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Request_Exec) String() string {
+func (x *Scenario_Outline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Request_Exec) ProtoMessage() {}
+func (*Scenario_Outline) ProtoMessage() {}
 
-func (x *Operation_Request_Exec) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[68]
+func (x *Scenario_Outline) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4610,34 +5153,62 @@ func (x *Operation_Request_Exec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Request_Exec.ProtoReflect.Descriptor instead.
-func (*Operation_Request_Exec) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 0, 5}
+// Deprecated: Use Scenario_Outline.ProtoReflect.Descriptor instead.
+func (*Scenario_Outline) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{7, 2}
 }
 
-type Operation_Request_Output struct {
+func (x *Scenario_Outline) GetScenario() *Ref_Scenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+func (x *Scenario_Outline) GetMatrix() *Matrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *Scenario_Outline) GetSteps() []*Scenario_Outline_Step {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *Scenario_Outline) GetVerifies() []*Quality {
+	if x != nil {
+		return x.Verifies
+	}
+	return nil
+}
+
+type Scenario_Filter_SelectAll struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Operation_Request_Output) Reset() {
-	*x = Operation_Request_Output{}
+func (x *Scenario_Filter_SelectAll) Reset() {
+	*x = Scenario_Filter_SelectAll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[69]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Request_Output) String() string {
+func (x *Scenario_Filter_SelectAll) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Request_Output) ProtoMessage() {}
+func (*Scenario_Filter_SelectAll) ProtoMessage() {}
 
-func (x *Operation_Request_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[69]
+func (x *Scenario_Filter_SelectAll) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4648,37 +5219,41 @@ func (x *Operation_Request_Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Request_Output.ProtoReflect.Descriptor instead.
-func (*Operation_Request_Output) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 0, 6}
+// Deprecated: Use Scenario_Filter_SelectAll.ProtoReflect.Descriptor instead.
+func (*Scenario_Filter_SelectAll) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{7, 1, 0}
 }
 
-type Operation_Response_Generate struct {
+// Match matches values against patterns. A pattern is either an exact
+// value, a glob, or a regular expression enclosed in slashes. A match
+// succeeds if any pattern matches, or if none match when negated.
+type Scenario_Filter_Match struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics     []*Diagnostic     `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	TerraformModule *Terraform_Module `protobuf:"bytes,2,opt,name=terraform_module,proto3" json:"terraform_module,omitempty"`
+	Key      string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Patterns []string `protobuf:"bytes,2,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	Negate   bool     `protobuf:"varint,3,opt,name=negate,proto3" json:"negate,omitempty"`
 }
 
-func (x *Operation_Response_Generate) Reset() {
-	*x = Operation_Response_Generate{}
+func (x *Scenario_Filter_Match) Reset() {
+	*x = Scenario_Filter_Match{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[70]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Response_Generate) String() string {
+func (x *Scenario_Filter_Match) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Response_Generate) ProtoMessage() {}
+func (*Scenario_Filter_Match) ProtoMessage() {}
 
-func (x *Operation_Response_Generate) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[70]
+func (x *Scenario_Filter_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4689,54 +5264,59 @@ func (x *Operation_Response_Generate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Response_Generate.ProtoReflect.Descriptor instead.
-func (*Operation_Response_Generate) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 1, 0}
+// Deprecated: Use Scenario_Filter_Match.ProtoReflect.Descriptor instead.
+func (*Scenario_Filter_Match) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{7, 1, 1}
 }
 
-func (x *Operation_Response_Generate) GetDiagnostics() []*Diagnostic {
+func (x *Scenario_Filter_Match) GetKey() string {
 	if x != nil {
-		return x.Diagnostics
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *Operation_Response_Generate) GetTerraformModule() *Terraform_Module {
+func (x *Scenario_Filter_Match) GetPatterns() []string {
 	if x != nil {
-		return x.TerraformModule
+		return x.Patterns
 	}
 	return nil
 }
 
-type Operation_Response_Check struct {
+func (x *Scenario_Filter_Match) GetNegate() bool {
+	if x != nil {
+		return x.Negate
+	}
+	return false
+}
+
+type Scenario_Outline_Step struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic                        `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Generate    *Operation_Response_Generate         `protobuf:"bytes,2,opt,name=generate,proto3" json:"generate,omitempty"`
-	Init        *Terraform_Command_Init_Response     `protobuf:"bytes,3,opt,name=init,proto3" json:"init,omitempty"`
-	Validate    *Terraform_Command_Validate_Response `protobuf:"bytes,4,opt,name=validate,proto3" json:"validate,omitempty"`
-	Plan        *Terraform_Command_Plan_Response     `protobuf:"bytes,5,opt,name=plan,proto3" json:"plan,omitempty"`
+	Name        string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Verifies    []*Quality `protobuf:"bytes,3,rep,name=verifies,proto3" json:"verifies,omitempty"`
 }
 
-func (x *Operation_Response_Check) Reset() {
-	*x = Operation_Response_Check{}
+func (x *Scenario_Outline_Step) Reset() {
+	*x = Scenario_Outline_Step{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[71]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Response_Check) String() string {
+func (x *Scenario_Outline_Step) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Response_Check) ProtoMessage() {}
+func (*Scenario_Outline_Step) ProtoMessage() {}
 
-func (x *Operation_Response_Check) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[71]
+func (x *Scenario_Outline_Step) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4747,76 +5327,58 @@ func (x *Operation_Response_Check) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Response_Check.ProtoReflect.Descriptor instead.
-func (*Operation_Response_Check) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 1, 1}
-}
-
-func (x *Operation_Response_Check) GetDiagnostics() []*Diagnostic {
-	if x != nil {
-		return x.Diagnostics
-	}
-	return nil
-}
-
-func (x *Operation_Response_Check) GetGenerate() *Operation_Response_Generate {
-	if x != nil {
-		return x.Generate
-	}
-	return nil
+// Deprecated: Use Scenario_Outline_Step.ProtoReflect.Descriptor instead.
+func (*Scenario_Outline_Step) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{7, 2, 0}
 }
 
-func (x *Operation_Response_Check) GetInit() *Terraform_Command_Init_Response {
+func (x *Scenario_Outline_Step) GetName() string {
 	if x != nil {
-		return x.Init
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Operation_Response_Check) GetValidate() *Terraform_Command_Validate_Response {
+func (x *Scenario_Outline_Step) GetDescription() string {
 	if x != nil {
-		return x.Validate
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *Operation_Response_Check) GetPlan() *Terraform_Command_Plan_Response {
+func (x *Scenario_Outline_Step) GetVerifies() []*Quality {
 	if x != nil {
-		return x.Plan
+		return x.Verifies
 	}
 	return nil
 }
 
-type Operation_Response_Launch struct {
+type Operator_Config struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic                        `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Generate    *Operation_Response_Generate         `protobuf:"bytes,2,opt,name=generate,proto3" json:"generate,omitempty"`
-	Init        *Terraform_Command_Init_Response     `protobuf:"bytes,3,opt,name=init,proto3" json:"init,omitempty"`
-	Validate    *Terraform_Command_Validate_Response `protobuf:"bytes,4,opt,name=validate,proto3" json:"validate,omitempty"`
-	Plan        *Terraform_Command_Plan_Response     `protobuf:"bytes,5,opt,name=plan,proto3" json:"plan,omitempty"`
-	Apply       *Terraform_Command_Apply_Response    `protobuf:"bytes,6,opt,name=apply,proto3" json:"apply,omitempty"`
+	// How many operation workers to run
+	WorkerCount int32 `protobuf:"varint,1,opt,name=worker_count,json=workerCount,proto3" json:"worker_count,omitempty"`
 }
 
-func (x *Operation_Response_Launch) Reset() {
-	*x = Operation_Response_Launch{}
+func (x *Operator_Config) Reset() {
+	*x = Operator_Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[72]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Response_Launch) String() string {
+func (x *Operator_Config) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Response_Launch) ProtoMessage() {}
+func (*Operator_Config) ProtoMessage() {}
 
-func (x *Operation_Response_Launch) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[72]
+func (x *Operator_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4827,82 +5389,47 @@ func (x *Operation_Response_Launch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Response_Launch.ProtoReflect.Descriptor instead.
-func (*Operation_Response_Launch) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 1, 2}
+// Deprecated: Use Operator_Config.ProtoReflect.Descriptor instead.
+func (*Operator_Config) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 0}
 }
 
-func (x *Operation_Response_Launch) GetDiagnostics() []*Diagnostic {
+func (x *Operator_Config) GetWorkerCount() int32 {
 	if x != nil {
-		return x.Diagnostics
+		return x.WorkerCount
 	}
-	return nil
+	return 0
 }
 
-func (x *Operation_Response_Launch) GetGenerate() *Operation_Response_Generate {
-	if x != nil {
-		return x.Generate
-	}
-	return nil
-}
+// FailurePolicy determines what happens to a scenario's resources when
+// launching the scenario fails.
+type Operation_FailurePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Operation_Response_Launch) GetInit() *Terraform_Command_Init_Response {
-	if x != nil {
-		return x.Init
-	}
-	return nil
+	Type Operation_FailurePolicy_Type `protobuf:"varint,1,opt,name=type,proto3,enum=hashicorp.enos.v1.Operation_FailurePolicy_Type" json:"type,omitempty"`
+	// keep_for is how long to keep the resources when the type is keep for
+	KeepFor *durationpb.Duration `protobuf:"bytes,2,opt,name=keep_for,proto3" json:"keep_for,omitempty"`
 }
 
-func (x *Operation_Response_Launch) GetValidate() *Terraform_Command_Validate_Response {
-	if x != nil {
-		return x.Validate
-	}
-	return nil
-}
-
-func (x *Operation_Response_Launch) GetPlan() *Terraform_Command_Plan_Response {
-	if x != nil {
-		return x.Plan
-	}
-	return nil
-}
-
-func (x *Operation_Response_Launch) GetApply() *Terraform_Command_Apply_Response {
-	if x != nil {
-		return x.Apply
-	}
-	return nil
-}
-
-type Operation_Response_Destroy struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Diagnostics    []*Diagnostic                       `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	PriorStateShow *Terraform_Command_Show_Response    `protobuf:"bytes,5,opt,name=prior_state_show,json=priorStateShow,proto3" json:"prior_state_show,omitempty"`
-	Generate       *Operation_Response_Generate        `protobuf:"bytes,2,opt,name=generate,proto3" json:"generate,omitempty"`
-	Init           *Terraform_Command_Init_Response    `protobuf:"bytes,4,opt,name=init,proto3" json:"init,omitempty"`
-	Destroy        *Terraform_Command_Destroy_Response `protobuf:"bytes,3,opt,name=destroy,proto3" json:"destroy,omitempty"`
-}
-
-func (x *Operation_Response_Destroy) Reset() {
-	*x = Operation_Response_Destroy{}
+func (x *Operation_FailurePolicy) Reset() {
+	*x = Operation_FailurePolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[73]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Response_Destroy) String() string {
+func (x *Operation_FailurePolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Response_Destroy) ProtoMessage() {}
+func (*Operation_FailurePolicy) ProtoMessage() {}
 
-func (x *Operation_Response_Destroy) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[73]
+func (x *Operation_FailurePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4913,78 +5440,65 @@ func (x *Operation_Response_Destroy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Response_Destroy.ProtoReflect.Descriptor instead.
-func (*Operation_Response_Destroy) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 1, 3}
-}
-
-func (x *Operation_Response_Destroy) GetDiagnostics() []*Diagnostic {
-	if x != nil {
-		return x.Diagnostics
-	}
-	return nil
-}
-
-func (x *Operation_Response_Destroy) GetPriorStateShow() *Terraform_Command_Show_Response {
-	if x != nil {
-		return x.PriorStateShow
-	}
-	return nil
-}
-
-func (x *Operation_Response_Destroy) GetGenerate() *Operation_Response_Generate {
-	if x != nil {
-		return x.Generate
-	}
-	return nil
+// Deprecated: Use Operation_FailurePolicy.ProtoReflect.Descriptor instead.
+func (*Operation_FailurePolicy) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 0}
 }
 
-func (x *Operation_Response_Destroy) GetInit() *Terraform_Command_Init_Response {
+func (x *Operation_FailurePolicy) GetType() Operation_FailurePolicy_Type {
 	if x != nil {
-		return x.Init
+		return x.Type
 	}
-	return nil
+	return Operation_FailurePolicy_TYPE_UNSPECIFIED
 }
 
-func (x *Operation_Response_Destroy) GetDestroy() *Terraform_Command_Destroy_Response {
+func (x *Operation_FailurePolicy) GetKeepFor() *durationpb.Duration {
 	if x != nil {
-		return x.Destroy
+		return x.KeepFor
 	}
 	return nil
 }
 
-type Operation_Response_Run struct {
+// Request is an operation request. This is passed when making requests to
+// a server.
+type Operation_Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics    []*Diagnostic                        `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Generate       *Operation_Response_Generate         `protobuf:"bytes,2,opt,name=generate,proto3" json:"generate,omitempty"`
-	Init           *Terraform_Command_Init_Response     `protobuf:"bytes,3,opt,name=init,proto3" json:"init,omitempty"`
-	Validate       *Terraform_Command_Validate_Response `protobuf:"bytes,4,opt,name=validate,proto3" json:"validate,omitempty"`
-	Plan           *Terraform_Command_Plan_Response     `protobuf:"bytes,5,opt,name=plan,proto3" json:"plan,omitempty"`
-	Apply          *Terraform_Command_Apply_Response    `protobuf:"bytes,6,opt,name=apply,proto3" json:"apply,omitempty"`
-	PriorStateShow *Terraform_Command_Show_Response     `protobuf:"bytes,8,opt,name=prior_state_show,json=priorStateShow,proto3" json:"prior_state_show,omitempty"`
-	Destroy        *Terraform_Command_Destroy_Response  `protobuf:"bytes,7,opt,name=destroy,proto3" json:"destroy,omitempty"`
+	Scenario  *Ref_Scenario `protobuf:"bytes,1,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Workspace *Workspace    `protobuf:"bytes,2,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Id        string        `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to Value:
+	//
+	//	*Operation_Request_Generate_
+	//	*Operation_Request_Check_
+	//	*Operation_Request_Launch_
+	//	*Operation_Request_Destroy_
+	//	*Operation_Request_Run_
+	//	*Operation_Request_Exec_
+	//	*Operation_Request_Output_
+	//	*Operation_Request_State_
+	Value isOperation_Request_Value `protobuf_oneof:"value"`
 }
 
-func (x *Operation_Response_Run) Reset() {
-	*x = Operation_Response_Run{}
+func (x *Operation_Request) Reset() {
+	*x = Operation_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[74]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation_Response_Run) String() string {
+func (x *Operation_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation_Response_Run) ProtoMessage() {}
+func (*Operation_Request) ProtoMessage() {}
 
-func (x *Operation_Response_Run) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[74]
+func (x *Operation_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4995,220 +5509,192 @@ func (x *Operation_Response_Run) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation_Response_Run.ProtoReflect.Descriptor instead.
-func (*Operation_Response_Run) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 1, 4}
+// Deprecated: Use Operation_Request.ProtoReflect.Descriptor instead.
+func (*Operation_Request) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1}
 }
 
-func (x *Operation_Response_Run) GetDiagnostics() []*Diagnostic {
+func (x *Operation_Request) GetScenario() *Ref_Scenario {
 	if x != nil {
-		return x.Diagnostics
+		return x.Scenario
 	}
 	return nil
 }
 
-func (x *Operation_Response_Run) GetGenerate() *Operation_Response_Generate {
+func (x *Operation_Request) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.Generate
+		return x.Workspace
 	}
 	return nil
 }
 
-func (x *Operation_Response_Run) GetInit() *Terraform_Command_Init_Response {
+func (x *Operation_Request) GetId() string {
 	if x != nil {
-		return x.Init
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *Operation_Response_Run) GetValidate() *Terraform_Command_Validate_Response {
-	if x != nil {
-		return x.Validate
+func (m *Operation_Request) GetValue() isOperation_Request_Value {
+	if m != nil {
+		return m.Value
 	}
 	return nil
 }
 
-func (x *Operation_Response_Run) GetPlan() *Terraform_Command_Plan_Response {
-	if x != nil {
-		return x.Plan
+func (x *Operation_Request) GetGenerate() *Operation_Request_Generate {
+	if x, ok := x.GetValue().(*Operation_Request_Generate_); ok {
+		return x.Generate
 	}
 	return nil
 }
 
-func (x *Operation_Response_Run) GetApply() *Terraform_Command_Apply_Response {
-	if x != nil {
-		return x.Apply
+func (x *Operation_Request) GetCheck() *Operation_Request_Check {
+	if x, ok := x.GetValue().(*Operation_Request_Check_); ok {
+		return x.Check
 	}
 	return nil
 }
 
-func (x *Operation_Response_Run) GetPriorStateShow() *Terraform_Command_Show_Response {
-	if x != nil {
-		return x.PriorStateShow
+func (x *Operation_Request) GetLaunch() *Operation_Request_Launch {
+	if x, ok := x.GetValue().(*Operation_Request_Launch_); ok {
+		return x.Launch
 	}
 	return nil
 }
 
-func (x *Operation_Response_Run) GetDestroy() *Terraform_Command_Destroy_Response {
-	if x != nil {
+func (x *Operation_Request) GetDestroy() *Operation_Request_Destroy {
+	if x, ok := x.GetValue().(*Operation_Request_Destroy_); ok {
 		return x.Destroy
 	}
 	return nil
 }
 
-type Operation_Response_Exec struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Diagnostics     []*Diagnostic                    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	TerraformModule *Terraform_Module                `protobuf:"bytes,2,opt,name=terraform_module,proto3" json:"terraform_module,omitempty"`
-	Exec            *Terraform_Command_Exec_Response `protobuf:"bytes,3,opt,name=exec,proto3" json:"exec,omitempty"`
+func (x *Operation_Request) GetRun() *Operation_Request_Run {
+	if x, ok := x.GetValue().(*Operation_Request_Run_); ok {
+		return x.Run
+	}
+	return nil
 }
 
-func (x *Operation_Response_Exec) Reset() {
-	*x = Operation_Response_Exec{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[75]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Operation_Request) GetExec() *Operation_Request_Exec {
+	if x, ok := x.GetValue().(*Operation_Request_Exec_); ok {
+		return x.Exec
 	}
+	return nil
 }
 
-func (x *Operation_Response_Exec) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Operation_Request) GetOutput() *Operation_Request_Output {
+	if x, ok := x.GetValue().(*Operation_Request_Output_); ok {
+		return x.Output
+	}
+	return nil
 }
 
-func (*Operation_Response_Exec) ProtoMessage() {}
-
-func (x *Operation_Response_Exec) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[75]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Operation_Request) GetState() *Operation_Request_State {
+	if x, ok := x.GetValue().(*Operation_Request_State_); ok {
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Operation_Response_Exec.ProtoReflect.Descriptor instead.
-func (*Operation_Response_Exec) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 1, 5}
+type isOperation_Request_Value interface {
+	isOperation_Request_Value()
 }
 
-func (x *Operation_Response_Exec) GetDiagnostics() []*Diagnostic {
-	if x != nil {
-		return x.Diagnostics
-	}
-	return nil
+type Operation_Request_Generate_ struct {
+	Generate *Operation_Request_Generate `protobuf:"bytes,11,opt,name=generate,proto3,oneof"`
 }
 
-func (x *Operation_Response_Exec) GetTerraformModule() *Terraform_Module {
-	if x != nil {
-		return x.TerraformModule
-	}
-	return nil
+type Operation_Request_Check_ struct {
+	Check *Operation_Request_Check `protobuf:"bytes,12,opt,name=check,proto3,oneof"`
 }
 
-func (x *Operation_Response_Exec) GetExec() *Terraform_Command_Exec_Response {
-	if x != nil {
-		return x.Exec
-	}
-	return nil
+type Operation_Request_Launch_ struct {
+	Launch *Operation_Request_Launch `protobuf:"bytes,13,opt,name=launch,proto3,oneof"`
 }
 
-type Operation_Response_Output struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type Operation_Request_Destroy_ struct {
+	Destroy *Operation_Request_Destroy `protobuf:"bytes,14,opt,name=destroy,proto3,oneof"`
+}
 
-	Diagnostics     []*Diagnostic                      `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	TerraformModule *Terraform_Module                  `protobuf:"bytes,2,opt,name=terraform_module,proto3" json:"terraform_module,omitempty"`
-	Output          *Terraform_Command_Output_Response `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+type Operation_Request_Run_ struct {
+	Run *Operation_Request_Run `protobuf:"bytes,15,opt,name=run,proto3,oneof"`
 }
 
-func (x *Operation_Response_Output) Reset() {
-	*x = Operation_Response_Output{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[76]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type Operation_Request_Exec_ struct {
+	Exec *Operation_Request_Exec `protobuf:"bytes,16,opt,name=exec,proto3,oneof"`
 }
 
-func (x *Operation_Response_Output) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type Operation_Request_Output_ struct {
+	Output *Operation_Request_Output `protobuf:"bytes,17,opt,name=output,proto3,oneof"`
 }
 
-func (*Operation_Response_Output) ProtoMessage() {}
+type Operation_Request_State_ struct {
+	State *Operation_Request_State `protobuf:"bytes,18,opt,name=state,proto3,oneof"`
+}
 
-func (x *Operation_Response_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[76]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
+func (*Operation_Request_Generate_) isOperation_Request_Value() {}
 
-// Deprecated: Use Operation_Response_Output.ProtoReflect.Descriptor instead.
-func (*Operation_Response_Output) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{8, 1, 6}
-}
+func (*Operation_Request_Check_) isOperation_Request_Value() {}
 
-func (x *Operation_Response_Output) GetDiagnostics() []*Diagnostic {
-	if x != nil {
-		return x.Diagnostics
-	}
-	return nil
-}
+func (*Operation_Request_Launch_) isOperation_Request_Value() {}
 
-func (x *Operation_Response_Output) GetTerraformModule() *Terraform_Module {
-	if x != nil {
-		return x.TerraformModule
-	}
-	return nil
-}
+func (*Operation_Request_Destroy_) isOperation_Request_Value() {}
 
-func (x *Operation_Response_Output) GetOutput() *Terraform_Command_Output_Response {
-	if x != nil {
-		return x.Output
-	}
-	return nil
-}
+func (*Operation_Request_Run_) isOperation_Request_Value() {}
 
-type Terraform_Module struct {
+func (*Operation_Request_Exec_) isOperation_Request_Value() {}
+
+func (*Operation_Request_Output_) isOperation_Request_Value() {}
+
+func (*Operation_Request_State_) isOperation_Request_Value() {}
+
+// Response is an operation response from a server. The value of the response
+// will either be the full response for the operation type or a partial
+// event update.
+type Operation_Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModulePath  string        `protobuf:"bytes,1,opt,name=module_path,proto3" json:"module_path,omitempty"`
-	RcPath      string        `protobuf:"bytes,2,opt,name=rc_path,proto3" json:"rc_path,omitempty"`
-	ScenarioRef *Ref_Scenario `protobuf:"bytes,3,opt,name=scenario_ref,json=scenario,proto3" json:"scenario_ref,omitempty"`
+	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Op          *Ref_Operation   `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Status      Operation_Status `protobuf:"varint,3,opt,name=status,proto3,enum=hashicorp.enos.v1.Operation_Status" json:"status,omitempty"`
+	// NOTE: If you add a new or modify value type be sure to update theoperation
+	// operation helpers in the diagnostics package and the response writer init
+	// the ui package.
+	//
+	// Types that are assignable to Value:
+	//
+	//	*Operation_Response_Generate_
+	//	*Operation_Response_Check_
+	//	*Operation_Response_Launch_
+	//	*Operation_Response_Destroy_
+	//	*Operation_Response_Run_
+	//	*Operation_Response_Exec_
+	//	*Operation_Response_Output_
+	//	*Operation_Response_State_
+	Value isOperation_Response_Value `protobuf_oneof:"value"`
 }
 
-func (x *Terraform_Module) Reset() {
-	*x = Terraform_Module{}
+func (x *Operation_Response) Reset() {
+	*x = Operation_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[77]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Terraform_Module) String() string {
+func (x *Operation_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Module) ProtoMessage() {}
+func (*Operation_Response) ProtoMessage() {}
 
-func (x *Terraform_Module) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[77]
+func (x *Operation_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5219,201 +5705,184 @@ func (x *Terraform_Module) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Module.ProtoReflect.Descriptor instead.
-func (*Terraform_Module) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use Operation_Response.ProtoReflect.Descriptor instead.
+func (*Operation_Response) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2}
 }
 
-func (x *Terraform_Module) GetModulePath() string {
+func (x *Operation_Response) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.ModulePath
+		return x.Diagnostics
 	}
-	return ""
+	return nil
 }
 
-func (x *Terraform_Module) GetRcPath() string {
+func (x *Operation_Response) GetOp() *Ref_Operation {
 	if x != nil {
-		return x.RcPath
+		return x.Op
 	}
-	return ""
+	return nil
 }
 
-func (x *Terraform_Module) GetScenarioRef() *Ref_Scenario {
+func (x *Operation_Response) GetStatus() Operation_Status {
 	if x != nil {
-		return x.ScenarioRef
+		return x.Status
 	}
-	return nil
+	return Operation_STATUS_UNSPECIFIED
 }
 
-type Terraform_Command struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *Operation_Response) GetValue() isOperation_Response_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (x *Terraform_Command) Reset() {
-	*x = Terraform_Command{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[78]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Operation_Response) GetGenerate() *Operation_Response_Generate {
+	if x, ok := x.GetValue().(*Operation_Response_Generate_); ok {
+		return x.Generate
 	}
+	return nil
 }
 
-func (x *Terraform_Command) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Operation_Response) GetCheck() *Operation_Response_Check {
+	if x, ok := x.GetValue().(*Operation_Response_Check_); ok {
+		return x.Check
+	}
+	return nil
 }
 
-func (*Terraform_Command) ProtoMessage() {}
-
-func (x *Terraform_Command) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[78]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Operation_Response) GetLaunch() *Operation_Response_Launch {
+	if x, ok := x.GetValue().(*Operation_Response_Launch_); ok {
+		return x.Launch
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Terraform_Command.ProtoReflect.Descriptor instead.
-func (*Terraform_Command) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1}
+func (x *Operation_Response) GetDestroy() *Operation_Response_Destroy {
+	if x, ok := x.GetValue().(*Operation_Response_Destroy_); ok {
+		return x.Destroy
+	}
+	return nil
 }
 
-type Terraform_Runner struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Config *Terraform_Runner_Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+func (x *Operation_Response) GetRun() *Operation_Response_Run {
+	if x, ok := x.GetValue().(*Operation_Response_Run_); ok {
+		return x.Run
+	}
+	return nil
 }
 
-func (x *Terraform_Runner) Reset() {
-	*x = Terraform_Runner{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[79]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Operation_Response) GetExec() *Operation_Response_Exec {
+	if x, ok := x.GetValue().(*Operation_Response_Exec_); ok {
+		return x.Exec
 	}
+	return nil
 }
 
-func (x *Terraform_Runner) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Operation_Response) GetOutput() *Operation_Response_Output {
+	if x, ok := x.GetValue().(*Operation_Response_Output_); ok {
+		return x.Output
+	}
+	return nil
 }
 
-func (*Terraform_Runner) ProtoMessage() {}
-
-func (x *Terraform_Runner) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[79]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Operation_Response) GetState() *Operation_Response_State {
+	if x, ok := x.GetValue().(*Operation_Response_State_); ok {
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Terraform_Runner.ProtoReflect.Descriptor instead.
-func (*Terraform_Runner) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2}
+type isOperation_Response_Value interface {
+	isOperation_Response_Value()
 }
 
-func (x *Terraform_Runner) GetConfig() *Terraform_Runner_Config {
-	if x != nil {
-		return x.Config
-	}
-	return nil
+type Operation_Response_Generate_ struct {
+	Generate *Operation_Response_Generate `protobuf:"bytes,11,opt,name=generate,proto3,oneof"`
 }
 
-type Terraform_Command_Init struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type Operation_Response_Check_ struct {
+	Check *Operation_Response_Check `protobuf:"bytes,12,opt,name=check,proto3,oneof"`
 }
 
-func (x *Terraform_Command_Init) Reset() {
-	*x = Terraform_Command_Init{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[80]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type Operation_Response_Launch_ struct {
+	Launch *Operation_Response_Launch `protobuf:"bytes,13,opt,name=launch,proto3,oneof"`
 }
 
-func (x *Terraform_Command_Init) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type Operation_Response_Destroy_ struct {
+	Destroy *Operation_Response_Destroy `protobuf:"bytes,14,opt,name=destroy,proto3,oneof"`
 }
 
-func (*Terraform_Command_Init) ProtoMessage() {}
-
-func (x *Terraform_Command_Init) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[80]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type Operation_Response_Run_ struct {
+	Run *Operation_Response_Run `protobuf:"bytes,15,opt,name=run,proto3,oneof"`
 }
 
-// Deprecated: Use Terraform_Command_Init.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Init) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 0}
+type Operation_Response_Exec_ struct {
+	Exec *Operation_Response_Exec `protobuf:"bytes,16,opt,name=exec,proto3,oneof"`
 }
 
-type Terraform_Command_Validate struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type Operation_Response_Output_ struct {
+	Output *Operation_Response_Output `protobuf:"bytes,17,opt,name=output,proto3,oneof"`
 }
 
-func (x *Terraform_Command_Validate) Reset() {
-	*x = Terraform_Command_Validate{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[81]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type Operation_Response_State_ struct {
+	State *Operation_Response_State `protobuf:"bytes,18,opt,name=state,proto3,oneof"`
 }
 
-func (x *Terraform_Command_Validate) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+func (*Operation_Response_Generate_) isOperation_Response_Value() {}
 
-func (*Terraform_Command_Validate) ProtoMessage() {}
+func (*Operation_Response_Check_) isOperation_Response_Value() {}
 
-func (x *Terraform_Command_Validate) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[81]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
+func (*Operation_Response_Launch_) isOperation_Response_Value() {}
 
-// Deprecated: Use Terraform_Command_Validate.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Validate) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 1}
-}
+func (*Operation_Response_Destroy_) isOperation_Response_Value() {}
 
-type Terraform_Command_Plan struct {
+func (*Operation_Response_Run_) isOperation_Response_Value() {}
+
+func (*Operation_Response_Exec_) isOperation_Response_Value() {}
+
+func (*Operation_Response_Output_) isOperation_Response_Value() {}
+
+func (*Operation_Response_State_) isOperation_Response_Value() {}
+
+// Events operation updates that can be streamed to the client while an operation
+// is in progress
+type Operation_Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Op          *Ref_Operation   `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Status      Operation_Status `protobuf:"varint,3,opt,name=status,proto3,enum=hashicorp.enos.v1.Operation_Status" json:"status,omitempty"`
+	// done is how we tell event listeners that event stream is completed. The
+	// worker running the operation is responsible for setting this field when
+	// the associated workFunc is completed.
+	Done        bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	// NOTE: If you add a new value type you need to make sure you update the
+	// diagnostics and ui packages to handle the new event types.
+	//
+	// Types that are assignable to Value:
+	//
+	//	*Operation_Event_Decode
+	//	*Operation_Event_Generate
+	//	*Operation_Event_Init
+	//	*Operation_Event_Validate
+	//	*Operation_Event_Plan
+	//	*Operation_Event_Apply
+	//	*Operation_Event_Destroy
+	//	*Operation_Event_Exec
+	//	*Operation_Event_Output
+	//	*Operation_Event_Show
+	//	*Operation_Event_Progress
+	//	*Operation_Event_Hook
+	Value isOperation_Event_Value `protobuf_oneof:"value"`
 }
 
-func (x *Terraform_Command_Plan) Reset() {
-	*x = Terraform_Command_Plan{}
+func (x *Operation_Event) Reset() {
+	*x = Operation_Event{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5421,13 +5890,13 @@ func (x *Terraform_Command_Plan) Reset() {
 	}
 }
 
-func (x *Terraform_Command_Plan) String() string {
+func (x *Operation_Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Command_Plan) ProtoMessage() {}
+func (*Operation_Event) ProtoMessage() {}
 
-func (x *Terraform_Command_Plan) ProtoReflect() protoreflect.Message {
+func (x *Operation_Event) ProtoReflect() protoreflect.Message {
 	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5439,186 +5908,236 @@ func (x *Terraform_Command_Plan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Command_Plan.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Plan) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 2}
+// Deprecated: Use Operation_Event.ProtoReflect.Descriptor instead.
+func (*Operation_Event) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 3}
 }
 
-type Terraform_Command_Apply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Operation_Event) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
 }
 
-func (x *Terraform_Command_Apply) Reset() {
-	*x = Terraform_Command_Apply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[83]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Operation_Event) GetOp() *Ref_Operation {
+	if x != nil {
+		return x.Op
 	}
+	return nil
 }
 
-func (x *Terraform_Command_Apply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Operation_Event) GetStatus() Operation_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Operation_STATUS_UNSPECIFIED
 }
 
-func (*Terraform_Command_Apply) ProtoMessage() {}
-
-func (x *Terraform_Command_Apply) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[83]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Operation_Event) GetDone() bool {
+	if x != nil {
+		return x.Done
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use Terraform_Command_Apply.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Apply) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 3}
+func (x *Operation_Event) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
 }
 
-type Terraform_Command_Destroy struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *Operation_Event) GetValue() isOperation_Event_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (x *Terraform_Command_Destroy) Reset() {
-	*x = Terraform_Command_Destroy{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[84]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Operation_Event) GetDecode() *DecodeResponse {
+	if x, ok := x.GetValue().(*Operation_Event_Decode); ok {
+		return x.Decode
 	}
+	return nil
 }
 
-func (x *Terraform_Command_Destroy) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Operation_Event) GetGenerate() *Operation_Response_Generate {
+	if x, ok := x.GetValue().(*Operation_Event_Generate); ok {
+		return x.Generate
+	}
+	return nil
 }
 
-func (*Terraform_Command_Destroy) ProtoMessage() {}
-
-func (x *Terraform_Command_Destroy) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[84]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Operation_Event) GetInit() *Terraform_Command_Init_Response {
+	if x, ok := x.GetValue().(*Operation_Event_Init); ok {
+		return x.Init
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Terraform_Command_Destroy.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Destroy) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 4}
+func (x *Operation_Event) GetValidate() *Terraform_Command_Validate_Response {
+	if x, ok := x.GetValue().(*Operation_Event_Validate); ok {
+		return x.Validate
+	}
+	return nil
 }
 
-type Terraform_Command_Exec struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Operation_Event) GetPlan() *Terraform_Command_Plan_Response {
+	if x, ok := x.GetValue().(*Operation_Event_Plan); ok {
+		return x.Plan
+	}
+	return nil
 }
 
-func (x *Terraform_Command_Exec) Reset() {
-	*x = Terraform_Command_Exec{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[85]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Operation_Event) GetApply() *Terraform_Command_Apply_Response {
+	if x, ok := x.GetValue().(*Operation_Event_Apply); ok {
+		return x.Apply
 	}
+	return nil
 }
 
-func (x *Terraform_Command_Exec) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Operation_Event) GetDestroy() *Terraform_Command_Destroy_Response {
+	if x, ok := x.GetValue().(*Operation_Event_Destroy); ok {
+		return x.Destroy
+	}
+	return nil
 }
 
-func (*Terraform_Command_Exec) ProtoMessage() {}
-
-func (x *Terraform_Command_Exec) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[85]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Operation_Event) GetExec() *Terraform_Command_Exec_Response {
+	if x, ok := x.GetValue().(*Operation_Event_Exec); ok {
+		return x.Exec
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Terraform_Command_Exec.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Exec) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 5}
+func (x *Operation_Event) GetOutput() *Terraform_Command_Output_Response {
+	if x, ok := x.GetValue().(*Operation_Event_Output); ok {
+		return x.Output
+	}
+	return nil
 }
 
-type Terraform_Command_Output struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Operation_Event) GetShow() *Terraform_Command_Show_Response {
+	if x, ok := x.GetValue().(*Operation_Event_Show); ok {
+		return x.Show
+	}
+	return nil
 }
 
-func (x *Terraform_Command_Output) Reset() {
-	*x = Terraform_Command_Output{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[86]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Operation_Event) GetProgress() *Terraform_Command_Progress {
+	if x, ok := x.GetValue().(*Operation_Event_Progress); ok {
+		return x.Progress
 	}
+	return nil
 }
 
-func (x *Terraform_Command_Output) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Operation_Event) GetHook() *Operation_Response_Hook {
+	if x, ok := x.GetValue().(*Operation_Event_Hook); ok {
+		return x.Hook
+	}
+	return nil
 }
 
-func (*Terraform_Command_Output) ProtoMessage() {}
+type isOperation_Event_Value interface {
+	isOperation_Event_Value()
+}
 
-func (x *Terraform_Command_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[86]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type Operation_Event_Decode struct {
+	Decode *DecodeResponse `protobuf:"bytes,10,opt,name=decode,proto3,oneof"`
 }
 
-// Deprecated: Use Terraform_Command_Output.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Output) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 6}
+type Operation_Event_Generate struct {
+	Generate *Operation_Response_Generate `protobuf:"bytes,11,opt,name=generate,proto3,oneof"`
 }
 
-type Terraform_Command_Show struct {
+type Operation_Event_Init struct {
+	Init *Terraform_Command_Init_Response `protobuf:"bytes,12,opt,name=init,proto3,oneof"`
+}
+
+type Operation_Event_Validate struct {
+	Validate *Terraform_Command_Validate_Response `protobuf:"bytes,13,opt,name=validate,proto3,oneof"`
+}
+
+type Operation_Event_Plan struct {
+	Plan *Terraform_Command_Plan_Response `protobuf:"bytes,14,opt,name=plan,proto3,oneof"`
+}
+
+type Operation_Event_Apply struct {
+	Apply *Terraform_Command_Apply_Response `protobuf:"bytes,15,opt,name=apply,proto3,oneof"`
+}
+
+type Operation_Event_Destroy struct {
+	Destroy *Terraform_Command_Destroy_Response `protobuf:"bytes,16,opt,name=destroy,proto3,oneof"`
+}
+
+type Operation_Event_Exec struct {
+	Exec *Terraform_Command_Exec_Response `protobuf:"bytes,17,opt,name=exec,proto3,oneof"`
+}
+
+type Operation_Event_Output struct {
+	Output *Terraform_Command_Output_Response `protobuf:"bytes,18,opt,name=output,proto3,oneof"`
+}
+
+type Operation_Event_Show struct {
+	Show *Terraform_Command_Show_Response `protobuf:"bytes,19,opt,name=show,proto3,oneof"`
+}
+
+type Operation_Event_Progress struct {
+	Progress *Terraform_Command_Progress `protobuf:"bytes,20,opt,name=progress,proto3,oneof"`
+}
+
+type Operation_Event_Hook struct {
+	Hook *Operation_Response_Hook `protobuf:"bytes,21,opt,name=hook,proto3,oneof"`
+}
+
+func (*Operation_Event_Decode) isOperation_Event_Value() {}
+
+func (*Operation_Event_Generate) isOperation_Event_Value() {}
+
+func (*Operation_Event_Init) isOperation_Event_Value() {}
+
+func (*Operation_Event_Validate) isOperation_Event_Value() {}
+
+func (*Operation_Event_Plan) isOperation_Event_Value() {}
+
+func (*Operation_Event_Apply) isOperation_Event_Value() {}
+
+func (*Operation_Event_Destroy) isOperation_Event_Value() {}
+
+func (*Operation_Event_Exec) isOperation_Event_Value() {}
+
+func (*Operation_Event_Output) isOperation_Event_Value() {}
+
+func (*Operation_Event_Show) isOperation_Event_Value() {}
+
+func (*Operation_Event_Progress) isOperation_Event_Value() {}
+
+func (*Operation_Event_Hook) isOperation_Event_Value() {}
+
+type Operation_Request_Generate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Terraform_Command_Show) Reset() {
-	*x = Terraform_Command_Show{}
+func (x *Operation_Request_Generate) Reset() {
+	*x = Operation_Request_Generate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[87]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Terraform_Command_Show) String() string {
+func (x *Operation_Request_Generate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Command_Show) ProtoMessage() {}
+func (*Operation_Request_Generate) ProtoMessage() {}
 
-func (x *Terraform_Command_Show) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[87]
+func (x *Operation_Request_Generate) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5629,37 +6148,34 @@ func (x *Terraform_Command_Show) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Command_Show.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Show) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 7}
+// Deprecated: Use Operation_Request_Generate.ProtoReflect.Descriptor instead.
+func (*Operation_Request_Generate) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 0}
 }
 
-type Terraform_Command_Init_Response struct {
+type Operation_Request_Check struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Stderr      string        `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
 }
 
-func (x *Terraform_Command_Init_Response) Reset() {
-	*x = Terraform_Command_Init_Response{}
+func (x *Operation_Request_Check) Reset() {
+	*x = Operation_Request_Check{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[88]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Terraform_Command_Init_Response) String() string {
+func (x *Operation_Request_Check) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Command_Init_Response) ProtoMessage() {}
+func (*Operation_Request_Check) ProtoMessage() {}
 
-func (x *Terraform_Command_Init_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[88]
+func (x *Operation_Request_Check) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5670,54 +6186,38 @@ func (x *Terraform_Command_Init_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Command_Init_Response.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Init_Response) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 0, 0}
-}
-
-func (x *Terraform_Command_Init_Response) GetDiagnostics() []*Diagnostic {
-	if x != nil {
-		return x.Diagnostics
-	}
-	return nil
-}
-
-func (x *Terraform_Command_Init_Response) GetStderr() string {
-	if x != nil {
-		return x.Stderr
-	}
-	return ""
+// Deprecated: Use Operation_Request_Check.ProtoReflect.Descriptor instead.
+func (*Operation_Request_Check) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 1}
 }
 
-type Terraform_Command_Validate_Response struct {
+type Operation_Request_Launch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics   []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Valid         bool          `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
-	ErrorCount    int64         `protobuf:"varint,3,opt,name=error_count,proto3" json:"error_count,omitempty"`
-	WarningCount  int64         `protobuf:"varint,4,opt,name=warning_count,proto3" json:"warning_count,omitempty"`
-	FormatVersion string        `protobuf:"bytes,5,opt,name=format_version,proto3" json:"format_version,omitempty"`
+	FailurePolicy *Operation_FailurePolicy `protobuf:"bytes,1,opt,name=failure_policy,proto3" json:"failure_policy,omitempty"`
+	// ttl is how long the launched scenario should exist before it is reaped
+	Ttl *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func (x *Terraform_Command_Validate_Response) Reset() {
-	*x = Terraform_Command_Validate_Response{}
+func (x *Operation_Request_Launch) Reset() {
+	*x = Operation_Request_Launch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[89]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Terraform_Command_Validate_Response) String() string {
+func (x *Operation_Request_Launch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Command_Validate_Response) ProtoMessage() {}
+func (*Operation_Request_Launch) ProtoMessage() {}
 
-func (x *Terraform_Command_Validate_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[89]
+func (x *Operation_Request_Launch) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5728,73 +6228,52 @@ func (x *Terraform_Command_Validate_Response) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Command_Validate_Response.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Validate_Response) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 1, 0}
+// Deprecated: Use Operation_Request_Launch.ProtoReflect.Descriptor instead.
+func (*Operation_Request_Launch) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 2}
 }
 
-func (x *Terraform_Command_Validate_Response) GetDiagnostics() []*Diagnostic {
+func (x *Operation_Request_Launch) GetFailurePolicy() *Operation_FailurePolicy {
 	if x != nil {
-		return x.Diagnostics
+		return x.FailurePolicy
 	}
 	return nil
 }
 
-func (x *Terraform_Command_Validate_Response) GetValid() bool {
-	if x != nil {
-		return x.Valid
-	}
-	return false
-}
-
-func (x *Terraform_Command_Validate_Response) GetErrorCount() int64 {
-	if x != nil {
-		return x.ErrorCount
-	}
-	return 0
-}
-
-func (x *Terraform_Command_Validate_Response) GetWarningCount() int64 {
-	if x != nil {
-		return x.WarningCount
-	}
-	return 0
-}
-
-func (x *Terraform_Command_Validate_Response) GetFormatVersion() string {
+func (x *Operation_Request_Launch) GetTtl() *durationpb.Duration {
 	if x != nil {
-		return x.FormatVersion
+		return x.Ttl
 	}
-	return ""
+	return nil
 }
 
-type Terraform_Command_Plan_Response struct {
+type Operation_Request_Destroy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics    []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	ChangesPresent bool          `protobuf:"varint,2,opt,name=changes_present,proto3" json:"changes_present,omitempty"`
-	Stderr         string        `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// terraform_module is a previously generated module to destroy. If it is
+	// set the module will not be generated from the flight plan.
+	TerraformModule *Terraform_Module `protobuf:"bytes,1,opt,name=terraform_module,proto3" json:"terraform_module,omitempty"`
 }
 
-func (x *Terraform_Command_Plan_Response) Reset() {
-	*x = Terraform_Command_Plan_Response{}
+func (x *Operation_Request_Destroy) Reset() {
+	*x = Operation_Request_Destroy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[90]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Terraform_Command_Plan_Response) String() string {
+func (x *Operation_Request_Destroy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Command_Plan_Response) ProtoMessage() {}
+func (*Operation_Request_Destroy) ProtoMessage() {}
 
-func (x *Terraform_Command_Plan_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[90]
+func (x *Operation_Request_Destroy) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5805,58 +6284,43 @@ func (x *Terraform_Command_Plan_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Command_Plan_Response.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Plan_Response) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 2, 0}
+// Deprecated: Use Operation_Request_Destroy.ProtoReflect.Descriptor instead.
+func (*Operation_Request_Destroy) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 3}
 }
 
-func (x *Terraform_Command_Plan_Response) GetDiagnostics() []*Diagnostic {
+func (x *Operation_Request_Destroy) GetTerraformModule() *Terraform_Module {
 	if x != nil {
-		return x.Diagnostics
+		return x.TerraformModule
 	}
 	return nil
 }
 
-func (x *Terraform_Command_Plan_Response) GetChangesPresent() bool {
-	if x != nil {
-		return x.ChangesPresent
-	}
-	return false
-}
-
-func (x *Terraform_Command_Plan_Response) GetStderr() string {
-	if x != nil {
-		return x.Stderr
-	}
-	return ""
-}
-
-type Terraform_Command_Apply_Response struct {
+type Operation_Request_Run struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Stderr      string        `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	FailurePolicy *Operation_FailurePolicy `protobuf:"bytes,1,opt,name=failure_policy,proto3" json:"failure_policy,omitempty"`
 }
 
-func (x *Terraform_Command_Apply_Response) Reset() {
-	*x = Terraform_Command_Apply_Response{}
+func (x *Operation_Request_Run) Reset() {
+	*x = Operation_Request_Run{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[91]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Terraform_Command_Apply_Response) String() string {
+func (x *Operation_Request_Run) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Command_Apply_Response) ProtoMessage() {}
+func (*Operation_Request_Run) ProtoMessage() {}
 
-func (x *Terraform_Command_Apply_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[91]
+func (x *Operation_Request_Run) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5867,51 +6331,41 @@ func (x *Terraform_Command_Apply_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Command_Apply_Response.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Apply_Response) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 3, 0}
+// Deprecated: Use Operation_Request_Run.ProtoReflect.Descriptor instead.
+func (*Operation_Request_Run) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 4}
 }
 
-func (x *Terraform_Command_Apply_Response) GetDiagnostics() []*Diagnostic {
+func (x *Operation_Request_Run) GetFailurePolicy() *Operation_FailurePolicy {
 	if x != nil {
-		return x.Diagnostics
+		return x.FailurePolicy
 	}
 	return nil
 }
 
-func (x *Terraform_Command_Apply_Response) GetStderr() string {
-	if x != nil {
-		return x.Stderr
-	}
-	return ""
-}
-
-type Terraform_Command_Destroy_Response struct {
+type Operation_Request_Exec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Stderr      string        `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
 }
 
-func (x *Terraform_Command_Destroy_Response) Reset() {
-	*x = Terraform_Command_Destroy_Response{}
+func (x *Operation_Request_Exec) Reset() {
+	*x = Operation_Request_Exec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[92]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Terraform_Command_Destroy_Response) String() string {
+func (x *Operation_Request_Exec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Command_Destroy_Response) ProtoMessage() {}
+func (*Operation_Request_Exec) ProtoMessage() {}
 
-func (x *Terraform_Command_Destroy_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[92]
+func (x *Operation_Request_Exec) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5922,53 +6376,34 @@ func (x *Terraform_Command_Destroy_Response) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Command_Destroy_Response.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Destroy_Response) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 4, 0}
-}
-
-func (x *Terraform_Command_Destroy_Response) GetDiagnostics() []*Diagnostic {
-	if x != nil {
-		return x.Diagnostics
-	}
-	return nil
-}
-
-func (x *Terraform_Command_Destroy_Response) GetStderr() string {
-	if x != nil {
-		return x.Stderr
-	}
-	return ""
+// Deprecated: Use Operation_Request_Exec.ProtoReflect.Descriptor instead.
+func (*Operation_Request_Exec) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 5}
 }
 
-type Terraform_Command_Exec_Response struct {
+type Operation_Request_Output struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	SubCommand  string        `protobuf:"bytes,2,opt,name=sub_command,proto3" json:"sub_command,omitempty"`
-	Stderr      string        `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
-	Stdout      string        `protobuf:"bytes,4,opt,name=stdout,proto3" json:"stdout,omitempty"`
 }
 
-func (x *Terraform_Command_Exec_Response) Reset() {
-	*x = Terraform_Command_Exec_Response{}
+func (x *Operation_Request_Output) Reset() {
+	*x = Operation_Request_Output{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[93]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Terraform_Command_Exec_Response) String() string {
+func (x *Operation_Request_Output) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Command_Exec_Response) ProtoMessage() {}
+func (*Operation_Request_Output) ProtoMessage() {}
 
-func (x *Terraform_Command_Exec_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[93]
+func (x *Operation_Request_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5979,65 +6414,75 @@ func (x *Terraform_Command_Exec_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Command_Exec_Response.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Exec_Response) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 5, 0}
+// Deprecated: Use Operation_Request_Output.ProtoReflect.Descriptor instead.
+func (*Operation_Request_Output) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 6}
 }
 
-func (x *Terraform_Command_Exec_Response) GetDiagnostics() []*Diagnostic {
-	if x != nil {
-		return x.Diagnostics
-	}
-	return nil
+type Operation_Request_State struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Terraform_Command_Exec_Response) GetSubCommand() string {
-	if x != nil {
-		return x.SubCommand
+func (x *Operation_Request_State) Reset() {
+	*x = Operation_Request_State{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Terraform_Command_Exec_Response) GetStderr() string {
-	if x != nil {
-		return x.Stderr
-	}
-	return ""
+func (x *Operation_Request_State) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Terraform_Command_Exec_Response) GetStdout() string {
-	if x != nil {
-		return x.Stdout
+func (*Operation_Request_State) ProtoMessage() {}
+
+func (x *Operation_Request_State) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type Terraform_Command_Output_Response struct {
+// Deprecated: Use Operation_Request_State.ProtoReflect.Descriptor instead.
+func (*Operation_Request_State) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 7}
+}
+
+type Operation_Response_Generate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic                             `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Meta        []*Terraform_Command_Output_Response_Meta `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty"`
+	Diagnostics     []*Diagnostic     `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	TerraformModule *Terraform_Module `protobuf:"bytes,2,opt,name=terraform_module,proto3" json:"terraform_module,omitempty"`
 }
 
-func (x *Terraform_Command_Output_Response) Reset() {
-	*x = Terraform_Command_Output_Response{}
+func (x *Operation_Response_Generate) Reset() {
+	*x = Operation_Response_Generate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[94]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Terraform_Command_Output_Response) String() string {
+func (x *Operation_Response_Generate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Command_Output_Response) ProtoMessage() {}
+func (*Operation_Response_Generate) ProtoMessage() {}
 
-func (x *Terraform_Command_Output_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[94]
+func (x *Operation_Response_Generate) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6048,54 +6493,54 @@ func (x *Terraform_Command_Output_Response) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Command_Output_Response.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Output_Response) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 6, 0}
+// Deprecated: Use Operation_Response_Generate.ProtoReflect.Descriptor instead.
+func (*Operation_Response_Generate) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 0}
 }
 
-func (x *Terraform_Command_Output_Response) GetDiagnostics() []*Diagnostic {
+func (x *Operation_Response_Generate) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *Terraform_Command_Output_Response) GetMeta() []*Terraform_Command_Output_Response_Meta {
+func (x *Operation_Response_Generate) GetTerraformModule() *Terraform_Module {
 	if x != nil {
-		return x.Meta
+		return x.TerraformModule
 	}
 	return nil
 }
 
-type Terraform_Command_Output_Response_Meta struct {
+type Operation_Response_Check struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type      []byte `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Value     []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	Sensitive bool   `protobuf:"varint,4,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
-	Stderr    string `protobuf:"bytes,5,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	Diagnostics []*Diagnostic                        `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Generate    *Operation_Response_Generate         `protobuf:"bytes,2,opt,name=generate,proto3" json:"generate,omitempty"`
+	Init        *Terraform_Command_Init_Response     `protobuf:"bytes,3,opt,name=init,proto3" json:"init,omitempty"`
+	Validate    *Terraform_Command_Validate_Response `protobuf:"bytes,4,opt,name=validate,proto3" json:"validate,omitempty"`
+	Plan        *Terraform_Command_Plan_Response     `protobuf:"bytes,5,opt,name=plan,proto3" json:"plan,omitempty"`
 }
 
-func (x *Terraform_Command_Output_Response_Meta) Reset() {
-	*x = Terraform_Command_Output_Response_Meta{}
+func (x *Operation_Response_Check) Reset() {
+	*x = Operation_Response_Check{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[95]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Terraform_Command_Output_Response_Meta) String() string {
+func (x *Operation_Response_Check) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Command_Output_Response_Meta) ProtoMessage() {}
+func (*Operation_Response_Check) ProtoMessage() {}
 
-func (x *Terraform_Command_Output_Response_Meta) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[95]
+func (x *Operation_Response_Check) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6106,75 +6551,80 @@ func (x *Terraform_Command_Output_Response_Meta) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Command_Output_Response_Meta.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Output_Response_Meta) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 6, 0, 0}
+// Deprecated: Use Operation_Response_Check.ProtoReflect.Descriptor instead.
+func (*Operation_Response_Check) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 1}
 }
 
-func (x *Terraform_Command_Output_Response_Meta) GetName() string {
+func (x *Operation_Response_Check) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Name
+		return x.Diagnostics
 	}
-	return ""
+	return nil
 }
 
-func (x *Terraform_Command_Output_Response_Meta) GetType() []byte {
+func (x *Operation_Response_Check) GetGenerate() *Operation_Response_Generate {
 	if x != nil {
-		return x.Type
+		return x.Generate
 	}
 	return nil
 }
 
-func (x *Terraform_Command_Output_Response_Meta) GetValue() []byte {
+func (x *Operation_Response_Check) GetInit() *Terraform_Command_Init_Response {
 	if x != nil {
-		return x.Value
+		return x.Init
 	}
 	return nil
 }
 
-func (x *Terraform_Command_Output_Response_Meta) GetSensitive() bool {
+func (x *Operation_Response_Check) GetValidate() *Terraform_Command_Validate_Response {
 	if x != nil {
-		return x.Sensitive
+		return x.Validate
 	}
-	return false
+	return nil
 }
 
-func (x *Terraform_Command_Output_Response_Meta) GetStderr() string {
+func (x *Operation_Response_Check) GetPlan() *Terraform_Command_Plan_Response {
 	if x != nil {
-		return x.Stderr
+		return x.Plan
 	}
-	return ""
+	return nil
 }
 
-type Terraform_Command_Show_Response struct {
+type Operation_Response_Launch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	// For now, we pass the entire state over the wire as a tfjson.State JSON
-	// representation. You can unmarshal the value into that Go type if
-	// necessary.
-	State []byte `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Diagnostics   []*Diagnostic                        `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Generate      *Operation_Response_Generate         `protobuf:"bytes,2,opt,name=generate,proto3" json:"generate,omitempty"`
+	Init          *Terraform_Command_Init_Response     `protobuf:"bytes,3,opt,name=init,proto3" json:"init,omitempty"`
+	Validate      *Terraform_Command_Validate_Response `protobuf:"bytes,4,opt,name=validate,proto3" json:"validate,omitempty"`
+	Plan          *Terraform_Command_Plan_Response     `protobuf:"bytes,5,opt,name=plan,proto3" json:"plan,omitempty"`
+	Apply         *Terraform_Command_Apply_Response    `protobuf:"bytes,6,opt,name=apply,proto3" json:"apply,omitempty"`
+	Hooks         []*Operation_Response_Hook           `protobuf:"bytes,7,rep,name=hooks,proto3" json:"hooks,omitempty"`
+	FailurePolicy *Operation_Response_FailurePolicy    `protobuf:"bytes,8,opt,name=failure_policy,proto3" json:"failure_policy,omitempty"`
+	// expires_at is when the launched scenario will be reaped
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
 }
 
-func (x *Terraform_Command_Show_Response) Reset() {
-	*x = Terraform_Command_Show_Response{}
+func (x *Operation_Response_Launch) Reset() {
+	*x = Operation_Response_Launch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[96]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Terraform_Command_Show_Response) String() string {
+func (x *Operation_Response_Launch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Command_Show_Response) ProtoMessage() {}
+func (*Operation_Response_Launch) ProtoMessage() {}
 
-func (x *Terraform_Command_Show_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[96]
+func (x *Operation_Response_Launch) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6185,181 +6635,195 @@ func (x *Terraform_Command_Show_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Command_Show_Response.ProtoReflect.Descriptor instead.
-func (*Terraform_Command_Show_Response) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 1, 7, 0}
+// Deprecated: Use Operation_Response_Launch.ProtoReflect.Descriptor instead.
+func (*Operation_Response_Launch) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 2}
 }
 
-func (x *Terraform_Command_Show_Response) GetDiagnostics() []*Diagnostic {
+func (x *Operation_Response_Launch) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *Terraform_Command_Show_Response) GetState() []byte {
+func (x *Operation_Response_Launch) GetGenerate() *Operation_Response_Generate {
 	if x != nil {
-		return x.State
+		return x.Generate
 	}
 	return nil
 }
 
-type Terraform_Runner_Config struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Terraform CLI flags
-	Flags *Terraform_Runner_Config_Flags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	// The path to the terraform binary
-	BinPath string `protobuf:"bytes,2,opt,name=bin_path,json=binPath,proto3" json:"bin_path,omitempty"`
-	// Where the .terraformrc configuration is
-	ConfigPath string `protobuf:"bytes,3,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"`
-	// The directory from which to execute Terraform
-	WorkingDirPath string `protobuf:"bytes,4,opt,name=working_dir_path,json=workingDirPath,proto3" json:"working_dir_path,omitempty"`
-	// Environment variables to set when executing Terraform
-	Env map[string]string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// User supplied sub-command
-	UserSubCommand string `protobuf:"bytes,6,opt,name=user_sub_command,json=userSubCommand,proto3" json:"user_sub_command,omitempty"`
-	// Output filter
-	OutputFilter string `protobuf:"bytes,7,opt,name=output_filter,json=outputFilter,proto3" json:"output_filter,omitempty"`
-	// Fail on warnings
-	FailOnWarnings bool `protobuf:"varint,8,opt,name=fail_on_warnings,json=failOnWarnings,proto3" json:"fail_on_warnings,omitempty"`
+func (x *Operation_Response_Launch) GetInit() *Terraform_Command_Init_Response {
+	if x != nil {
+		return x.Init
+	}
+	return nil
 }
 
-func (x *Terraform_Runner_Config) Reset() {
-	*x = Terraform_Runner_Config{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[97]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Operation_Response_Launch) GetValidate() *Terraform_Command_Validate_Response {
+	if x != nil {
+		return x.Validate
 	}
+	return nil
 }
 
-func (x *Terraform_Runner_Config) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Operation_Response_Launch) GetPlan() *Terraform_Command_Plan_Response {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
 }
 
-func (*Terraform_Runner_Config) ProtoMessage() {}
-
-func (x *Terraform_Runner_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[97]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Operation_Response_Launch) GetApply() *Terraform_Command_Apply_Response {
+	if x != nil {
+		return x.Apply
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Terraform_Runner_Config.ProtoReflect.Descriptor instead.
-func (*Terraform_Runner_Config) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 0}
+func (x *Operation_Response_Launch) GetHooks() []*Operation_Response_Hook {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
 }
 
-func (x *Terraform_Runner_Config) GetFlags() *Terraform_Runner_Config_Flags {
+func (x *Operation_Response_Launch) GetFailurePolicy() *Operation_Response_FailurePolicy {
 	if x != nil {
-		return x.Flags
+		return x.FailurePolicy
 	}
 	return nil
 }
 
-func (x *Terraform_Runner_Config) GetBinPath() string {
+func (x *Operation_Response_Launch) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BinPath
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-func (x *Terraform_Runner_Config) GetConfigPath() string {
+type Operation_Response_Destroy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics    []*Diagnostic                       `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	PriorStateShow *Terraform_Command_Show_Response    `protobuf:"bytes,5,opt,name=prior_state_show,json=priorStateShow,proto3" json:"prior_state_show,omitempty"`
+	Generate       *Operation_Response_Generate        `protobuf:"bytes,2,opt,name=generate,proto3" json:"generate,omitempty"`
+	Init           *Terraform_Command_Init_Response    `protobuf:"bytes,4,opt,name=init,proto3" json:"init,omitempty"`
+	Destroy        *Terraform_Command_Destroy_Response `protobuf:"bytes,3,opt,name=destroy,proto3" json:"destroy,omitempty"`
+	Hooks          []*Operation_Response_Hook          `protobuf:"bytes,6,rep,name=hooks,proto3" json:"hooks,omitempty"`
+}
+
+func (x *Operation_Response_Destroy) Reset() {
+	*x = Operation_Response_Destroy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Operation_Response_Destroy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation_Response_Destroy) ProtoMessage() {}
+
+func (x *Operation_Response_Destroy) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation_Response_Destroy.ProtoReflect.Descriptor instead.
+func (*Operation_Response_Destroy) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 3}
+}
+
+func (x *Operation_Response_Destroy) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.ConfigPath
+		return x.Diagnostics
 	}
-	return ""
+	return nil
 }
 
-func (x *Terraform_Runner_Config) GetWorkingDirPath() string {
+func (x *Operation_Response_Destroy) GetPriorStateShow() *Terraform_Command_Show_Response {
 	if x != nil {
-		return x.WorkingDirPath
+		return x.PriorStateShow
 	}
-	return ""
+	return nil
 }
 
-func (x *Terraform_Runner_Config) GetEnv() map[string]string {
+func (x *Operation_Response_Destroy) GetGenerate() *Operation_Response_Generate {
 	if x != nil {
-		return x.Env
+		return x.Generate
 	}
 	return nil
 }
 
-func (x *Terraform_Runner_Config) GetUserSubCommand() string {
+func (x *Operation_Response_Destroy) GetInit() *Terraform_Command_Init_Response {
 	if x != nil {
-		return x.UserSubCommand
+		return x.Init
 	}
-	return ""
+	return nil
 }
 
-func (x *Terraform_Runner_Config) GetOutputFilter() string {
+func (x *Operation_Response_Destroy) GetDestroy() *Terraform_Command_Destroy_Response {
 	if x != nil {
-		return x.OutputFilter
+		return x.Destroy
 	}
-	return ""
+	return nil
 }
 
-func (x *Terraform_Runner_Config) GetFailOnWarnings() bool {
+func (x *Operation_Response_Destroy) GetHooks() []*Operation_Response_Hook {
 	if x != nil {
-		return x.FailOnWarnings
+		return x.Hooks
 	}
-	return false
+	return nil
 }
 
-// Flags are a subset of the Terraform flags that we allow to be settable.
-type Terraform_Runner_Config_Flags struct {
+type Operation_Response_Run struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// -backup=path
-	BackupStateFilePath string `protobuf:"bytes,1,opt,name=backup_state_file_path,json=backupStateFilePath,proto3" json:"backup_state_file_path,omitempty"`
-	// -lock-timeout=10s
-	LockTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=lock_timeout,json=lockTimeout,proto3" json:"lock_timeout,omitempty"`
-	// -backend=false
-	NoBackend bool `protobuf:"varint,3,opt,name=no_backend,json=noBackend,proto3" json:"no_backend,omitempty"`
-	// -lock=false
-	NoLock bool `protobuf:"varint,4,opt,name=no_lock,json=noLock,proto3" json:"no_lock,omitempty"`
-	// -get=false
-	NoDownload bool `protobuf:"varint,5,opt,name=no_download,json=noDownload,proto3" json:"no_download,omitempty"`
-	// -refresh=false
-	NoRefresh bool `protobuf:"varint,6,opt,name=no_refresh,json=noRefresh,proto3" json:"no_refresh,omitempty"`
-	// -parallelism=n
-	Parallelism uint32 `protobuf:"varint,7,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
-	// -refresh-only
-	RefreshOnly bool `protobuf:"varint,8,opt,name=refresh_only,json=refreshOnly,proto3" json:"refresh_only,omitempty"`
-	// -upgrade
-	Upgrade bool `protobuf:"varint,9,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
-	// -reconfigure=false
-	NoReconfigure bool `protobuf:"varint,10,opt,name=no_reconfigure,json=noReconfigure,proto3" json:"no_reconfigure,omitempty"`
+	Diagnostics    []*Diagnostic                        `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Generate       *Operation_Response_Generate         `protobuf:"bytes,2,opt,name=generate,proto3" json:"generate,omitempty"`
+	Init           *Terraform_Command_Init_Response     `protobuf:"bytes,3,opt,name=init,proto3" json:"init,omitempty"`
+	Validate       *Terraform_Command_Validate_Response `protobuf:"bytes,4,opt,name=validate,proto3" json:"validate,omitempty"`
+	Plan           *Terraform_Command_Plan_Response     `protobuf:"bytes,5,opt,name=plan,proto3" json:"plan,omitempty"`
+	Apply          *Terraform_Command_Apply_Response    `protobuf:"bytes,6,opt,name=apply,proto3" json:"apply,omitempty"`
+	PriorStateShow *Terraform_Command_Show_Response     `protobuf:"bytes,8,opt,name=prior_state_show,json=priorStateShow,proto3" json:"prior_state_show,omitempty"`
+	Destroy        *Terraform_Command_Destroy_Response  `protobuf:"bytes,7,opt,name=destroy,proto3" json:"destroy,omitempty"`
+	Hooks          []*Operation_Response_Hook           `protobuf:"bytes,9,rep,name=hooks,proto3" json:"hooks,omitempty"`
+	FailurePolicy  *Operation_Response_FailurePolicy    `protobuf:"bytes,10,opt,name=failure_policy,proto3" json:"failure_policy,omitempty"`
 }
 
-func (x *Terraform_Runner_Config_Flags) Reset() {
-	*x = Terraform_Runner_Config_Flags{}
+func (x *Operation_Response_Run) Reset() {
+	*x = Operation_Response_Run{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[99]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Terraform_Runner_Config_Flags) String() string {
+func (x *Operation_Response_Run) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Terraform_Runner_Config_Flags) ProtoMessage() {}
+func (*Operation_Response_Run) ProtoMessage() {}
 
-func (x *Terraform_Runner_Config_Flags) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[99]
+func (x *Operation_Response_Run) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6370,106 +6834,114 @@ func (x *Terraform_Runner_Config_Flags) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Terraform_Runner_Config_Flags.ProtoReflect.Descriptor instead.
-func (*Terraform_Runner_Config_Flags) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 0, 1}
+// Deprecated: Use Operation_Response_Run.ProtoReflect.Descriptor instead.
+func (*Operation_Response_Run) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 4}
 }
 
-func (x *Terraform_Runner_Config_Flags) GetBackupStateFilePath() string {
+func (x *Operation_Response_Run) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.BackupStateFilePath
+		return x.Diagnostics
 	}
-	return ""
+	return nil
 }
 
-func (x *Terraform_Runner_Config_Flags) GetLockTimeout() *durationpb.Duration {
+func (x *Operation_Response_Run) GetGenerate() *Operation_Response_Generate {
 	if x != nil {
-		return x.LockTimeout
+		return x.Generate
 	}
 	return nil
 }
 
-func (x *Terraform_Runner_Config_Flags) GetNoBackend() bool {
+func (x *Operation_Response_Run) GetInit() *Terraform_Command_Init_Response {
 	if x != nil {
-		return x.NoBackend
+		return x.Init
 	}
-	return false
+	return nil
 }
 
-func (x *Terraform_Runner_Config_Flags) GetNoLock() bool {
+func (x *Operation_Response_Run) GetValidate() *Terraform_Command_Validate_Response {
 	if x != nil {
-		return x.NoLock
+		return x.Validate
 	}
-	return false
+	return nil
 }
 
-func (x *Terraform_Runner_Config_Flags) GetNoDownload() bool {
+func (x *Operation_Response_Run) GetPlan() *Terraform_Command_Plan_Response {
 	if x != nil {
-		return x.NoDownload
+		return x.Plan
 	}
-	return false
+	return nil
 }
 
-func (x *Terraform_Runner_Config_Flags) GetNoRefresh() bool {
+func (x *Operation_Response_Run) GetApply() *Terraform_Command_Apply_Response {
 	if x != nil {
-		return x.NoRefresh
+		return x.Apply
 	}
-	return false
+	return nil
 }
 
-func (x *Terraform_Runner_Config_Flags) GetParallelism() uint32 {
+func (x *Operation_Response_Run) GetPriorStateShow() *Terraform_Command_Show_Response {
 	if x != nil {
-		return x.Parallelism
+		return x.PriorStateShow
 	}
-	return 0
+	return nil
 }
 
-func (x *Terraform_Runner_Config_Flags) GetRefreshOnly() bool {
+func (x *Operation_Response_Run) GetDestroy() *Terraform_Command_Destroy_Response {
 	if x != nil {
-		return x.RefreshOnly
+		return x.Destroy
 	}
-	return false
+	return nil
 }
 
-func (x *Terraform_Runner_Config_Flags) GetUpgrade() bool {
+func (x *Operation_Response_Run) GetHooks() []*Operation_Response_Hook {
 	if x != nil {
-		return x.Upgrade
+		return x.Hooks
 	}
-	return false
+	return nil
 }
 
-func (x *Terraform_Runner_Config_Flags) GetNoReconfigure() bool {
+func (x *Operation_Response_Run) GetFailurePolicy() *Operation_Response_FailurePolicy {
 	if x != nil {
-		return x.NoReconfigure
+		return x.FailurePolicy
 	}
-	return false
+	return nil
 }
 
-type Matrix_Vector struct {
+// FailurePolicy is the result of applying the failure policy after
+// launching a scenario failed.
+type Operation_Response_FailurePolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Elements []*Matrix_Element `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
+	Diagnostics    []*Diagnostic                            `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Policy         *Operation_FailurePolicy                 `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	Outcome        Operation_Response_FailurePolicy_Outcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=hashicorp.enos.v1.Operation_Response_FailurePolicy_Outcome" json:"outcome,omitempty"`
+	PriorStateShow *Terraform_Command_Show_Response         `protobuf:"bytes,4,opt,name=prior_state_show,proto3" json:"prior_state_show,omitempty"`
+	Destroy        *Terraform_Command_Destroy_Response      `protobuf:"bytes,5,opt,name=destroy,proto3" json:"destroy,omitempty"`
+	// expires_at is when kept resources should be destroyed
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
 }
 
-func (x *Matrix_Vector) Reset() {
-	*x = Matrix_Vector{}
+func (x *Operation_Response_FailurePolicy) Reset() {
+	*x = Operation_Response_FailurePolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[100]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Matrix_Vector) String() string {
+func (x *Operation_Response_FailurePolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Matrix_Vector) ProtoMessage() {}
+func (*Operation_Response_FailurePolicy) ProtoMessage() {}
 
-func (x *Matrix_Vector) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[100]
+func (x *Operation_Response_FailurePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6480,44 +6952,86 @@ func (x *Matrix_Vector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Matrix_Vector.ProtoReflect.Descriptor instead.
-func (*Matrix_Vector) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 0}
+// Deprecated: Use Operation_Response_FailurePolicy.ProtoReflect.Descriptor instead.
+func (*Operation_Response_FailurePolicy) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 5}
 }
 
-func (x *Matrix_Vector) GetElements() []*Matrix_Element {
+func (x *Operation_Response_FailurePolicy) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Elements
+		return x.Diagnostics
 	}
 	return nil
 }
 
-type Matrix_Element struct {
+func (x *Operation_Response_FailurePolicy) GetPolicy() *Operation_FailurePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *Operation_Response_FailurePolicy) GetOutcome() Operation_Response_FailurePolicy_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Operation_Response_FailurePolicy_OUTCOME_UNSPECIFIED
+}
+
+func (x *Operation_Response_FailurePolicy) GetPriorStateShow() *Terraform_Command_Show_Response {
+	if x != nil {
+		return x.PriorStateShow
+	}
+	return nil
+}
+
+func (x *Operation_Response_FailurePolicy) GetDestroy() *Terraform_Command_Destroy_Response {
+	if x != nil {
+		return x.Destroy
+	}
+	return nil
+}
+
+func (x *Operation_Response_FailurePolicy) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Hook is the result of running a scenario hook command.
+type Operation_Response_Hook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// type is the hook type, e.g. before_launch
+	Type     string               `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Command  []string             `protobuf:"bytes,3,rep,name=command,proto3" json:"command,omitempty"`
+	Stdout   string               `protobuf:"bytes,4,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr   string               `protobuf:"bytes,5,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	ExitCode int32                `protobuf:"varint,6,opt,name=exit_code,proto3" json:"exit_code,omitempty"`
+	Elapsed  *durationpb.Duration `protobuf:"bytes,7,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
 }
 
-func (x *Matrix_Element) Reset() {
-	*x = Matrix_Element{}
+func (x *Operation_Response_Hook) Reset() {
+	*x = Operation_Response_Hook{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[101]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Matrix_Element) String() string {
+func (x *Operation_Response_Hook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Matrix_Element) ProtoMessage() {}
+func (*Operation_Response_Hook) ProtoMessage() {}
 
-func (x *Matrix_Element) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[101]
+func (x *Operation_Response_Hook) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6528,106 +7042,87 @@ func (x *Matrix_Element) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Matrix_Element.ProtoReflect.Descriptor instead.
-func (*Matrix_Element) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1}
+// Deprecated: Use Operation_Response_Hook.ProtoReflect.Descriptor instead.
+func (*Operation_Response_Hook) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 6}
 }
 
-func (x *Matrix_Element) GetKey() string {
+func (x *Operation_Response_Hook) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Key
+		return x.Diagnostics
 	}
-	return ""
+	return nil
 }
 
-func (x *Matrix_Element) GetValue() string {
+func (x *Operation_Response_Hook) GetType() string {
 	if x != nil {
-		return x.Value
+		return x.Type
 	}
 	return ""
 }
 
-type Matrix_Exclude struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Vector *Matrix_Vector      `protobuf:"bytes,1,opt,name=vector,proto3" json:"vector,omitempty"`
-	Mode   Matrix_Exclude_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=hashicorp.enos.v1.Matrix_Exclude_Mode" json:"mode,omitempty"`
-}
-
-func (x *Matrix_Exclude) Reset() {
-	*x = Matrix_Exclude{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[102]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Operation_Response_Hook) GetCommand() []string {
+	if x != nil {
+		return x.Command
 	}
+	return nil
 }
 
-func (x *Matrix_Exclude) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Matrix_Exclude) ProtoMessage() {}
-
-func (x *Matrix_Exclude) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[102]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Operation_Response_Hook) GetStdout() string {
+	if x != nil {
+		return x.Stdout
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Matrix_Exclude.ProtoReflect.Descriptor instead.
-func (*Matrix_Exclude) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 2}
+func (x *Operation_Response_Hook) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
 }
 
-func (x *Matrix_Exclude) GetVector() *Matrix_Vector {
+func (x *Operation_Response_Hook) GetExitCode() int32 {
 	if x != nil {
-		return x.Vector
+		return x.ExitCode
 	}
-	return nil
+	return 0
 }
 
-func (x *Matrix_Exclude) GetMode() Matrix_Exclude_Mode {
+func (x *Operation_Response_Hook) GetElapsed() *durationpb.Duration {
 	if x != nil {
-		return x.Mode
+		return x.Elapsed
 	}
-	return Matrix_Exclude_MODE_UNSPECIFIED
+	return nil
 }
 
-// ID is the unique identification of the sample and its subsets.
-type Sample_ID struct {
+type Operation_Response_Exec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Diagnostics     []*Diagnostic                    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	TerraformModule *Terraform_Module                `protobuf:"bytes,2,opt,name=terraform_module,proto3" json:"terraform_module,omitempty"`
+	Exec            *Terraform_Command_Exec_Response `protobuf:"bytes,3,opt,name=exec,proto3" json:"exec,omitempty"`
 }
 
-func (x *Sample_ID) Reset() {
-	*x = Sample_ID{}
+func (x *Operation_Response_Exec) Reset() {
+	*x = Operation_Response_Exec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[103]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Sample_ID) String() string {
+func (x *Operation_Response_Exec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sample_ID) ProtoMessage() {}
+func (*Operation_Response_Exec) ProtoMessage() {}
 
-func (x *Sample_ID) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[103]
+func (x *Operation_Response_Exec) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6638,48 +7133,59 @@ func (x *Sample_ID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sample_ID.ProtoReflect.Descriptor instead.
-func (*Sample_ID) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use Operation_Response_Exec.ProtoReflect.Descriptor instead.
+func (*Operation_Response_Exec) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 7}
 }
 
-func (x *Sample_ID) GetName() string {
+func (x *Operation_Response_Exec) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Name
+		return x.Diagnostics
 	}
-	return ""
+	return nil
 }
 
-// A subet refers to a scenario subset of a matrix.
-type Sample_Subset struct {
+func (x *Operation_Response_Exec) GetTerraformModule() *Terraform_Module {
+	if x != nil {
+		return x.TerraformModule
+	}
+	return nil
+}
+
+func (x *Operation_Response_Exec) GetExec() *Terraform_Command_Exec_Response {
+	if x != nil {
+		return x.Exec
+	}
+	return nil
+}
+
+type Operation_Response_Output struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             *Sample_Subset_ID   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ScenarioName   string              `protobuf:"bytes,2,opt,name=scenario_name,proto3" json:"scenario_name,omitempty"`
-	ScenarioFilter string              `protobuf:"bytes,3,opt,name=scenario_filter,proto3" json:"scenario_filter,omitempty"`
-	Attributes     []*Sample_Attribute `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`
-	Matrix         *Matrix             `protobuf:"bytes,5,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	Diagnostics     []*Diagnostic                      `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	TerraformModule *Terraform_Module                  `protobuf:"bytes,2,opt,name=terraform_module,proto3" json:"terraform_module,omitempty"`
+	Output          *Terraform_Command_Output_Response `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
 }
 
-func (x *Sample_Subset) Reset() {
-	*x = Sample_Subset{}
+func (x *Operation_Response_Output) Reset() {
+	*x = Operation_Response_Output{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[104]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Sample_Subset) String() string {
+func (x *Operation_Response_Output) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sample_Subset) ProtoMessage() {}
+func (*Operation_Response_Output) ProtoMessage() {}
 
-func (x *Sample_Subset) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[104]
+func (x *Operation_Response_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6690,79 +7196,70 @@ func (x *Sample_Subset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sample_Subset.ProtoReflect.Descriptor instead.
-func (*Sample_Subset) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11, 1}
+// Deprecated: Use Operation_Response_Output.ProtoReflect.Descriptor instead.
+func (*Operation_Response_Output) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 8}
 }
 
-func (x *Sample_Subset) GetId() *Sample_Subset_ID {
+func (x *Operation_Response_Output) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Id
+		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *Sample_Subset) GetScenarioName() string {
-	if x != nil {
-		return x.ScenarioName
-	}
-	return ""
-}
-
-func (x *Sample_Subset) GetScenarioFilter() string {
-	if x != nil {
-		return x.ScenarioFilter
-	}
-	return ""
-}
-
-func (x *Sample_Subset) GetAttributes() []*Sample_Attribute {
+func (x *Operation_Response_Output) GetTerraformModule() *Terraform_Module {
 	if x != nil {
-		return x.Attributes
+		return x.TerraformModule
 	}
 	return nil
 }
 
-func (x *Sample_Subset) GetMatrix() *Matrix {
+func (x *Operation_Response_Output) GetOutput() *Terraform_Command_Output_Response {
 	if x != nil {
-		return x.Matrix
+		return x.Output
 	}
 	return nil
 }
 
-// A filter lets us dynamically filter our sample frame, limit our sample observation, or control
-// the randomness seed for deterministic observations.
-type Sample_Filter struct {
+// State describes the current Terraform state of a scenario's generated module.
+type Operation_Response_State struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sample         *Ref_Sample         `protobuf:"bytes,1,opt,name=sample,proto3" json:"sample,omitempty"`
-	Subsets        []*Sample_Subset_ID `protobuf:"bytes,2,rep,name=subsets,proto3" json:"subsets,omitempty"`
-	ExcludeSubsets []*Sample_Subset_ID `protobuf:"bytes,3,rep,name=exclude_subsets,proto3" json:"exclude_subsets,omitempty"`
-	MaxElements    int32               `protobuf:"varint,4,opt,name=max_elements,json=maxElements,proto3" json:"max_elements,omitempty"`
-	MinElements    int32               `protobuf:"varint,5,opt,name=min_elements,json=minElements,proto3" json:"min_elements,omitempty"`
-	Percentage     float32             `protobuf:"fixed32,6,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	Seed           int64               `protobuf:"varint,7,opt,name=seed,proto3" json:"seed,omitempty"`
-}
-
-func (x *Sample_Filter) Reset() {
-	*x = Sample_Filter{}
+	Diagnostics     []*Diagnostic                    `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	TerraformModule *Terraform_Module                `protobuf:"bytes,2,opt,name=terraform_module,proto3" json:"terraform_module,omitempty"`
+	Show            *Terraform_Command_Show_Response `protobuf:"bytes,3,opt,name=show,proto3" json:"show,omitempty"`
+	// exists is whether or not the scenario has state with resources in it
+	Exists        bool                             `protobuf:"varint,4,opt,name=exists,proto3" json:"exists,omitempty"`
+	ResourceCount int64                            `protobuf:"varint,5,opt,name=resource_count,proto3" json:"resource_count,omitempty"`
+	Steps         []*Operation_Response_State_Step `protobuf:"bytes,6,rep,name=steps,proto3" json:"steps,omitempty"`
+	// last_modified is the last time that the local state was written. It is
+	// unset when the module uses a remote backend.
+	LastModified *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified,proto3" json:"last_modified,omitempty"`
+	// init is only set if the module had to be initialized before we could show
+	// the state.
+	Init *Terraform_Command_Init_Response `protobuf:"bytes,8,opt,name=init,proto3" json:"init,omitempty"`
+}
+
+func (x *Operation_Response_State) Reset() {
+	*x = Operation_Response_State{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[105]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Sample_Filter) String() string {
+func (x *Operation_Response_State) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sample_Filter) ProtoMessage() {}
+func (*Operation_Response_State) ProtoMessage() {}
 
-func (x *Sample_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[105]
+func (x *Operation_Response_State) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6773,89 +7270,93 @@ func (x *Sample_Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sample_Filter.ProtoReflect.Descriptor instead.
-func (*Sample_Filter) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11, 2}
+// Deprecated: Use Operation_Response_State.ProtoReflect.Descriptor instead.
+func (*Operation_Response_State) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 9}
 }
 
-func (x *Sample_Filter) GetSample() *Ref_Sample {
+func (x *Operation_Response_State) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.Sample
+		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *Sample_Filter) GetSubsets() []*Sample_Subset_ID {
+func (x *Operation_Response_State) GetTerraformModule() *Terraform_Module {
 	if x != nil {
-		return x.Subsets
+		return x.TerraformModule
 	}
 	return nil
 }
 
-func (x *Sample_Filter) GetExcludeSubsets() []*Sample_Subset_ID {
+func (x *Operation_Response_State) GetShow() *Terraform_Command_Show_Response {
 	if x != nil {
-		return x.ExcludeSubsets
+		return x.Show
 	}
 	return nil
 }
 
-func (x *Sample_Filter) GetMaxElements() int32 {
+func (x *Operation_Response_State) GetExists() bool {
 	if x != nil {
-		return x.MaxElements
+		return x.Exists
 	}
-	return 0
+	return false
 }
 
-func (x *Sample_Filter) GetMinElements() int32 {
+func (x *Operation_Response_State) GetResourceCount() int64 {
 	if x != nil {
-		return x.MinElements
+		return x.ResourceCount
 	}
 	return 0
 }
 
-func (x *Sample_Filter) GetPercentage() float32 {
+func (x *Operation_Response_State) GetSteps() []*Operation_Response_State_Step {
 	if x != nil {
-		return x.Percentage
+		return x.Steps
 	}
-	return 0
+	return nil
 }
 
-func (x *Sample_Filter) GetSeed() int64 {
+func (x *Operation_Response_State) GetLastModified() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Seed
+		return x.LastModified
 	}
-	return 0
+	return nil
 }
 
-// A sample element is one instance of the sample observation.
-type Sample_Element struct {
+func (x *Operation_Response_State) GetInit() *Terraform_Command_Init_Response {
+	if x != nil {
+		return x.Init
+	}
+	return nil
+}
+
+type Operation_Response_State_Step struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sample     *Ref_Sample        `protobuf:"bytes,1,opt,name=sample,proto3" json:"sample,omitempty"`
-	Subset     *Ref_Sample_Subset `protobuf:"bytes,2,opt,name=subset,proto3" json:"subset,omitempty"`
-	Scenario   *Ref_Scenario      `protobuf:"bytes,3,opt,name=scenario,proto3" json:"scenario,omitempty"`
-	Attributes *structpb.Struct   `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ResourceCount int64  `protobuf:"varint,2,opt,name=resource_count,proto3" json:"resource_count,omitempty"`
 }
 
-func (x *Sample_Element) Reset() {
-	*x = Sample_Element{}
+func (x *Operation_Response_State_Step) Reset() {
+	*x = Operation_Response_State_Step{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[106]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Sample_Element) String() string {
+func (x *Operation_Response_State_Step) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sample_Element) ProtoMessage() {}
+func (*Operation_Response_State_Step) ProtoMessage() {}
 
-func (x *Sample_Element) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[106]
+func (x *Operation_Response_State_Step) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6866,67 +7367,52 @@ func (x *Sample_Element) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sample_Element.ProtoReflect.Descriptor instead.
-func (*Sample_Element) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11, 3}
-}
-
-func (x *Sample_Element) GetSample() *Ref_Sample {
-	if x != nil {
-		return x.Sample
-	}
-	return nil
+// Deprecated: Use Operation_Response_State_Step.ProtoReflect.Descriptor instead.
+func (*Operation_Response_State_Step) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{9, 2, 9, 0}
 }
 
-func (x *Sample_Element) GetSubset() *Ref_Sample_Subset {
+func (x *Operation_Response_State_Step) GetName() string {
 	if x != nil {
-		return x.Subset
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Sample_Element) GetScenario() *Ref_Scenario {
+func (x *Operation_Response_State_Step) GetResourceCount() int64 {
 	if x != nil {
-		return x.Scenario
+		return x.ResourceCount
 	}
-	return nil
+	return 0
 }
 
-func (x *Sample_Element) GetAttributes() *structpb.Struct {
-	if x != nil {
-		return x.Attributes
-	}
-	return nil
-}
-
-// A sample observation the result of taking a sample.
-type Sample_Observation struct {
+type Terraform_Module struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic     `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Elements    []*Sample_Element `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
-	Filter      *Sample_Filter    `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	ModulePath  string        `protobuf:"bytes,1,opt,name=module_path,proto3" json:"module_path,omitempty"`
+	RcPath      string        `protobuf:"bytes,2,opt,name=rc_path,proto3" json:"rc_path,omitempty"`
+	ScenarioRef *Ref_Scenario `protobuf:"bytes,3,opt,name=scenario_ref,json=scenario,proto3" json:"scenario_ref,omitempty"`
 }
 
-func (x *Sample_Observation) Reset() {
-	*x = Sample_Observation{}
+func (x *Terraform_Module) Reset() {
+	*x = Terraform_Module{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[107]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Sample_Observation) String() string {
+func (x *Terraform_Module) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sample_Observation) ProtoMessage() {}
+func (*Terraform_Module) ProtoMessage() {}
 
-func (x *Sample_Observation) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[107]
+func (x *Terraform_Module) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6937,61 +7423,55 @@ func (x *Sample_Observation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sample_Observation.ProtoReflect.Descriptor instead.
-func (*Sample_Observation) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11, 4}
+// Deprecated: Use Terraform_Module.ProtoReflect.Descriptor instead.
+func (*Terraform_Module) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 0}
 }
 
-func (x *Sample_Observation) GetDiagnostics() []*Diagnostic {
+func (x *Terraform_Module) GetModulePath() string {
 	if x != nil {
-		return x.Diagnostics
+		return x.ModulePath
 	}
-	return nil
+	return ""
 }
 
-func (x *Sample_Observation) GetElements() []*Sample_Element {
+func (x *Terraform_Module) GetRcPath() string {
 	if x != nil {
-		return x.Elements
+		return x.RcPath
 	}
-	return nil
+	return ""
 }
 
-func (x *Sample_Observation) GetFilter() *Sample_Filter {
+func (x *Terraform_Module) GetScenarioRef() *Ref_Scenario {
 	if x != nil {
-		return x.Filter
+		return x.ScenarioRef
 	}
 	return nil
 }
 
-// Attributes are various metadata that was injected into a sample element.
-type Sample_Attribute struct {
+type Terraform_Command struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// To support unknown schema we pass the attributes as JSON encoded cty types and values.
-	CtyType  []byte `protobuf:"bytes,2,opt,name=cty_type,proto3" json:"cty_type,omitempty"`
-	CtyValue []byte `protobuf:"bytes,3,opt,name=cty_value,proto3" json:"cty_value,omitempty"`
 }
 
-func (x *Sample_Attribute) Reset() {
-	*x = Sample_Attribute{}
+func (x *Terraform_Command) Reset() {
+	*x = Terraform_Command{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[108]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Sample_Attribute) String() string {
+func (x *Terraform_Command) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sample_Attribute) ProtoMessage() {}
+func (*Terraform_Command) ProtoMessage() {}
 
-func (x *Sample_Attribute) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[108]
+func (x *Terraform_Command) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7002,57 +7482,36 @@ func (x *Sample_Attribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sample_Attribute.ProtoReflect.Descriptor instead.
-func (*Sample_Attribute) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11, 5}
-}
-
-func (x *Sample_Attribute) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *Sample_Attribute) GetCtyType() []byte {
-	if x != nil {
-		return x.CtyType
-	}
-	return nil
-}
-
-func (x *Sample_Attribute) GetCtyValue() []byte {
-	if x != nil {
-		return x.CtyValue
-	}
-	return nil
+// Deprecated: Use Terraform_Command.ProtoReflect.Descriptor instead.
+func (*Terraform_Command) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1}
 }
 
-type Sample_Subset_ID struct {
+type Terraform_Runner struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Config *Terraform_Runner_Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *Sample_Subset_ID) Reset() {
-	*x = Sample_Subset_ID{}
+func (x *Terraform_Runner) Reset() {
+	*x = Terraform_Runner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[109]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Sample_Subset_ID) String() string {
+func (x *Terraform_Runner) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sample_Subset_ID) ProtoMessage() {}
+func (*Terraform_Runner) ProtoMessage() {}
 
-func (x *Sample_Subset_ID) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[109]
+func (x *Terraform_Runner) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7063,43 +7522,41 @@ func (x *Sample_Subset_ID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sample_Subset_ID.ProtoReflect.Descriptor instead.
-func (*Sample_Subset_ID) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11, 1, 0}
+// Deprecated: Use Terraform_Runner.ProtoReflect.Descriptor instead.
+func (*Terraform_Runner) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 2}
 }
 
-func (x *Sample_Subset_ID) GetName() string {
+func (x *Terraform_Runner) GetConfig() *Terraform_Runner_Config {
 	if x != nil {
-		return x.Name
+		return x.Config
 	}
-	return ""
+	return nil
 }
 
-type Ref_Scenario struct {
+type Terraform_Command_Init struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id *Scenario_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *Ref_Scenario) Reset() {
-	*x = Ref_Scenario{}
+func (x *Terraform_Command_Init) Reset() {
+	*x = Terraform_Command_Init{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[110]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Ref_Scenario) String() string {
+func (x *Terraform_Command_Init) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ref_Scenario) ProtoMessage() {}
+func (*Terraform_Command_Init) ProtoMessage() {}
 
-func (x *Ref_Scenario) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[110]
+func (x *Terraform_Command_Init) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7110,44 +7567,34 @@ func (x *Ref_Scenario) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ref_Scenario.ProtoReflect.Descriptor instead.
-func (*Ref_Scenario) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12, 0}
-}
-
-func (x *Ref_Scenario) GetId() *Scenario_ID {
-	if x != nil {
-		return x.Id
-	}
-	return nil
+// Deprecated: Use Terraform_Command_Init.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Init) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 0}
 }
 
-type Ref_Operation struct {
+type Terraform_Command_Validate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id       string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Scenario *Ref_Scenario `protobuf:"bytes,2,opt,name=scenario,proto3" json:"scenario,omitempty"`
 }
 
-func (x *Ref_Operation) Reset() {
-	*x = Ref_Operation{}
+func (x *Terraform_Command_Validate) Reset() {
+	*x = Terraform_Command_Validate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[111]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Ref_Operation) String() string {
+func (x *Terraform_Command_Validate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ref_Operation) ProtoMessage() {}
+func (*Terraform_Command_Validate) ProtoMessage() {}
 
-func (x *Ref_Operation) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[111]
+func (x *Terraform_Command_Validate) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7158,50 +7605,34 @@ func (x *Ref_Operation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ref_Operation.ProtoReflect.Descriptor instead.
-func (*Ref_Operation) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12, 1}
-}
-
-func (x *Ref_Operation) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Ref_Operation) GetScenario() *Ref_Scenario {
-	if x != nil {
-		return x.Scenario
-	}
-	return nil
+// Deprecated: Use Terraform_Command_Validate.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Validate) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 1}
 }
 
-type Ref_Sample struct {
+type Terraform_Command_Plan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id *Sample_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *Ref_Sample) Reset() {
-	*x = Ref_Sample{}
+func (x *Terraform_Command_Plan) Reset() {
+	*x = Terraform_Command_Plan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[112]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Ref_Sample) String() string {
+func (x *Terraform_Command_Plan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ref_Sample) ProtoMessage() {}
+func (*Terraform_Command_Plan) ProtoMessage() {}
 
-func (x *Ref_Sample) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[112]
+func (x *Terraform_Command_Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7212,43 +7643,34 @@ func (x *Ref_Sample) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ref_Sample.ProtoReflect.Descriptor instead.
-func (*Ref_Sample) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12, 2}
-}
-
-func (x *Ref_Sample) GetId() *Sample_ID {
-	if x != nil {
-		return x.Id
-	}
-	return nil
+// Deprecated: Use Terraform_Command_Plan.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Plan) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 2}
 }
 
-type Ref_Sample_Subset struct {
+type Terraform_Command_Apply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id *Sample_Subset_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *Ref_Sample_Subset) Reset() {
-	*x = Ref_Sample_Subset{}
+func (x *Terraform_Command_Apply) Reset() {
+	*x = Terraform_Command_Apply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[113]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Ref_Sample_Subset) String() string {
+func (x *Terraform_Command_Apply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ref_Sample_Subset) ProtoMessage() {}
+func (*Terraform_Command_Apply) ProtoMessage() {}
 
-func (x *Ref_Sample_Subset) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[113]
+func (x *Terraform_Command_Apply) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7259,44 +7681,86 @@ func (x *Ref_Sample_Subset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ref_Sample_Subset.ProtoReflect.Descriptor instead.
-func (*Ref_Sample_Subset) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12, 2, 0}
-}
-
-func (x *Ref_Sample_Subset) GetId() *Sample_Subset_ID {
-	if x != nil {
-		return x.Id
-	}
-	return nil
+// Deprecated: Use Terraform_Command_Apply.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Apply) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 3}
 }
 
-type FormatRequest_File struct {
+type Terraform_Command_Destroy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Body []byte `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+func (x *Terraform_Command_Destroy) Reset() {
+	*x = Terraform_Command_Destroy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *FormatRequest_File) Reset() {
-	*x = FormatRequest_File{}
+func (x *Terraform_Command_Destroy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terraform_Command_Destroy) ProtoMessage() {}
+
+func (x *Terraform_Command_Destroy) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terraform_Command_Destroy.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Destroy) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 4}
+}
+
+// Progress is a resource level progress update that is parsed from the
+// machine readable output of terraform apply and destroy.
+type Terraform_Command_Progress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type Terraform_Command_Progress_Type `protobuf:"varint,1,opt,name=type,proto3,enum=hashicorp.enos.v1.Terraform_Command_Progress_Type" json:"type,omitempty"`
+	// address is the resource address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// step is the name of the scenario step whose module manages the resource
+	Step string `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`
+	// action is the resource action, e.g. create, update, delete
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// elapsed is how long the action has been running
+	Elapsed *durationpb.Duration `protobuf:"bytes,5,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+	// id is the resource id. It is only set when the action is complete.
+	Id string `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Terraform_Command_Progress) Reset() {
+	*x = Terraform_Command_Progress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[114]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FormatRequest_File) String() string {
+func (x *Terraform_Command_Progress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FormatRequest_File) ProtoMessage() {}
+func (*Terraform_Command_Progress) ProtoMessage() {}
 
-func (x *FormatRequest_File) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[114]
+func (x *Terraform_Command_Progress) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7307,54 +7771,76 @@ func (x *FormatRequest_File) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FormatRequest_File.ProtoReflect.Descriptor instead.
-func (*FormatRequest_File) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{38, 0}
+// Deprecated: Use Terraform_Command_Progress.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Progress) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 5}
 }
 
-func (x *FormatRequest_File) GetPath() string {
+func (x *Terraform_Command_Progress) GetType() Terraform_Command_Progress_Type {
 	if x != nil {
-		return x.Path
+		return x.Type
+	}
+	return Terraform_Command_Progress_TYPE_UNSPECIFIED
+}
+
+func (x *Terraform_Command_Progress) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
 	return ""
 }
 
-func (x *FormatRequest_File) GetBody() []byte {
+func (x *Terraform_Command_Progress) GetStep() string {
 	if x != nil {
-		return x.Body
+		return x.Step
+	}
+	return ""
+}
+
+func (x *Terraform_Command_Progress) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Terraform_Command_Progress) GetElapsed() *durationpb.Duration {
+	if x != nil {
+		return x.Elapsed
 	}
 	return nil
 }
 
-type FormatRequest_Config struct {
+func (x *Terraform_Command_Progress) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Terraform_Command_Exec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Write     bool `protobuf:"varint,1,opt,name=write,proto3" json:"write,omitempty"`
-	List      bool `protobuf:"varint,2,opt,name=list,proto3" json:"list,omitempty"`
-	Check     bool `protobuf:"varint,3,opt,name=check,proto3" json:"check,omitempty"`
-	Diff      bool `protobuf:"varint,4,opt,name=diff,proto3" json:"diff,omitempty"`
-	Recursive bool `protobuf:"varint,5,opt,name=recursive,proto3" json:"recursive,omitempty"`
 }
 
-func (x *FormatRequest_Config) Reset() {
-	*x = FormatRequest_Config{}
+func (x *Terraform_Command_Exec) Reset() {
+	*x = Terraform_Command_Exec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[115]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FormatRequest_Config) String() string {
+func (x *Terraform_Command_Exec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FormatRequest_Config) ProtoMessage() {}
+func (*Terraform_Command_Exec) ProtoMessage() {}
 
-func (x *FormatRequest_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[115]
+func (x *Terraform_Command_Exec) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7365,75 +7851,113 @@ func (x *FormatRequest_Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FormatRequest_Config.ProtoReflect.Descriptor instead.
-func (*FormatRequest_Config) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{38, 1}
+// Deprecated: Use Terraform_Command_Exec.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Exec) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 6}
 }
 
-func (x *FormatRequest_Config) GetWrite() bool {
-	if x != nil {
-		return x.Write
-	}
-	return false
+type Terraform_Command_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *FormatRequest_Config) GetList() bool {
-	if x != nil {
-		return x.List
+func (x *Terraform_Command_Output) Reset() {
+	*x = Terraform_Command_Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *FormatRequest_Config) GetCheck() bool {
-	if x != nil {
-		return x.Check
+func (x *Terraform_Command_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terraform_Command_Output) ProtoMessage() {}
+
+func (x *Terraform_Command_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *FormatRequest_Config) GetDiff() bool {
-	if x != nil {
-		return x.Diff
+// Deprecated: Use Terraform_Command_Output.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Output) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 7}
+}
+
+type Terraform_Command_Show struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Terraform_Command_Show) Reset() {
+	*x = Terraform_Command_Show{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *FormatRequest_Config) GetRecursive() bool {
-	if x != nil {
-		return x.Recursive
+func (x *Terraform_Command_Show) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terraform_Command_Show) ProtoMessage() {}
+
+func (x *Terraform_Command_Show) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type FormatResponse_Response struct {
+// Deprecated: Use Terraform_Command_Show.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Show) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 8}
+}
+
+type Terraform_Command_Init_Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Path        string        `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Changed     bool          `protobuf:"varint,3,opt,name=changed,proto3" json:"changed,omitempty"`
-	Diff        string        `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`
-	Body        string        `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"` // Used for formatted STDIN
+	Stderr      string        `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
 }
 
-func (x *FormatResponse_Response) Reset() {
-	*x = FormatResponse_Response{}
+func (x *Terraform_Command_Init_Response) Reset() {
+	*x = Terraform_Command_Init_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[116]
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FormatResponse_Response) String() string {
+func (x *Terraform_Command_Init_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FormatResponse_Response) ProtoMessage() {}
+func (*Terraform_Command_Init_Response) ProtoMessage() {}
 
-func (x *FormatResponse_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[116]
+func (x *Terraform_Command_Init_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7444,61 +7968,2292 @@ func (x *FormatResponse_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FormatResponse_Response.ProtoReflect.Descriptor instead.
-func (*FormatResponse_Response) Descriptor() ([]byte, []int) {
-	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{39, 0}
+// Deprecated: Use Terraform_Command_Init_Response.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Init_Response) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 0, 0}
 }
 
-func (x *FormatResponse_Response) GetDiagnostics() []*Diagnostic {
+func (x *Terraform_Command_Init_Response) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-func (x *FormatResponse_Response) GetPath() string {
+func (x *Terraform_Command_Init_Response) GetStderr() string {
 	if x != nil {
-		return x.Path
+		return x.Stderr
 	}
 	return ""
 }
 
-func (x *FormatResponse_Response) GetChanged() bool {
-	if x != nil {
-		return x.Changed
-	}
-	return false
+type Terraform_Command_Validate_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics   []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Valid         bool          `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	ErrorCount    int64         `protobuf:"varint,3,opt,name=error_count,proto3" json:"error_count,omitempty"`
+	WarningCount  int64         `protobuf:"varint,4,opt,name=warning_count,proto3" json:"warning_count,omitempty"`
+	FormatVersion string        `protobuf:"bytes,5,opt,name=format_version,proto3" json:"format_version,omitempty"`
 }
 
-func (x *FormatResponse_Response) GetDiff() string {
-	if x != nil {
-		return x.Diff
+func (x *Terraform_Command_Validate_Response) Reset() {
+	*x = Terraform_Command_Validate_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *FormatResponse_Response) GetBody() string {
-	if x != nil {
-		return x.Body
-	}
-	return ""
+func (x *Terraform_Command_Validate_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var File_hashicorp_enos_v1_enos_proto protoreflect.FileDescriptor
+func (*Terraform_Command_Validate_Response) ProtoMessage() {}
 
-var file_hashicorp_enos_v1_enos_proto_rawDesc = []byte{
-	0x0a, 0x1c, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x65, 0x6e, 0x6f, 0x73,
-	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x96, 0x04, 0x0a, 0x02, 0x55, 0x49, 0x1a, 0x8f, 0x04, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73,
+func (x *Terraform_Command_Validate_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terraform_Command_Validate_Response.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Validate_Response) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 1, 0}
+}
+
+func (x *Terraform_Command_Validate_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *Terraform_Command_Validate_Response) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *Terraform_Command_Validate_Response) GetErrorCount() int64 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *Terraform_Command_Validate_Response) GetWarningCount() int64 {
+	if x != nil {
+		return x.WarningCount
+	}
+	return 0
+}
+
+func (x *Terraform_Command_Validate_Response) GetFormatVersion() string {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return ""
+}
+
+type Terraform_Command_Plan_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics    []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	ChangesPresent bool          `protobuf:"varint,2,opt,name=changes_present,proto3" json:"changes_present,omitempty"`
+	Stderr         string        `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+}
+
+func (x *Terraform_Command_Plan_Response) Reset() {
+	*x = Terraform_Command_Plan_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Terraform_Command_Plan_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terraform_Command_Plan_Response) ProtoMessage() {}
+
+func (x *Terraform_Command_Plan_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terraform_Command_Plan_Response.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Plan_Response) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 2, 0}
+}
+
+func (x *Terraform_Command_Plan_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *Terraform_Command_Plan_Response) GetChangesPresent() bool {
+	if x != nil {
+		return x.ChangesPresent
+	}
+	return false
+}
+
+func (x *Terraform_Command_Plan_Response) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+type Terraform_Command_Apply_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Stderr      string        `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+}
+
+func (x *Terraform_Command_Apply_Response) Reset() {
+	*x = Terraform_Command_Apply_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Terraform_Command_Apply_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terraform_Command_Apply_Response) ProtoMessage() {}
+
+func (x *Terraform_Command_Apply_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terraform_Command_Apply_Response.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Apply_Response) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 3, 0}
+}
+
+func (x *Terraform_Command_Apply_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *Terraform_Command_Apply_Response) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+type Terraform_Command_Destroy_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Stderr      string        `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+}
+
+func (x *Terraform_Command_Destroy_Response) Reset() {
+	*x = Terraform_Command_Destroy_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Terraform_Command_Destroy_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terraform_Command_Destroy_Response) ProtoMessage() {}
+
+func (x *Terraform_Command_Destroy_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terraform_Command_Destroy_Response.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Destroy_Response) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 4, 0}
+}
+
+func (x *Terraform_Command_Destroy_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *Terraform_Command_Destroy_Response) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+type Terraform_Command_Exec_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	SubCommand  string        `protobuf:"bytes,2,opt,name=sub_command,proto3" json:"sub_command,omitempty"`
+	Stderr      string        `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	Stdout      string        `protobuf:"bytes,4,opt,name=stdout,proto3" json:"stdout,omitempty"`
+}
+
+func (x *Terraform_Command_Exec_Response) Reset() {
+	*x = Terraform_Command_Exec_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Terraform_Command_Exec_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terraform_Command_Exec_Response) ProtoMessage() {}
+
+func (x *Terraform_Command_Exec_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terraform_Command_Exec_Response.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Exec_Response) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 6, 0}
+}
+
+func (x *Terraform_Command_Exec_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *Terraform_Command_Exec_Response) GetSubCommand() string {
+	if x != nil {
+		return x.SubCommand
+	}
+	return ""
+}
+
+func (x *Terraform_Command_Exec_Response) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+func (x *Terraform_Command_Exec_Response) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+type Terraform_Command_Output_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic                             `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Meta        []*Terraform_Command_Output_Response_Meta `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *Terraform_Command_Output_Response) Reset() {
+	*x = Terraform_Command_Output_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Terraform_Command_Output_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terraform_Command_Output_Response) ProtoMessage() {}
+
+func (x *Terraform_Command_Output_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terraform_Command_Output_Response.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Output_Response) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 7, 0}
+}
+
+func (x *Terraform_Command_Output_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *Terraform_Command_Output_Response) GetMeta() []*Terraform_Command_Output_Response_Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type Terraform_Command_Output_Response_Meta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type      []byte `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value     []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Sensitive bool   `protobuf:"varint,4,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	Stderr    string `protobuf:"bytes,5,opt,name=stderr,proto3" json:"stderr,omitempty"`
+}
+
+func (x *Terraform_Command_Output_Response_Meta) Reset() {
+	*x = Terraform_Command_Output_Response_Meta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Terraform_Command_Output_Response_Meta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terraform_Command_Output_Response_Meta) ProtoMessage() {}
+
+func (x *Terraform_Command_Output_Response_Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terraform_Command_Output_Response_Meta.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Output_Response_Meta) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 7, 0, 0}
+}
+
+func (x *Terraform_Command_Output_Response_Meta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Terraform_Command_Output_Response_Meta) GetType() []byte {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *Terraform_Command_Output_Response_Meta) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Terraform_Command_Output_Response_Meta) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *Terraform_Command_Output_Response_Meta) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+type Terraform_Command_Show_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// For now, we pass the entire state over the wire as a tfjson.State JSON
+	// representation. You can unmarshal the value into that Go type if
+	// necessary.
+	State []byte `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *Terraform_Command_Show_Response) Reset() {
+	*x = Terraform_Command_Show_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Terraform_Command_Show_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terraform_Command_Show_Response) ProtoMessage() {}
+
+func (x *Terraform_Command_Show_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terraform_Command_Show_Response.ProtoReflect.Descriptor instead.
+func (*Terraform_Command_Show_Response) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 1, 8, 0}
+}
+
+func (x *Terraform_Command_Show_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *Terraform_Command_Show_Response) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type Terraform_Runner_Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Terraform CLI flags
+	Flags *Terraform_Runner_Config_Flags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	// The path to the terraform binary
+	BinPath string `protobuf:"bytes,2,opt,name=bin_path,json=binPath,proto3" json:"bin_path,omitempty"`
+	// Where the .terraformrc configuration is
+	ConfigPath string `protobuf:"bytes,3,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"`
+	// The directory from which to execute Terraform
+	WorkingDirPath string `protobuf:"bytes,4,opt,name=working_dir_path,json=workingDirPath,proto3" json:"working_dir_path,omitempty"`
+	// Environment variables to set when executing Terraform
+	Env map[string]string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// User supplied sub-command
+	UserSubCommand string `protobuf:"bytes,6,opt,name=user_sub_command,json=userSubCommand,proto3" json:"user_sub_command,omitempty"`
+	// Output filter
+	OutputFilter string `protobuf:"bytes,7,opt,name=output_filter,json=outputFilter,proto3" json:"output_filter,omitempty"`
+	// Fail on warnings
+	FailOnWarnings bool `protobuf:"varint,8,opt,name=fail_on_warnings,json=failOnWarnings,proto3" json:"fail_on_warnings,omitempty"`
+}
+
+func (x *Terraform_Runner_Config) Reset() {
+	*x = Terraform_Runner_Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Terraform_Runner_Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terraform_Runner_Config) ProtoMessage() {}
+
+func (x *Terraform_Runner_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terraform_Runner_Config.ProtoReflect.Descriptor instead.
+func (*Terraform_Runner_Config) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 2, 0}
+}
+
+func (x *Terraform_Runner_Config) GetFlags() *Terraform_Runner_Config_Flags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Terraform_Runner_Config) GetBinPath() string {
+	if x != nil {
+		return x.BinPath
+	}
+	return ""
+}
+
+func (x *Terraform_Runner_Config) GetConfigPath() string {
+	if x != nil {
+		return x.ConfigPath
+	}
+	return ""
+}
+
+func (x *Terraform_Runner_Config) GetWorkingDirPath() string {
+	if x != nil {
+		return x.WorkingDirPath
+	}
+	return ""
+}
+
+func (x *Terraform_Runner_Config) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Terraform_Runner_Config) GetUserSubCommand() string {
+	if x != nil {
+		return x.UserSubCommand
+	}
+	return ""
+}
+
+func (x *Terraform_Runner_Config) GetOutputFilter() string {
+	if x != nil {
+		return x.OutputFilter
+	}
+	return ""
+}
+
+func (x *Terraform_Runner_Config) GetFailOnWarnings() bool {
+	if x != nil {
+		return x.FailOnWarnings
+	}
+	return false
+}
+
+// Flags are a subset of the Terraform flags that we allow to be settable.
+type Terraform_Runner_Config_Flags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// -backup=path
+	BackupStateFilePath string `protobuf:"bytes,1,opt,name=backup_state_file_path,json=backupStateFilePath,proto3" json:"backup_state_file_path,omitempty"`
+	// -lock-timeout=10s
+	LockTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=lock_timeout,json=lockTimeout,proto3" json:"lock_timeout,omitempty"`
+	// -backend=false
+	NoBackend bool `protobuf:"varint,3,opt,name=no_backend,json=noBackend,proto3" json:"no_backend,omitempty"`
+	// -lock=false
+	NoLock bool `protobuf:"varint,4,opt,name=no_lock,json=noLock,proto3" json:"no_lock,omitempty"`
+	// -get=false
+	NoDownload bool `protobuf:"varint,5,opt,name=no_download,json=noDownload,proto3" json:"no_download,omitempty"`
+	// -refresh=false
+	NoRefresh bool `protobuf:"varint,6,opt,name=no_refresh,json=noRefresh,proto3" json:"no_refresh,omitempty"`
+	// -parallelism=n
+	Parallelism uint32 `protobuf:"varint,7,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	// -refresh-only
+	RefreshOnly bool `protobuf:"varint,8,opt,name=refresh_only,json=refreshOnly,proto3" json:"refresh_only,omitempty"`
+	// -upgrade
+	Upgrade bool `protobuf:"varint,9,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	// -reconfigure=false
+	NoReconfigure bool `protobuf:"varint,10,opt,name=no_reconfigure,json=noReconfigure,proto3" json:"no_reconfigure,omitempty"`
+	// -target=module.<step> for each step and the steps it depends on
+	TargetSteps []string `protobuf:"bytes,11,rep,name=target_steps,proto3" json:"target_steps,omitempty"`
+	// -target=module.<step> for each step and the steps that depend on it
+	FromSteps []string `protobuf:"bytes,12,rep,name=from_steps,proto3" json:"from_steps,omitempty"`
+}
+
+func (x *Terraform_Runner_Config_Flags) Reset() {
+	*x = Terraform_Runner_Config_Flags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Terraform_Runner_Config_Flags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terraform_Runner_Config_Flags) ProtoMessage() {}
+
+func (x *Terraform_Runner_Config_Flags) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terraform_Runner_Config_Flags.ProtoReflect.Descriptor instead.
+func (*Terraform_Runner_Config_Flags) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{10, 2, 0, 1}
+}
+
+func (x *Terraform_Runner_Config_Flags) GetBackupStateFilePath() string {
+	if x != nil {
+		return x.BackupStateFilePath
+	}
+	return ""
+}
+
+func (x *Terraform_Runner_Config_Flags) GetLockTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.LockTimeout
+	}
+	return nil
+}
+
+func (x *Terraform_Runner_Config_Flags) GetNoBackend() bool {
+	if x != nil {
+		return x.NoBackend
+	}
+	return false
+}
+
+func (x *Terraform_Runner_Config_Flags) GetNoLock() bool {
+	if x != nil {
+		return x.NoLock
+	}
+	return false
+}
+
+func (x *Terraform_Runner_Config_Flags) GetNoDownload() bool {
+	if x != nil {
+		return x.NoDownload
+	}
+	return false
+}
+
+func (x *Terraform_Runner_Config_Flags) GetNoRefresh() bool {
+	if x != nil {
+		return x.NoRefresh
+	}
+	return false
+}
+
+func (x *Terraform_Runner_Config_Flags) GetParallelism() uint32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *Terraform_Runner_Config_Flags) GetRefreshOnly() bool {
+	if x != nil {
+		return x.RefreshOnly
+	}
+	return false
+}
+
+func (x *Terraform_Runner_Config_Flags) GetUpgrade() bool {
+	if x != nil {
+		return x.Upgrade
+	}
+	return false
+}
+
+func (x *Terraform_Runner_Config_Flags) GetNoReconfigure() bool {
+	if x != nil {
+		return x.NoReconfigure
+	}
+	return false
+}
+
+func (x *Terraform_Runner_Config_Flags) GetTargetSteps() []string {
+	if x != nil {
+		return x.TargetSteps
+	}
+	return nil
+}
+
+func (x *Terraform_Runner_Config_Flags) GetFromSteps() []string {
+	if x != nil {
+		return x.FromSteps
+	}
+	return nil
+}
+
+type Matrix_Vector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elements []*Matrix_Element `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (x *Matrix_Vector) Reset() {
+	*x = Matrix_Vector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Matrix_Vector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Matrix_Vector) ProtoMessage() {}
+
+func (x *Matrix_Vector) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Matrix_Vector.ProtoReflect.Descriptor instead.
+func (*Matrix_Vector) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *Matrix_Vector) GetElements() []*Matrix_Element {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+type Matrix_Element struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Matrix_Element) Reset() {
+	*x = Matrix_Element{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Matrix_Element) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Matrix_Element) ProtoMessage() {}
+
+func (x *Matrix_Element) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Matrix_Element.ProtoReflect.Descriptor instead.
+func (*Matrix_Element) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *Matrix_Element) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Matrix_Element) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Matrix_Exclude struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vector *Matrix_Vector      `protobuf:"bytes,1,opt,name=vector,proto3" json:"vector,omitempty"`
+	Mode   Matrix_Exclude_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=hashicorp.enos.v1.Matrix_Exclude_Mode" json:"mode,omitempty"`
+}
+
+func (x *Matrix_Exclude) Reset() {
+	*x = Matrix_Exclude{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Matrix_Exclude) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Matrix_Exclude) ProtoMessage() {}
+
+func (x *Matrix_Exclude) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Matrix_Exclude.ProtoReflect.Descriptor instead.
+func (*Matrix_Exclude) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{11, 2}
+}
+
+func (x *Matrix_Exclude) GetVector() *Matrix_Vector {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+func (x *Matrix_Exclude) GetMode() Matrix_Exclude_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Matrix_Exclude_MODE_UNSPECIFIED
+}
+
+// ID is the unique identification of the sample and its subsets.
+type Sample_ID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Sample_ID) Reset() {
+	*x = Sample_ID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sample_ID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sample_ID) ProtoMessage() {}
+
+func (x *Sample_ID) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sample_ID.ProtoReflect.Descriptor instead.
+func (*Sample_ID) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *Sample_ID) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// A subet refers to a scenario subset of a matrix.
+type Sample_Subset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             *Sample_Subset_ID   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScenarioName   string              `protobuf:"bytes,2,opt,name=scenario_name,proto3" json:"scenario_name,omitempty"`
+	ScenarioFilter string              `protobuf:"bytes,3,opt,name=scenario_filter,proto3" json:"scenario_filter,omitempty"`
+	Attributes     []*Sample_Attribute `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	Matrix         *Matrix             `protobuf:"bytes,5,opt,name=matrix,proto3" json:"matrix,omitempty"`
+}
+
+func (x *Sample_Subset) Reset() {
+	*x = Sample_Subset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sample_Subset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sample_Subset) ProtoMessage() {}
+
+func (x *Sample_Subset) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sample_Subset.ProtoReflect.Descriptor instead.
+func (*Sample_Subset) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *Sample_Subset) GetId() *Sample_Subset_ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Sample_Subset) GetScenarioName() string {
+	if x != nil {
+		return x.ScenarioName
+	}
+	return ""
+}
+
+func (x *Sample_Subset) GetScenarioFilter() string {
+	if x != nil {
+		return x.ScenarioFilter
+	}
+	return ""
+}
+
+func (x *Sample_Subset) GetAttributes() []*Sample_Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Sample_Subset) GetMatrix() *Matrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+// A filter lets us dynamically filter our sample frame, limit our sample observation, or control
+// the randomness seed for deterministic observations.
+type Sample_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sample         *Ref_Sample         `protobuf:"bytes,1,opt,name=sample,proto3" json:"sample,omitempty"`
+	Subsets        []*Sample_Subset_ID `protobuf:"bytes,2,rep,name=subsets,proto3" json:"subsets,omitempty"`
+	ExcludeSubsets []*Sample_Subset_ID `protobuf:"bytes,3,rep,name=exclude_subsets,proto3" json:"exclude_subsets,omitempty"`
+	MaxElements    int32               `protobuf:"varint,4,opt,name=max_elements,json=maxElements,proto3" json:"max_elements,omitempty"`
+	MinElements    int32               `protobuf:"varint,5,opt,name=min_elements,json=minElements,proto3" json:"min_elements,omitempty"`
+	Percentage     float32             `protobuf:"fixed32,6,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Seed           int64               `protobuf:"varint,7,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *Sample_Filter) Reset() {
+	*x = Sample_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sample_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sample_Filter) ProtoMessage() {}
+
+func (x *Sample_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sample_Filter.ProtoReflect.Descriptor instead.
+func (*Sample_Filter) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12, 2}
+}
+
+func (x *Sample_Filter) GetSample() *Ref_Sample {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+func (x *Sample_Filter) GetSubsets() []*Sample_Subset_ID {
+	if x != nil {
+		return x.Subsets
+	}
+	return nil
+}
+
+func (x *Sample_Filter) GetExcludeSubsets() []*Sample_Subset_ID {
+	if x != nil {
+		return x.ExcludeSubsets
+	}
+	return nil
+}
+
+func (x *Sample_Filter) GetMaxElements() int32 {
+	if x != nil {
+		return x.MaxElements
+	}
+	return 0
+}
+
+func (x *Sample_Filter) GetMinElements() int32 {
+	if x != nil {
+		return x.MinElements
+	}
+	return 0
+}
+
+func (x *Sample_Filter) GetPercentage() float32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *Sample_Filter) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// A sample element is one instance of the sample observation.
+type Sample_Element struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sample     *Ref_Sample        `protobuf:"bytes,1,opt,name=sample,proto3" json:"sample,omitempty"`
+	Subset     *Ref_Sample_Subset `protobuf:"bytes,2,opt,name=subset,proto3" json:"subset,omitempty"`
+	Scenario   *Ref_Scenario      `protobuf:"bytes,3,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Attributes *structpb.Struct   `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *Sample_Element) Reset() {
+	*x = Sample_Element{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sample_Element) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sample_Element) ProtoMessage() {}
+
+func (x *Sample_Element) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sample_Element.ProtoReflect.Descriptor instead.
+func (*Sample_Element) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12, 3}
+}
+
+func (x *Sample_Element) GetSample() *Ref_Sample {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+func (x *Sample_Element) GetSubset() *Ref_Sample_Subset {
+	if x != nil {
+		return x.Subset
+	}
+	return nil
+}
+
+func (x *Sample_Element) GetScenario() *Ref_Scenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+func (x *Sample_Element) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// A sample observation the result of taking a sample.
+type Sample_Observation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic     `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Elements    []*Sample_Element `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	Filter      *Sample_Filter    `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *Sample_Observation) Reset() {
+	*x = Sample_Observation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sample_Observation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sample_Observation) ProtoMessage() {}
+
+func (x *Sample_Observation) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sample_Observation.ProtoReflect.Descriptor instead.
+func (*Sample_Observation) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12, 4}
+}
+
+func (x *Sample_Observation) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *Sample_Observation) GetElements() []*Sample_Element {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+func (x *Sample_Observation) GetFilter() *Sample_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Attributes are various metadata that was injected into a sample element.
+type Sample_Attribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// To support unknown schema we pass the attributes as JSON encoded cty types and values.
+	CtyType  []byte `protobuf:"bytes,2,opt,name=cty_type,proto3" json:"cty_type,omitempty"`
+	CtyValue []byte `protobuf:"bytes,3,opt,name=cty_value,proto3" json:"cty_value,omitempty"`
+}
+
+func (x *Sample_Attribute) Reset() {
+	*x = Sample_Attribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sample_Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sample_Attribute) ProtoMessage() {}
+
+func (x *Sample_Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sample_Attribute.ProtoReflect.Descriptor instead.
+func (*Sample_Attribute) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12, 5}
+}
+
+func (x *Sample_Attribute) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Sample_Attribute) GetCtyType() []byte {
+	if x != nil {
+		return x.CtyType
+	}
+	return nil
+}
+
+func (x *Sample_Attribute) GetCtyValue() []byte {
+	if x != nil {
+		return x.CtyValue
+	}
+	return nil
+}
+
+type Sample_Subset_ID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Sample_Subset_ID) Reset() {
+	*x = Sample_Subset_ID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sample_Subset_ID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sample_Subset_ID) ProtoMessage() {}
+
+func (x *Sample_Subset_ID) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sample_Subset_ID.ProtoReflect.Descriptor instead.
+func (*Sample_Subset_ID) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{12, 1, 0}
+}
+
+func (x *Sample_Subset_ID) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Ref_Scenario struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id *Scenario_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Ref_Scenario) Reset() {
+	*x = Ref_Scenario{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ref_Scenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ref_Scenario) ProtoMessage() {}
+
+func (x *Ref_Scenario) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ref_Scenario.ProtoReflect.Descriptor instead.
+func (*Ref_Scenario) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *Ref_Scenario) GetId() *Scenario_ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type Ref_Operation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Scenario *Ref_Scenario `protobuf:"bytes,2,opt,name=scenario,proto3" json:"scenario,omitempty"`
+}
+
+func (x *Ref_Operation) Reset() {
+	*x = Ref_Operation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ref_Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ref_Operation) ProtoMessage() {}
+
+func (x *Ref_Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ref_Operation.ProtoReflect.Descriptor instead.
+func (*Ref_Operation) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *Ref_Operation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Ref_Operation) GetScenario() *Ref_Scenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+type Ref_Sample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id *Sample_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Ref_Sample) Reset() {
+	*x = Ref_Sample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ref_Sample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ref_Sample) ProtoMessage() {}
+
+func (x *Ref_Sample) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ref_Sample.ProtoReflect.Descriptor instead.
+func (*Ref_Sample) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{13, 2}
+}
+
+func (x *Ref_Sample) GetId() *Sample_ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type Ref_Sample_Subset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id *Sample_Subset_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Ref_Sample_Subset) Reset() {
+	*x = Ref_Sample_Subset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ref_Sample_Subset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ref_Sample_Subset) ProtoMessage() {}
+
+func (x *Ref_Sample_Subset) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ref_Sample_Subset.ProtoReflect.Descriptor instead.
+func (*Ref_Sample_Subset) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{13, 2, 0}
+}
+
+func (x *Ref_Sample_Subset) GetId() *Sample_Subset_ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type Lint_Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Hcl  []byte `protobuf:"bytes,2,opt,name=hcl,proto3" json:"hcl,omitempty"`
+}
+
+func (x *Lint_Config) Reset() {
+	*x = Lint_Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lint_Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lint_Config) ProtoMessage() {}
+
+func (x *Lint_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lint_Config.ProtoReflect.Descriptor instead.
+func (*Lint_Config) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *Lint_Config) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Lint_Config) GetHcl() []byte {
+	if x != nil {
+		return x.Hcl
+	}
+	return nil
+}
+
+type ExecScenarioStreamRequest_Start struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workspace *Workspace       `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Filter    *Scenario_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// tty allocates a pseudo-terminal for the sub-command
+	Tty  bool                                    `protobuf:"varint,3,opt,name=tty,proto3" json:"tty,omitempty"`
+	Size *ExecScenarioStreamRequest_TerminalSize `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	// term is the clients TERM environment variable
+	Term string `protobuf:"bytes,5,opt,name=term,proto3" json:"term,omitempty"`
+}
+
+func (x *ExecScenarioStreamRequest_Start) Reset() {
+	*x = ExecScenarioStreamRequest_Start{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecScenarioStreamRequest_Start) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecScenarioStreamRequest_Start) ProtoMessage() {}
+
+func (x *ExecScenarioStreamRequest_Start) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecScenarioStreamRequest_Start.ProtoReflect.Descriptor instead.
+func (*ExecScenarioStreamRequest_Start) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *ExecScenarioStreamRequest_Start) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+func (x *ExecScenarioStreamRequest_Start) GetFilter() *Scenario_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExecScenarioStreamRequest_Start) GetTty() bool {
+	if x != nil {
+		return x.Tty
+	}
+	return false
+}
+
+func (x *ExecScenarioStreamRequest_Start) GetSize() *ExecScenarioStreamRequest_TerminalSize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+func (x *ExecScenarioStreamRequest_Start) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type ExecScenarioStreamRequest_Stdin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Eof  bool   `protobuf:"varint,2,opt,name=eof,proto3" json:"eof,omitempty"`
+}
+
+func (x *ExecScenarioStreamRequest_Stdin) Reset() {
+	*x = ExecScenarioStreamRequest_Stdin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecScenarioStreamRequest_Stdin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecScenarioStreamRequest_Stdin) ProtoMessage() {}
+
+func (x *ExecScenarioStreamRequest_Stdin) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecScenarioStreamRequest_Stdin.ProtoReflect.Descriptor instead.
+func (*ExecScenarioStreamRequest_Stdin) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{43, 1}
+}
+
+func (x *ExecScenarioStreamRequest_Stdin) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExecScenarioStreamRequest_Stdin) GetEof() bool {
+	if x != nil {
+		return x.Eof
+	}
+	return false
+}
+
+type ExecScenarioStreamRequest_TerminalSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows uint32 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols uint32 `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
+}
+
+func (x *ExecScenarioStreamRequest_TerminalSize) Reset() {
+	*x = ExecScenarioStreamRequest_TerminalSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecScenarioStreamRequest_TerminalSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecScenarioStreamRequest_TerminalSize) ProtoMessage() {}
+
+func (x *ExecScenarioStreamRequest_TerminalSize) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecScenarioStreamRequest_TerminalSize.ProtoReflect.Descriptor instead.
+func (*ExecScenarioStreamRequest_TerminalSize) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{43, 2}
+}
+
+func (x *ExecScenarioStreamRequest_TerminalSize) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *ExecScenarioStreamRequest_TerminalSize) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+type ExecScenarioStreamResponse_Exit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic   `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Decode      *DecodeResponse `protobuf:"bytes,2,opt,name=decode,proto3" json:"decode,omitempty"`
+	Scenario    *Ref_Scenario   `protobuf:"bytes,3,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	ExitCode    int32           `protobuf:"varint,4,opt,name=exit_code,proto3" json:"exit_code,omitempty"`
+}
+
+func (x *ExecScenarioStreamResponse_Exit) Reset() {
+	*x = ExecScenarioStreamResponse_Exit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecScenarioStreamResponse_Exit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecScenarioStreamResponse_Exit) ProtoMessage() {}
+
+func (x *ExecScenarioStreamResponse_Exit) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecScenarioStreamResponse_Exit.ProtoReflect.Descriptor instead.
+func (*ExecScenarioStreamResponse_Exit) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{44, 0}
+}
+
+func (x *ExecScenarioStreamResponse_Exit) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *ExecScenarioStreamResponse_Exit) GetDecode() *DecodeResponse {
+	if x != nil {
+		return x.Decode
+	}
+	return nil
+}
+
+func (x *ExecScenarioStreamResponse_Exit) GetScenario() *Ref_Scenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+func (x *ExecScenarioStreamResponse_Exit) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type FormatRequest_File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Body []byte `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *FormatRequest_File) Reset() {
+	*x = FormatRequest_File{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FormatRequest_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatRequest_File) ProtoMessage() {}
+
+func (x *FormatRequest_File) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatRequest_File.ProtoReflect.Descriptor instead.
+func (*FormatRequest_File) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (x *FormatRequest_File) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FormatRequest_File) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type FormatRequest_Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Write     bool `protobuf:"varint,1,opt,name=write,proto3" json:"write,omitempty"`
+	List      bool `protobuf:"varint,2,opt,name=list,proto3" json:"list,omitempty"`
+	Check     bool `protobuf:"varint,3,opt,name=check,proto3" json:"check,omitempty"`
+	Diff      bool `protobuf:"varint,4,opt,name=diff,proto3" json:"diff,omitempty"`
+	Recursive bool `protobuf:"varint,5,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Canonical bool `protobuf:"varint,6,opt,name=canonical,proto3" json:"canonical,omitempty"`
+}
+
+func (x *FormatRequest_Config) Reset() {
+	*x = FormatRequest_Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FormatRequest_Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatRequest_Config) ProtoMessage() {}
+
+func (x *FormatRequest_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatRequest_Config.ProtoReflect.Descriptor instead.
+func (*FormatRequest_Config) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{53, 1}
+}
+
+func (x *FormatRequest_Config) GetWrite() bool {
+	if x != nil {
+		return x.Write
+	}
+	return false
+}
+
+func (x *FormatRequest_Config) GetList() bool {
+	if x != nil {
+		return x.List
+	}
+	return false
+}
+
+func (x *FormatRequest_Config) GetCheck() bool {
+	if x != nil {
+		return x.Check
+	}
+	return false
+}
+
+func (x *FormatRequest_Config) GetDiff() bool {
+	if x != nil {
+		return x.Diff
+	}
+	return false
+}
+
+func (x *FormatRequest_Config) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *FormatRequest_Config) GetCanonical() bool {
+	if x != nil {
+		return x.Canonical
+	}
+	return false
+}
+
+type FormatResponse_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Path        string        `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Changed     bool          `protobuf:"varint,3,opt,name=changed,proto3" json:"changed,omitempty"`
+	Diff        string        `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`
+	Body        string        `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"` // Used for formatted STDIN
+}
+
+func (x *FormatResponse_Response) Reset() {
+	*x = FormatResponse_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FormatResponse_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatResponse_Response) ProtoMessage() {}
+
+func (x *FormatResponse_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatResponse_Response.ProtoReflect.Descriptor instead.
+func (*FormatResponse_Response) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{54, 0}
+}
+
+func (x *FormatResponse_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *FormatResponse_Response) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FormatResponse_Response) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *FormatResponse_Response) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *FormatResponse_Response) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// Module is the result of cleaning a generated module directory.
+type CleanScenariosResponse_Module struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// path is the generated module directory
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// scenario is the scenario that generates the module. It is not set if the
+	// module is orphaned.
+	Scenario *Ref_Scenario `protobuf:"bytes,3,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	// orphaned is set if no scenario in the flight plan generates the module
+	Orphaned bool                                `protobuf:"varint,4,opt,name=orphaned,proto3" json:"orphaned,omitempty"`
+	State    CleanScenariosResponse_Module_State `protobuf:"varint,5,opt,name=state,proto3,enum=hashicorp.enos.v1.CleanScenariosResponse_Module_State" json:"state,omitempty"`
+	// removed is set if the module directory was removed, or would be if not
+	// a dry run.
+	Removed bool `protobuf:"varint,6,opt,name=removed,proto3" json:"removed,omitempty"`
+	// provider_cache_removed is set if the modules provider plugin cache was
+	// removed, or would be if not a dry run.
+	ProviderCacheRemoved bool `protobuf:"varint,7,opt,name=provider_cache_removed,proto3" json:"provider_cache_removed,omitempty"`
+	// reclaimed_bytes is the amount of disk space that was, or would be,
+	// reclaimed.
+	ReclaimedBytes uint64 `protobuf:"varint,8,opt,name=reclaimed_bytes,proto3" json:"reclaimed_bytes,omitempty"`
+}
+
+func (x *CleanScenariosResponse_Module) Reset() {
+	*x = CleanScenariosResponse_Module{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanScenariosResponse_Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanScenariosResponse_Module) ProtoMessage() {}
+
+func (x *CleanScenariosResponse_Module) ProtoReflect() protoreflect.Message {
+	mi := &file_hashicorp_enos_v1_enos_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanScenariosResponse_Module.ProtoReflect.Descriptor instead.
+func (*CleanScenariosResponse_Module) Descriptor() ([]byte, []int) {
+	return file_hashicorp_enos_v1_enos_proto_rawDescGZIP(), []int{64, 0}
+}
+
+func (x *CleanScenariosResponse_Module) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *CleanScenariosResponse_Module) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CleanScenariosResponse_Module) GetScenario() *Ref_Scenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+func (x *CleanScenariosResponse_Module) GetOrphaned() bool {
+	if x != nil {
+		return x.Orphaned
+	}
+	return false
+}
+
+func (x *CleanScenariosResponse_Module) GetState() CleanScenariosResponse_Module_State {
+	if x != nil {
+		return x.State
+	}
+	return CleanScenariosResponse_Module_STATE_UNSPECIFIED
+}
+
+func (x *CleanScenariosResponse_Module) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+func (x *CleanScenariosResponse_Module) GetProviderCacheRemoved() bool {
+	if x != nil {
+		return x.ProviderCacheRemoved
+	}
+	return false
+}
+
+func (x *CleanScenariosResponse_Module) GetReclaimedBytes() uint64 {
+	if x != nil {
+		return x.ReclaimedBytes
+	}
+	return 0
+}
+
+var File_hashicorp_enos_v1_enos_proto protoreflect.FileDescriptor
+
+var file_hashicorp_enos_v1_enos_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x65, 0x6e, 0x6f, 0x73,
+	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xce, 0x04, 0x0a, 0x02, 0x55, 0x49, 0x1a, 0xc7, 0x04, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73,
 	0x5f, 0x74, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x5f, 0x74,
 	0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
@@ -7516,270 +10271,342 @@ var file_hashicorp_enos_v1_enos_proto_rawDesc = []byte{
 	0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12,
 	0x2a, 0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69,
 	0x6e, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x5f,
-	0x6f, 0x6e, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x59, 0x0a, 0x06, 0x46,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a,
-	0x11, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x5f, 0x54, 0x45,
-	0x58, 0x54, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a,
-	0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
-	0x48, 0x54, 0x4d, 0x4c, 0x10, 0x03, 0x22, 0x71, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x15, 0x0a, 0x11, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x45, 0x56, 0x45, 0x4c,
-	0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x45, 0x56, 0x45, 0x4c,
-	0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x45, 0x56, 0x45, 0x4c,
-	0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x45, 0x56, 0x45,
-	0x4c, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x05, 0x22, 0xb5, 0x05, 0x0a, 0x0a, 0x44, 0x69,
-	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65,
-	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2e,
-	0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3f,
-	0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x53,
-	0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x1a,
-	0x8a, 0x02, 0x0a, 0x07, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x68, 0x69, 0x67,
-	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x68, 0x69, 0x67, 0x68, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x12, 0x32, 0x0a, 0x14, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x65,
-	0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x6f, 0x6e, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x06,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x5f, 0x54,
+	0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x48, 0x54, 0x4d, 0x4c, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x54, 0x55, 0x49, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x41, 0x52, 0x49, 0x46, 0x10, 0x06, 0x22, 0x71,
+	0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x56, 0x45, 0x4c,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12,
+	0x0e, 0x0a, 0x0a, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x02, 0x12,
+	0x0e, 0x0a, 0x0a, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x03, 0x12,
+	0x0f, 0x0a, 0x0b, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x04,
+	0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10,
+	0x05, 0x22, 0xc9, 0x05, 0x0a, 0x0a, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x12, 0x42, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x07,
+	0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x1a, 0x8a, 0x02, 0x0a, 0x07,
+	0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x32, 0x0a,
 	0x14, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
-	0x73, 0x74, 0x69, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x4d, 0x0a, 0x0f,
-	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x64, 0x0a, 0x08, 0x53,
-	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x56, 0x45, 0x52,
-	0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x45, 0x56, 0x45, 0x52,
-	0x49, 0x54, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53,
-	0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10,
-	0x03, 0x22, 0xce, 0x01, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66,
-	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
-	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x2e, 0x50, 0x6f, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x65,
-	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
-	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x1a, 0x45, 0x0a, 0x03, 0x50,
-	0x6f, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x62, 0x79,
-	0x74, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x3d, 0x0a, 0x0a, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50,
-	0x6c, 0x61, 0x6e, 0x52, 0x0a, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x70, 0x6c, 0x61, 0x6e, 0x12,
-	0x4c, 0x0a, 0x0b, 0x74, 0x66, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x63, 0x66, 0x67, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f,
-	0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x0b, 0x74, 0x66, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x63, 0x66, 0x67, 0x12, 0x10, 0x0a,
-	0x03, 0x64, 0x69, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x72, 0x12,
-	0x17, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6f, 0x75, 0x74, 0x44, 0x69, 0x72, 0x22, 0xe7, 0x02, 0x0a, 0x0a, 0x46, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x64, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x44,
-	0x69, 0x72, 0x12, 0x46, 0x0a, 0x08, 0x65, 0x6e, 0x6f, 0x73, 0x5f, 0x68, 0x63, 0x6c, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50,
-	0x6c, 0x61, 0x6e, 0x2e, 0x45, 0x6e, 0x6f, 0x73, 0x48, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x08, 0x65, 0x6e, 0x6f, 0x73, 0x5f, 0x68, 0x63, 0x6c, 0x12, 0x54, 0x0a, 0x0d, 0x65, 0x6e,
-	0x6f, 0x73, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x5f, 0x68, 0x63, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6c, 0x61, 0x6e,
-	0x2e, 0x45, 0x6e, 0x6f, 0x73, 0x56, 0x61, 0x72, 0x73, 0x48, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0d, 0x65, 0x6e, 0x6f, 0x73, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x5f, 0x68, 0x63, 0x6c,
-	0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x6f, 0x73, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x5f, 0x65, 0x6e,
-	0x76, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x6f, 0x73, 0x5f, 0x76, 0x61,
-	0x72, 0x73, 0x5f, 0x65, 0x6e, 0x76, 0x1a, 0x3a, 0x0a, 0x0c, 0x45, 0x6e, 0x6f, 0x73, 0x48, 0x63,
-	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x45, 0x6e, 0x6f, 0x73, 0x56, 0x61, 0x72, 0x73, 0x48, 0x63,
-	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x90, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
-	0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e,
-	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x70, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46,
-	0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x0a, 0x66, 0x6c, 0x69, 0x67, 0x68,
-	0x74, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0xd9, 0x06, 0x0a, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
-	0x69, 0x6f, 0x1a, 0xa2, 0x01, 0x0a, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a,
-	0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xbd, 0x02, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x3a, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78,
-	0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x12, 0x3b, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x45, 0x78, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x4b, 0x0a,
-	0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61,
-	0x74, 0x72, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x1a, 0x0b, 0x0a, 0x09, 0x53, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x41, 0x6c, 0x6c, 0x1a, 0xe7, 0x02, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x6c,
-	0x69, 0x6e, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x63,
-	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
-	0x12, 0x31, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d, 0x61, 0x74,
-	0x72, 0x69, 0x78, 0x12, 0x3e, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x68, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x45, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x4d, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x64, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x56, 0x45, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x22, 0x7a, 0x0a,
+	0x0e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a,
+	0x0b, 0x72, 0x65, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x6f, 0x63, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x64, 0x6f, 0x63, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0xce, 0x01, 0x0a, 0x05, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x1a, 0x45, 0x0a, 0x03, 0x50, 0x6f, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x62, 0x79, 0x74, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x09, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x0a, 0x66, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x4c, 0x0a, 0x0b, 0x74, 0x66, 0x5f, 0x65, 0x78,
+	0x65, 0x63, 0x5f, 0x63, 0x66, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x66, 0x5f, 0x65, 0x78, 0x65,
+	0x63, 0x5f, 0x63, 0x66, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x5f, 0x64,
+	0x69, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x44, 0x69, 0x72,
+	0x22, 0xe7, 0x02, 0x0a, 0x0a, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x19, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x44, 0x69, 0x72, 0x12, 0x46, 0x0a, 0x08, 0x65, 0x6e,
+	0x6f, 0x73, 0x5f, 0x68, 0x63, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x45, 0x6e, 0x6f, 0x73,
+	0x48, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x6f, 0x73, 0x5f, 0x68,
+	0x63, 0x6c, 0x12, 0x54, 0x0a, 0x0d, 0x65, 0x6e, 0x6f, 0x73, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x5f,
+	0x68, 0x63, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x45, 0x6e, 0x6f, 0x73, 0x56, 0x61, 0x72,
+	0x73, 0x48, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x65, 0x6e, 0x6f, 0x73, 0x5f,
+	0x76, 0x61, 0x72, 0x73, 0x5f, 0x68, 0x63, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x6f, 0x73,
+	0x5f, 0x76, 0x61, 0x72, 0x73, 0x5f, 0x65, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x65, 0x6e, 0x6f, 0x73, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x5f, 0x65, 0x6e, 0x76, 0x1a, 0x3a,
+	0x0a, 0x0c, 0x45, 0x6e, 0x6f, 0x73, 0x48, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x45, 0x6e,
+	0x6f, 0x73, 0x56, 0x61, 0x72, 0x73, 0x48, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x90, 0x01, 0x0a, 0x0e, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x3d,
+	0x0a, 0x0a, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x52, 0x0a, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0xf8, 0x08,
+	0x0a, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x1a, 0xa2, 0x01, 0x0a, 0x02, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0xdc, 0x04, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4c,
+	0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
 	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e,
-	0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74,
-	0x65, 0x70, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x73, 0x1a, 0x74, 0x0a, 0x04, 0x53,
-	0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x61,
-	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
-	0x73, 0x22, 0x73, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x6c, 0x6c,
+	0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x3a, 0x0a, 0x07,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
 	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x2b, 0x0a, 0x06, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb5, 0x28, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xc6, 0x05, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x3b, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
-	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61,
-	0x72, 0x69, 0x6f, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x3a, 0x0a,
-	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09,
-	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4b, 0x0a, 0x08, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x74, 0x72, 0x69, 0x78, 0x2e, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x07, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x13, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x12, 0x44, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x08,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x73, 0x12, 0x42,
+	0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x1a, 0x0b, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x6c, 0x6c, 0x1a,
+	0x4d, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x65, 0x1a, 0xe7,
+	0x02, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x73,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x31, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x3e, 0x0a, 0x05, 0x73, 0x74,
+	0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x53,
+	0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x73, 0x1a, 0x74, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x73, 0x22, 0x73, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x1a, 0x2b, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x3d,
+	0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xdd, 0x01, 0x0a, 0x0d,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x43, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x68, 0x61,
 	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x45, 0x0a, 0x06, 0x6c, 0x61,
-	0x75, 0x6e, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x61, 0x75, 0x6e, 0x63,
-	0x68, 0x12, 0x48, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x22, 0x50, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4b, 0x45, 0x45, 0x50, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44,
+	0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4b, 0x45, 0x45, 0x50, 0x5f, 0x46, 0x4f, 0x52, 0x10, 0x03, 0x1a, 0xba, 0x08, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61,
+	0x72, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x66, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x4b, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
 	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
-	0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x3c, 0x0a, 0x03, 0x72,
-	0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a,
+	0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x12, 0x45, 0x0a, 0x06, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x48, 0x00,
+	0x52, 0x06, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x12, 0x48, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74,
+	0x72, 0x6f, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x12, 0x3c, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x72, 0x75, 0x6e,
+	0x12, 0x3f, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x65,
+	0x63, 0x12, 0x45, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x0a, 0x0a, 0x08,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x1a, 0x07, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x1a, 0x89, 0x01, 0x0a, 0x06, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x12, 0x52, 0x0a, 0x0e,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x1a, 0x5a, 0x0a,
+	0x07, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x4f, 0x0a, 0x10, 0x74, 0x65, 0x72, 0x72,
+	0x61, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d,
+	0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x10, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f,
+	0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x1a, 0x59, 0x0a, 0x03, 0x52, 0x75, 0x6e,
+	0x12, 0x52, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x1a, 0x06, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x1a, 0x08, 0x0a, 0x06,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0x07, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42,
+	0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xde, 0x27, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x30, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
 	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52,
-	0x75, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x3f, 0x0a, 0x04, 0x65, 0x78, 0x65,
-	0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4c, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
 	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78,
-	0x65, 0x63, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x45, 0x0a, 0x06, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x1a, 0x0a, 0x0a, 0x08, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x1a, 0x07, 0x0a,
-	0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x1a, 0x08, 0x0a, 0x06, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68,
-	0x1a, 0x09, 0x0a, 0x07, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x1a, 0x05, 0x0a, 0x03, 0x52,
-	0x75, 0x6e, 0x1a, 0x06, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x1a, 0x08, 0x0a, 0x06, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xe4, 0x18,
-	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69,
-	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b,
-	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x30, 0x0a, 0x02, 0x6f,
-	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
-	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x3b, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4c, 0x0a, 0x08, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x68,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x48,
+	0x00, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x46, 0x0a, 0x06, 0x6c, 0x61, 0x75, 0x6e,
+	0x63, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68,
+	0x12, 0x49, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
+	0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x3d, 0x0a, 0x03, 0x72,
+	0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x52, 0x75, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x65, 0x78,
+	0x65, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x46, 0x0a, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68,
 	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
 	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
-	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x46, 0x0a,
-	0x06, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x48, 0x00, 0x52, 0x06, 0x6c,
-	0x61, 0x75, 0x6e, 0x63, 0x68, 0x12, 0x49, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65,
-	0x73, 0x74, 0x72, 0x6f, 0x79, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
-	0x12, 0x3d, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12,
-	0x40, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x65,
-	0x63, 0x12, 0x46, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48,
-	0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0x9c, 0x01, 0x0a, 0x08, 0x47, 0x65,
+	0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x9c, 0x01, 0x0a, 0x08, 0x47, 0x65,
 	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
 	0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61,
 	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
@@ -7813,7 +10640,7 @@ var file_hashicorp_enos_v1_enos_proto_rawDesc = []byte{
 	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
 	0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e,
 	0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x70,
-	0x6c, 0x61, 0x6e, 0x1a, 0xc4, 0x03, 0x0a, 0x06, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x12, 0x3f,
+	0x6c, 0x61, 0x6e, 0x1a, 0x9f, 0x05, 0x0a, 0x06, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x12, 0x3f,
 	0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
 	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
@@ -7841,509 +10668,921 @@ var file_hashicorp_enos_v1_enos_proto_rawDesc = []byte{
 	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
 	0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d,
 	0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x1a, 0x8d, 0x03, 0x0a, 0x07, 0x44,
-	0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x5b, 0x0a, 0x0e,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x1a, 0xcf, 0x03, 0x0a, 0x07, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f,
+	0x79, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x5c, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x77,
+	0x12, 0x4a, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x04,
+	0x69, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x2e, 0x49, 0x6e, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04,
+	0x69, 0x6e, 0x69, 0x74, 0x12, 0x4f, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x74,
+	0x72, 0x6f, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x64, 0x65,
+	0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x6f, 0x6f, 0x6b,
+	0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x1a, 0x8f, 0x06, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12,
+	0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x4a, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x04,
+	0x69, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x2e, 0x49, 0x6e, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04,
+	0x69, 0x6e, 0x69, 0x74, 0x12, 0x52, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61,
+	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61,
+	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e,
+	0x12, 0x49, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x5c, 0x0a, 0x10, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x68, 0x6f, 0x77,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x4f, 0x0a, 0x07, 0x64, 0x65, 0x73,
+	0x74, 0x72, 0x6f, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x5b, 0x0a, 0x0e,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0xc1, 0x04, 0x0a, 0x0d, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3f, 0x0a, 0x0b, 0x64,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x42, 0x0a, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x55, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x3b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x5e, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x10, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x12, 0x4f, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72,
+	0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x44,
+	0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x22, 0x67, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12,
+	0x17, 0x0a, 0x13, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x55, 0x54, 0x43,
+	0x4f, 0x4d, 0x45, 0x5f, 0x4b, 0x45, 0x50, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x55,
+	0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x53,
+	0x54, 0x52, 0x4f, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x1a, 0xf8, 0x01,
+	0x0a, 0x04, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
 	0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61,
 	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
 	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67,
-	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x5c, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x6f, 0x72,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x1a, 0xe0, 0x01, 0x0a, 0x04, 0x45, 0x78, 0x65,
+	0x63, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x4f, 0x0a, 0x10, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x5f,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x10, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
 	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x4a, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
-	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x12, 0x46, 0x0a, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x1a, 0xe8, 0x01, 0x0a, 0x06,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x4f, 0x0a, 0x10, 0x74, 0x65, 0x72, 0x72, 0x61,
+	0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x10, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72,
+	0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72,
+	0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0xb7, 0x04, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x12, 0x4f, 0x0a, 0x10, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x10, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x05, 0x73, 0x74,
+	0x65, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x1a, 0x42, 0x0a, 0x04,
+	0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x9b, 0x09, 0x0a, 0x05, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x12, 0x30, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x48, 0x0a, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
 	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f,
 	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x4f, 0x0a, 0x07, 0x64, 0x65, 0x73,
-	0x74, 0x72, 0x6f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x1a, 0xf0, 0x04, 0x0a, 0x03, 0x52,
-	0x75, 0x6e, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
-	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67,
-	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
-	0x69, 0x63, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12,
-	0x46, 0x0a, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x54, 0x0a, 0x08, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
 	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
 	0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x52, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x61, 0x6e, 0x64, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x48, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x4b, 0x0a, 0x05, 0x61,
+	0x70, 0x70, 0x6c, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x51, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74,
+	0x72, 0x6f, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x68, 0x61, 0x73, 0x68,
 	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
 	0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x70,
-	0x6c, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x48, 0x0a, 0x04, 0x65,
+	0x78, 0x65, 0x63, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68,
 	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
 	0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e,
-	0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x70,
-	0x6c, 0x61, 0x6e, 0x12, 0x49, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
-	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x5c,
-	0x0a, 0x10, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x68,
-	0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
-	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72,
-	0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x53,
-	0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0e, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x4f, 0x0a, 0x07,
-	0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x1a, 0xe0, 0x01,
-	0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61,
-	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67,
-	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x4f, 0x0a, 0x10, 0x74, 0x65, 0x72, 0x72, 0x61,
-	0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x45, 0x78, 0x65, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x4e, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72,
+	0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x12,
+	0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
 	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x10, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72,
-	0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61,
-	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63,
-	0x1a, 0xe8, 0x01, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x04,
+	0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x42, 0x07,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x41, 0x49, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x41, 0x52, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x1c,
+	0x0a, 0x18, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x0a, 0x22, 0xb9, 0x15, 0x0a, 0x09, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d,
+	0x1a, 0x85, 0x01, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x63, 0x65, 0x6e, 0x61,
+	0x72, 0x69, 0x6f, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x08,
+	0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x1a, 0xe4, 0x0c, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x1a, 0x6b, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x1a, 0x63, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64,
+	0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72,
+	0x72, 0x1a, 0xde, 0x01, 0x0a, 0x08, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x1a, 0xd1,
+	0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64,
 	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
 	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
-	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x4f, 0x0a, 0x10,
-	0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61,
-	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x10, 0x74, 0x65, 0x72,
-	0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x4c, 0x0a,
-	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x1a, 0x96, 0x01, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x1a, 0x8d, 0x01, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
 	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x8c, 0x08, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3f,
-	0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
-	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
-	0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
-	0x30, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61,
-	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f,
-	0x70, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f,
-	0x6e, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x3b, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x4c,
-	0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2e, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x48, 0x00, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x04,
-	0x69, 0x6e, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73,
+	0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x1a, 0x6c, 0x0a, 0x05, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x1a, 0x63, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x1a, 0x6e, 0x0a, 0x07, 0x44, 0x65, 0x73,
+	0x74, 0x72, 0x6f, 0x79, 0x1a, 0x63, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x1a, 0xdb, 0x02, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f,
+	0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x47,
+	0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x50, 0x50, 0x4c, 0x59, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12,
+	0x16, 0x0a, 0x12, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x04, 0x1a, 0xa6, 0x01, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63,
+	0x1a, 0x9d, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f,
+	0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74,
+	0x1a, 0xa1, 0x02, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0x96, 0x02, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x4d, 0x0a, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72,
+	0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x1a, 0x7a, 0x0a, 0x04, 0x4d, 0x65, 0x74, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x64, 0x65, 0x72, 0x72, 0x1a, 0x69, 0x0a, 0x04, 0x53, 0x68, 0x6f, 0x77, 0x1a, 0x61, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a,
+	0xbc, 0x07, 0x0a, 0x06, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73,
 	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x2e, 0x49, 0x6e, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
-	0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x54, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0xed,
+	0x06, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x46, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
 	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72,
-	0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x48, 0x00, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x04,
-	0x70, 0x6c, 0x61, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
-	0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x4b, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66,
-	0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x41, 0x70, 0x70, 0x6c,
-	0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x61, 0x70,
-	0x70, 0x6c, 0x79, 0x12, 0x51, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f,
-	0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72,
-	0x6f, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x64,
-	0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x48, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a,
+	0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x44, 0x69, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
 	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f,
-	0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63,
-	0x12, 0x4e, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x34, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x12, 0x48, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32,
+	0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x28,
+	0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x53, 0x75,
+	0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a,
+	0x10, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x57,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0xbc, 0x03, 0x0a, 0x05, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3c,
+	0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x6e, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e,
+	0x6f, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f,
+	0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x6f, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x5f, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c,
+	0x69, 0x73, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c,
+	0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6e, 0x6f, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0x98,
+	0x04, 0x0a, 0x06, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x3a, 0x0a, 0x07, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x12, 0x3b, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x45, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x1a, 0x47,
+	0x0a, 0x06, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x31, 0x0a, 0x07, 0x45, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xdc, 0x01, 0x0a, 0x07, 0x45,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x3a, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x5b, 0x0a, 0x04,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43,
+	0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x10, 0x03, 0x22, 0xd7, 0x0a, 0x0a, 0x06, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x52, 0x07, 0x73, 0x75, 0x62, 0x73,
+	0x65, 0x74, 0x73, 0x1a, 0x18, 0x0a, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x9f, 0x02,
+	0x0a, 0x06, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x12, 0x33, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x43, 0x0a,
+	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d,
+	0x61, 0x74, 0x72, 0x69, 0x78, 0x1a, 0x18, 0x0a, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a,
+	0xc7, 0x02, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x66, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x12, 0x3d, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x65, 0x74, 0x2e, 0x49, 0x44, 0x52, 0x07, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x73,
+	0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x73,
+	0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x2e, 0x49, 0x44, 0x52, 0x0f,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x1a, 0xf4, 0x01, 0x0a, 0x07, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x06,
+	0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x65, 0x74, 0x52, 0x06, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x73,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x1a, 0xc7, 0x01, 0x0a, 0x0b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x12, 0x3d, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x38, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x57, 0x0a, 0x09, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x74, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x74, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x74, 0x79, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x74, 0x79, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x92, 0x02, 0x0a, 0x03, 0x52, 0x65, 0x66, 0x1a, 0x3a, 0x0a, 0x08, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x2e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x1a, 0x58, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x1a, 0x75, 0x0a, 0x06, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x1a, 0x3d, 0x0a, 0x06, 0x53, 0x75, 0x62,
+	0x73, 0x65, 0x74, 0x12, 0x33, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x65,
+	0x74, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x6e,
+	0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x36, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x6e, 0x74, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x36, 0x0a, 0x04, 0x4c,
+	0x69, 0x6e, 0x74, 0x1a, 0x2e, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x63, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x68, 0x63, 0x6c, 0x22, 0x5a, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61,
+	0x72, 0x69, 0x6f, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22,
+	0xd6, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a,
+	0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0xfe, 0x02, 0x0a, 0x08, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x2e, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22,
+	0x87, 0x01, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x46,
+	0x41, 0x55, 0x4c, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x56, 0x41, 0x52, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x45, 0x4e, 0x56, 0x5f, 0x56, 0x41, 0x52, 0x10, 0x03,
+	0x12, 0x10, 0x0a, 0x0c, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x45, 0x54,
+	0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x44,
+	0x45, 0x43, 0x4c, 0x41, 0x52, 0x45, 0x44, 0x10, 0x05, 0x22, 0x32, 0x0a, 0x1c, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x99, 0x01,
+	0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x37, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x89,
+	0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x22, 0xca, 0x02, 0x0a, 0x25, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0d,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x6e, 0x6f, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x6e, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x6f, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6e, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x26, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x46,
+	0x0a, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x22, 0xd2, 0x01, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x3d, 0x0a, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61,
+	0x72, 0x69, 0x6f, 0x52, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x22, 0xaa,
+	0x01, 0x0a, 0x20, 0x45, 0x6e, 0x6f, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72,
+	0x69, 0x6f, 0x12, 0x3b, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x22, 0xd9, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65,
+	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
 	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x48, 0x00, 0x52, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
-	0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a,
-	0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10,
-	0x03, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e,
-	0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10,
-	0x06, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c,
-	0x45, 0x44, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
-	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x5f, 0x57,
-	0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x0a, 0x22, 0x97,
-	0x12, 0x0a, 0x09, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x1a, 0x85, 0x01, 0x0a,
-	0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x63, 0x5f,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x63, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x5f,
-	0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8f, 0x01, 0x0a,
+	0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xd6,
+	0x01, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68,
 	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x66, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e,
-	0x61, 0x72, 0x69, 0x6f, 0x1a, 0x86, 0x0a, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x1a, 0x6b, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x1a, 0x63, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
-	0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68,
-	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
-	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x1a, 0xde, 0x01,
-	0x0a, 0x08, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x1a, 0xd1, 0x01, 0x0a, 0x08, 0x52,
+	0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x91, 0x02, 0x0a, 0x16, 0x4c, 0x61, 0x75, 0x6e,
+	0x63, 0x68, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3a,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x0e, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b,
+	0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0xd7, 0x01, 0x0a, 0x17,
+	0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e,
 	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68,
 	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
 	0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61,
-	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x24, 0x0a, 0x0d, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x96,
-	0x01, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x1a, 0x8d, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
-	0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68,
-	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
-	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
-	0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x1a, 0x6c, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x6c, 0x79,
-	0x1a, 0x63, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b,
-	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
-	0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x64, 0x65, 0x72, 0x72, 0x1a, 0x6e, 0x0a, 0x07, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
-	0x1a, 0x63, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b,
-	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
-	0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x64, 0x65, 0x72, 0x72, 0x1a, 0xa6, 0x01, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x1a, 0x9d,
-	0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64,
-	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
-	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x1a, 0xa1,
-	0x02, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0x96, 0x02, 0x0a, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f,
+	0x79, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c,
+	0x5f, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x64, 0x22, 0xd8, 0x01,
+	0x0a, 0x18, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b,
+	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61,
 	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67,
-	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x4d, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66,
-	0x6f, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x1a, 0x7a, 0x0a, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x64, 0x65, 0x72, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65,
-	0x72, 0x72, 0x1a, 0x69, 0x0a, 0x04, 0x53, 0x68, 0x6f, 0x77, 0x1a, 0x61, 0x0a, 0x08, 0x52, 0x65,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06,
+	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x70,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xd5, 0x01, 0x0a,
+	0x15, 0x52, 0x65, 0x61, 0x70, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
 	0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61,
 	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
 	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67,
-	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0xf8, 0x06,
-	0x0a, 0x06, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
-	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72,
-	0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0xa9, 0x06, 0x0a,
-	0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x46, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61,
-	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12,
-	0x19, 0x0a, 0x08, 0x62, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x77,
-	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69,
-	0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
-	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d,
-	0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45,
-	0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x28, 0x0a, 0x10,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x53, 0x75, 0x62, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x66,
-	0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x57, 0x61, 0x72,
-	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xf8, 0x02,
-	0x0a, 0x05, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x61, 0x63, 0x6b, 0x75,
-	0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3c, 0x0a, 0x0c,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6c,
-	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f,
-	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x6e, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x5f,
-	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x4c, 0x6f,
-	0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x6f, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x52, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73,
-	0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65,
-	0x6c, 0x69, 0x73, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
-	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6e, 0x6f, 0x52, 0x65, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x22, 0x98, 0x04, 0x0a, 0x06, 0x4d, 0x61, 0x74,
-	0x72, 0x69, 0x78, 0x12, 0x3a, 0x0a, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e,
-	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12,
-	0x3a, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x65,
-	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68,
-	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
-	0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x1a, 0x47, 0x0a, 0x06, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e,
-	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x1a, 0x31, 0x0a, 0x07, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x1a, 0xdc, 0x01, 0x0a, 0x07, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x12, 0x38, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x04, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
-	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74,
-	0x72, 0x69, 0x78, 0x2e, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
-	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x5b, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14,
-	0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x58, 0x41,
-	0x43, 0x54, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45,
-	0x51, 0x55, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02,
-	0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
-	0x53, 0x10, 0x03, 0x22, 0xd7, 0x0a, 0x0a, 0x06, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x2c,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0a,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x73, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
-	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x65, 0x74, 0x52, 0x07, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x73, 0x1a, 0x18, 0x0a,
-	0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x9f, 0x02, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x73,
-	0x65, 0x74, 0x12, 0x33, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74,
-	0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x63, 0x65, 0x6e, 0x61,
-	0x72, 0x69, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
-	0x0f, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
-	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61,
-	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x06,
-	0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68,
-	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x1a,
-	0x18, 0x0a, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0xc7, 0x02, 0x0a, 0x06, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x73,
-	0x75, 0x62, 0x73, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68,
-	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x2e, 0x49,
-	0x44, 0x52, 0x07, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x78,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
-	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x65, 0x74, 0x2e, 0x49, 0x44, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
-	0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0b, 0x6d, 0x61, 0x78, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
-	0x65, 0x65, 0x64, 0x1a, 0xf4, 0x01, 0x0a, 0x07, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x35, 0x0a, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06,
-	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x52, 0x06, 0x73, 0x75,
-	0x62, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53,
-	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
-	0x6f, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0xc7, 0x01, 0x0a, 0x0b, 0x4f,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69,
-	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b,
-	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x65,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x1a, 0x57, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x74, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x63, 0x74, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x92, 0x02,
-	0x0a, 0x03, 0x52, 0x65, 0x66, 0x1a, 0x3a, 0x0a, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
-	0x6f, 0x12, 0x2e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69,
-	0x64, 0x1a, 0x58, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b,
-	0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
-	0x6f, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x1a, 0x75, 0x0a, 0x06, 0x53,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x1a, 0x3d, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x12, 0x33, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68,
-	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x2e, 0x49, 0x44, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
-	0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
-	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
-	0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x69, 0x74,
-	0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x69, 0x74, 0x5f,
-	0x73, 0x68, 0x61, 0x22, 0xca, 0x02, 0x0a, 0x25, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
-	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09,
-	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68,
-	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
-	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68,
-	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0c,
-	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15,
-	0x6e, 0x6f, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x65, 0x6e,
-	0x61, 0x72, 0x69, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6e, 0x6f, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73,
-	0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x6f, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f,
-	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6e,
-	0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
-	0x22, 0xec, 0x01, 0x0a, 0x26, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65,
-	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64,
-	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
-	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06,
-	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x53, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65,
+	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68,
 	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x22,
-	0x8e, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xd4, 0x01, 0x0a, 0x14, 0x52, 0x75, 0x6e,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x8e, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
 	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b,
 	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61,
 	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
@@ -8352,7 +11591,7 @@ var file_hashicorp_enos_v1_enos_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
 	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
 	0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x22, 0xd2, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x22, 0xd5, 0x01, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
 	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69,
 	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
@@ -8361,45 +11600,98 @@ var file_hashicorp_enos_v1_enos_proto_rawDesc = []byte{
 	0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61,
 	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
 	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06,
-	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72,
-	0x69, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x61, 0x73, 0x68,
-	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x66, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x09, 0x73, 0x63, 0x65, 0x6e,
-	0x61, 0x72, 0x69, 0x6f, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x20, 0x45, 0x6e, 0x6f, 0x73, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
-	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x63,
-	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68,
+	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf1, 0x04, 0x0a, 0x19, 0x45, 0x78, 0x65,
+	0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x4a, 0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
+	0x69, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x74, 0x64, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x53,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x69, 0x7a, 0x65, 0x1a, 0xf4, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3a, 0x0a,
+	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x03, 0x74, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x1a, 0x2d, 0x0a, 0x05, 0x53, 0x74,
+	0x64, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6f, 0x66, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x65, 0x6f, 0x66, 0x1a, 0x36, 0x0a, 0x0c, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c,
+	0x73, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x86, 0x03, 0x0a,
+	0x1a, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x06, 0x73,
+	0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x73,
+	0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12,
+	0x48, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x69,
+	0x74, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x69, 0x74, 0x1a, 0xdd, 0x01, 0x0a, 0x04, 0x45, 0x78,
+	0x69, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3b,
+	0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68,
 	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x48, 0x00, 0x52,
-	0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x3b, 0x0a, 0x06, 0x64, 0x65, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68,
-	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06,
-	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53,
-	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
-	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61,
-	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xd9, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
-	0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e,
-	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64,
-	0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x65,
+	0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xd7, 0x01, 0x0a, 0x17, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x63, 0x65,
 	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
 	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
@@ -8408,31 +11700,133 @@ var file_hashicorp_enos_v1_enos_proto_rawDesc = []byte{
 	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68,
 	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
 	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xd6, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
-	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
-	0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0a,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90,
-	0x01, 0x0a, 0x16, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
-	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xd7, 0x01, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
+	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x50, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x22, 0xca, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x8c,
+	0x01, 0x0a, 0x14, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xdc, 0x01,
+	0x0a, 0x15, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68,
 	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
-	0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x22, 0xd7, 0x01, 0x0a, 0x17, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x65, 0x6e,
-	0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd8, 0x02, 0x0a,
+	0x0d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b,
+	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x68, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x2e, 0x0a, 0x04,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x98, 0x01, 0x0a,
+	0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e,
+	0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61,
+	0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0xbf, 0x02, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b,
+	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x1a, 0xa1, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x4f, 0x0a, 0x1b, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x70, 0x22, 0x99, 0x01, 0x0a, 0x1c, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x38, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x44, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x02, 0x6f, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x70, 0x22, 0x97, 0x01, 0x0a,
+	0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x41, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd5, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x3f, 0x0a,
 	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
 	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
@@ -8440,57 +11834,22 @@ var file_hashicorp_enos_v1_enos_proto_rawDesc = []byte{
 	0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
 	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
 	0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x17,
-	0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
-	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
-	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
-	0xd8, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x53, 0x63, 0x65, 0x6e, 0x61,
-	0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b,
-	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
-	0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a,
-	0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68,
+	0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68,
 	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x52,
-	0x75, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3a,
-	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xd4, 0x01, 0x0a, 0x14, 0x52,
-	0x75, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
-	0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
-	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61,
-	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
-	0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
-	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x8e, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x9d,
+	0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x45,
+	0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x91,
+	0x01, 0x0a, 0x17, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
 	0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f,
 	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
@@ -8499,49 +11858,37 @@ var file_hashicorp_enos_v1_enos_proto_rawDesc = []byte{
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
 	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61,
 	0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x22, 0xd5, 0x01, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61,
-	0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b,
-	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
-	0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a,
-	0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68,
-	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
-	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xd7, 0x01,
-	0x0a, 0x17, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61,
-	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64,
-	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x73, 0x68,
-	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x50, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
-	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09,
-	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x13, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x72, 0x22, 0x8f, 0x02, 0x0a, 0x18, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x6f,
+	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x15, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xd6, 0x05, 0x0a, 0x16, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
 	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e,
@@ -8549,258 +11896,208 @@ var file_hashicorp_enos_v1_enos_proto_rawDesc = []byte{
 	0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
 	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a,
-	0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x4a, 0x0a,
+	0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
 	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x4f, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
-	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x61,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x1a, 0xd9, 0x03, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3f,
+	0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x68, 0x61,
 	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xdc, 0x01, 0x0a, 0x15, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
-	0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
-	0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x6f,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb9, 0x02, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69,
-	0x6c, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
-	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x2e, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x62, 0x6f, 0x64, 0x79, 0x1a, 0x7a, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14,
-	0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x69,
-	0x66, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65,
-	0x22, 0xbf, 0x02, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
-	0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
-	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61,
-	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
-	0x74, 0x69, 0x63, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
-	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x1a, 0xa1,
-	0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64,
-	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
-	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69,
-	0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x12,
-	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
-	0x64, 0x79, 0x22, 0x4f, 0x0a, 0x1b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x30, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f,
+	0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x22,
+	0x3f, 0x0a, 0x07, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x32, 0xd5, 0x13, 0x0a, 0x0b, 0x45, 0x6e, 0x6f, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x5b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x97, 0x01,
+	0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
+	0x69, 0x6f, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x38, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65,
+	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x27, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x6f, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x67, 0x0a, 0x0e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x28, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x2b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0f, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x29, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x75,
+	0x6e, 0x63, 0x68, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6d, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x53, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f,
+	0x79, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x53, 0x63, 0x65,
+	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x61, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73,
+	0x12, 0x26, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61,
+	0x72, 0x69, 0x6f, 0x73, 0x12, 0x27, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65,
+	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
 	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x02, 0x6f, 0x70, 0x22, 0x99, 0x01, 0x0a, 0x1c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
-	0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68,
-	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
-	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
-	0x44, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0f, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x29, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
 	0x20, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x02, 0x6f, 0x70, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64,
-	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
-	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x41, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x14, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2e,
 	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xd5, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x61,
-	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67,
-	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
-	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f,
-	0x64, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x58, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
 	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x17, 0x4f, 0x75, 0x74, 0x6c,
-	0x69, 0x6e, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
-	0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x8f, 0x02, 0x0a, 0x18,
-	0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67,
-	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69,
-	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61, 0x73, 0x68,
-	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x64, 0x65,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61,
-	0x72, 0x69, 0x6f, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x6f, 0x75, 0x74,
-	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
-	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x73, 0x22, 0x3f, 0x0a,
-	0x07, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xbf,
-	0x0d, 0x0a, 0x0b, 0x45, 0x6e, 0x6f, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x68,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x68,
 	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
-	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x97, 0x01, 0x0a, 0x1e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
-	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a,
+	0x0d, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x27,
 	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61,
-	0x72, 0x69, 0x6f, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
-	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x65,
-	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x27, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x33, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x6f, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x67, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x28, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63,
-	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x70, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x63, 0x65,
-	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x2b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
-	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0f, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x65,
-	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x29, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x75, 0x6e, 0x63,
-	0x68, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x65, 0x6e,
-	0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x6d, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
-	0x69, 0x6f, 0x73, 0x12, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
-	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x53,
-	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x53, 0x63, 0x65, 0x6e, 0x61,
-	0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61,
-	0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x26,
+	0x76, 0x31, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x6c,
+	0x69, 0x6e, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x67, 0x0a, 0x0e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61,
+	0x72, 0x69, 0x6f, 0x73, 0x12, 0x28, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
 	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
-	0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x63,
-	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x64, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
-	0x6f, 0x73, 0x12, 0x27, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65,
-	0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61,
-	0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x61,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0f, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x29,
+	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2c, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x61,
 	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x29, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x20, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01,
+	0x12, 0x7c, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x2e, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64,
+	0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12,
+	0x27, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e,
+	0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x2f, 0x2e,
 	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x14, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2e, 0x2e, 0x68,
-	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x68,
-	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x58, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
 	0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
-	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0b, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x68, 0x61, 0x73,
-	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0d, 0x4f,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x68,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x64, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x70, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
+	0x69, 0x6f, 0x73, 0x12, 0x27, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e,
+	0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x70, 0x53, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68,
 	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x6d, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x63, 0x65, 0x6e,
-	0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x2a, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
-	0x70, 0x2e, 0x65, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
-	0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x65, 0x6e,
-	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x63, 0x65,
-	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68,
-	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x65, 0x6e, 0x6f, 0x73, 0x2f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x52, 0x65, 0x61, 0x70, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2f, 0x65, 0x6e, 0x6f, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70,
+	0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8815,381 +12112,511 @@ func file_hashicorp_enos_v1_enos_proto_rawDescGZIP() []byte {
 	return file_hashicorp_enos_v1_enos_proto_rawDescData
 }
 
-var file_hashicorp_enos_v1_enos_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_hashicorp_enos_v1_enos_proto_msgTypes = make([]protoimpl.MessageInfo, 117)
+var file_hashicorp_enos_v1_enos_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_hashicorp_enos_v1_enos_proto_msgTypes = make([]protoimpl.MessageInfo, 149)
 var file_hashicorp_enos_v1_enos_proto_goTypes = []any{
-	(UI_Settings_Format)(0),    // 0: hashicorp.enos.v1.UI.Settings.Format
-	(UI_Settings_Level)(0),     // 1: hashicorp.enos.v1.UI.Settings.Level
-	(Diagnostic_Severity)(0),   // 2: hashicorp.enos.v1.Diagnostic.Severity
-	(Operation_Status)(0),      // 3: hashicorp.enos.v1.Operation.Status
-	(Matrix_Exclude_Mode)(0),   // 4: hashicorp.enos.v1.Matrix.Exclude.Mode
-	(*UI)(nil),                 // 5: hashicorp.enos.v1.UI
-	(*Diagnostic)(nil),         // 6: hashicorp.enos.v1.Diagnostic
-	(*Range)(nil),              // 7: hashicorp.enos.v1.Range
-	(*Workspace)(nil),          // 8: hashicorp.enos.v1.Workspace
-	(*FlightPlan)(nil),         // 9: hashicorp.enos.v1.FlightPlan
-	(*DecodeResponse)(nil),     // 10: hashicorp.enos.v1.DecodeResponse
-	(*Scenario)(nil),           // 11: hashicorp.enos.v1.Scenario
-	(*Operator)(nil),           // 12: hashicorp.enos.v1.Operator
-	(*Operation)(nil),          // 13: hashicorp.enos.v1.Operation
-	(*Terraform)(nil),          // 14: hashicorp.enos.v1.Terraform
-	(*Matrix)(nil),             // 15: hashicorp.enos.v1.Matrix
-	(*Sample)(nil),             // 16: hashicorp.enos.v1.Sample
-	(*Ref)(nil),                // 17: hashicorp.enos.v1.Ref
-	(*GetVersionRequest)(nil),  // 18: hashicorp.enos.v1.GetVersionRequest
-	(*GetVersionResponse)(nil), // 19: hashicorp.enos.v1.GetVersionResponse
-	(*ValidateScenariosConfigurationRequest)(nil),  // 20: hashicorp.enos.v1.ValidateScenariosConfigurationRequest
-	(*ValidateScenariosConfigurationResponse)(nil), // 21: hashicorp.enos.v1.ValidateScenariosConfigurationResponse
-	(*ListScenariosRequest)(nil),                   // 22: hashicorp.enos.v1.ListScenariosRequest
-	(*ListScenariosResponse)(nil),                  // 23: hashicorp.enos.v1.ListScenariosResponse
-	(*EnosServiceListScenariosResponse)(nil),       // 24: hashicorp.enos.v1.EnosServiceListScenariosResponse
-	(*GenerateScenariosRequest)(nil),               // 25: hashicorp.enos.v1.GenerateScenariosRequest
-	(*GenerateScenariosResponse)(nil),              // 26: hashicorp.enos.v1.GenerateScenariosResponse
-	(*CheckScenariosRequest)(nil),                  // 27: hashicorp.enos.v1.CheckScenariosRequest
-	(*CheckScenariosResponse)(nil),                 // 28: hashicorp.enos.v1.CheckScenariosResponse
-	(*LaunchScenariosRequest)(nil),                 // 29: hashicorp.enos.v1.LaunchScenariosRequest
-	(*LaunchScenariosResponse)(nil),                // 30: hashicorp.enos.v1.LaunchScenariosResponse
-	(*DestroyScenariosRequest)(nil),                // 31: hashicorp.enos.v1.DestroyScenariosRequest
-	(*DestroyScenariosResponse)(nil),               // 32: hashicorp.enos.v1.DestroyScenariosResponse
-	(*RunScenariosRequest)(nil),                    // 33: hashicorp.enos.v1.RunScenariosRequest
-	(*RunScenariosResponse)(nil),                   // 34: hashicorp.enos.v1.RunScenariosResponse
-	(*ExecScenariosRequest)(nil),                   // 35: hashicorp.enos.v1.ExecScenariosRequest
-	(*ExecScenariosResponse)(nil),                  // 36: hashicorp.enos.v1.ExecScenariosResponse
-	(*OutputScenariosRequest)(nil),                 // 37: hashicorp.enos.v1.OutputScenariosRequest
-	(*OutputScenariosResponse)(nil),                // 38: hashicorp.enos.v1.OutputScenariosResponse
-	(*ListSamplesRequest)(nil),                     // 39: hashicorp.enos.v1.ListSamplesRequest
-	(*ListSamplesResponse)(nil),                    // 40: hashicorp.enos.v1.ListSamplesResponse
-	(*ObserveSampleRequest)(nil),                   // 41: hashicorp.enos.v1.ObserveSampleRequest
-	(*ObserveSampleResponse)(nil),                  // 42: hashicorp.enos.v1.ObserveSampleResponse
-	(*FormatRequest)(nil),                          // 43: hashicorp.enos.v1.FormatRequest
-	(*FormatResponse)(nil),                         // 44: hashicorp.enos.v1.FormatResponse
-	(*OperationEventStreamRequest)(nil),            // 45: hashicorp.enos.v1.OperationEventStreamRequest
-	(*OperationEventStreamResponse)(nil),           // 46: hashicorp.enos.v1.OperationEventStreamResponse
-	(*OperationRequest)(nil),                       // 47: hashicorp.enos.v1.OperationRequest
-	(*OperationResponse)(nil),                      // 48: hashicorp.enos.v1.OperationResponse
-	(*OperationResponses)(nil),                     // 49: hashicorp.enos.v1.OperationResponses
-	(*OutlineScenariosRequest)(nil),                // 50: hashicorp.enos.v1.OutlineScenariosRequest
-	(*OutlineScenariosResponse)(nil),               // 51: hashicorp.enos.v1.OutlineScenariosResponse
-	(*Quality)(nil),                                // 52: hashicorp.enos.v1.Quality
-	(*UI_Settings)(nil),                            // 53: hashicorp.enos.v1.UI.Settings
-	(*Diagnostic_Snippet)(nil),                     // 54: hashicorp.enos.v1.Diagnostic.Snippet
-	(*Diagnostic_ExpressionValue)(nil),             // 55: hashicorp.enos.v1.Diagnostic.ExpressionValue
-	(*Range_Pos)(nil),                              // 56: hashicorp.enos.v1.Range.Pos
-	nil,                                            // 57: hashicorp.enos.v1.FlightPlan.EnosHclEntry
-	nil,                                            // 58: hashicorp.enos.v1.FlightPlan.EnosVarsHclEntry
-	(*Scenario_ID)(nil),                            // 59: hashicorp.enos.v1.Scenario.ID
-	(*Scenario_Filter)(nil),                        // 60: hashicorp.enos.v1.Scenario.Filter
-	(*Scenario_Outline)(nil),                       // 61: hashicorp.enos.v1.Scenario.Outline
-	(*Scenario_Filter_SelectAll)(nil),              // 62: hashicorp.enos.v1.Scenario.Filter.SelectAll
-	(*Scenario_Outline_Step)(nil),                  // 63: hashicorp.enos.v1.Scenario.Outline.Step
-	(*Operator_Config)(nil),                        // 64: hashicorp.enos.v1.Operator.Config
-	(*Operation_Request)(nil),                      // 65: hashicorp.enos.v1.Operation.Request
-	(*Operation_Response)(nil),                     // 66: hashicorp.enos.v1.Operation.Response
-	(*Operation_Event)(nil),                        // 67: hashicorp.enos.v1.Operation.Event
-	(*Operation_Request_Generate)(nil),             // 68: hashicorp.enos.v1.Operation.Request.Generate
-	(*Operation_Request_Check)(nil),                // 69: hashicorp.enos.v1.Operation.Request.Check
-	(*Operation_Request_Launch)(nil),               // 70: hashicorp.enos.v1.Operation.Request.Launch
-	(*Operation_Request_Destroy)(nil),              // 71: hashicorp.enos.v1.Operation.Request.Destroy
-	(*Operation_Request_Run)(nil),                  // 72: hashicorp.enos.v1.Operation.Request.Run
-	(*Operation_Request_Exec)(nil),                 // 73: hashicorp.enos.v1.Operation.Request.Exec
-	(*Operation_Request_Output)(nil),               // 74: hashicorp.enos.v1.Operation.Request.Output
-	(*Operation_Response_Generate)(nil),            // 75: hashicorp.enos.v1.Operation.Response.Generate
-	(*Operation_Response_Check)(nil),               // 76: hashicorp.enos.v1.Operation.Response.Check
-	(*Operation_Response_Launch)(nil),              // 77: hashicorp.enos.v1.Operation.Response.Launch
-	(*Operation_Response_Destroy)(nil),             // 78: hashicorp.enos.v1.Operation.Response.Destroy
-	(*Operation_Response_Run)(nil),                 // 79: hashicorp.enos.v1.Operation.Response.Run
-	(*Operation_Response_Exec)(nil),                // 80: hashicorp.enos.v1.Operation.Response.Exec
-	(*Operation_Response_Output)(nil),              // 81: hashicorp.enos.v1.Operation.Response.Output
-	(*Terraform_Module)(nil),                       // 82: hashicorp.enos.v1.Terraform.Module
-	(*Terraform_Command)(nil),                      // 83: hashicorp.enos.v1.Terraform.Command
-	(*Terraform_Runner)(nil),                       // 84: hashicorp.enos.v1.Terraform.Runner
-	(*Terraform_Command_Init)(nil),                 // 85: hashicorp.enos.v1.Terraform.Command.Init
-	(*Terraform_Command_Validate)(nil),             // 86: hashicorp.enos.v1.Terraform.Command.Validate
-	(*Terraform_Command_Plan)(nil),                 // 87: hashicorp.enos.v1.Terraform.Command.Plan
-	(*Terraform_Command_Apply)(nil),                // 88: hashicorp.enos.v1.Terraform.Command.Apply
-	(*Terraform_Command_Destroy)(nil),              // 89: hashicorp.enos.v1.Terraform.Command.Destroy
-	(*Terraform_Command_Exec)(nil),                 // 90: hashicorp.enos.v1.Terraform.Command.Exec
-	(*Terraform_Command_Output)(nil),               // 91: hashicorp.enos.v1.Terraform.Command.Output
-	(*Terraform_Command_Show)(nil),                 // 92: hashicorp.enos.v1.Terraform.Command.Show
-	(*Terraform_Command_Init_Response)(nil),        // 93: hashicorp.enos.v1.Terraform.Command.Init.Response
-	(*Terraform_Command_Validate_Response)(nil),    // 94: hashicorp.enos.v1.Terraform.Command.Validate.Response
-	(*Terraform_Command_Plan_Response)(nil),        // 95: hashicorp.enos.v1.Terraform.Command.Plan.Response
-	(*Terraform_Command_Apply_Response)(nil),       // 96: hashicorp.enos.v1.Terraform.Command.Apply.Response
-	(*Terraform_Command_Destroy_Response)(nil),     // 97: hashicorp.enos.v1.Terraform.Command.Destroy.Response
-	(*Terraform_Command_Exec_Response)(nil),        // 98: hashicorp.enos.v1.Terraform.Command.Exec.Response
-	(*Terraform_Command_Output_Response)(nil),      // 99: hashicorp.enos.v1.Terraform.Command.Output.Response
-	(*Terraform_Command_Output_Response_Meta)(nil), // 100: hashicorp.enos.v1.Terraform.Command.Output.Response.Meta
-	(*Terraform_Command_Show_Response)(nil),        // 101: hashicorp.enos.v1.Terraform.Command.Show.Response
-	(*Terraform_Runner_Config)(nil),                // 102: hashicorp.enos.v1.Terraform.Runner.Config
-	nil,                                            // 103: hashicorp.enos.v1.Terraform.Runner.Config.EnvEntry
-	(*Terraform_Runner_Config_Flags)(nil),          // 104: hashicorp.enos.v1.Terraform.Runner.Config.Flags
-	(*Matrix_Vector)(nil),                          // 105: hashicorp.enos.v1.Matrix.Vector
-	(*Matrix_Element)(nil),                         // 106: hashicorp.enos.v1.Matrix.Element
-	(*Matrix_Exclude)(nil),                         // 107: hashicorp.enos.v1.Matrix.Exclude
-	(*Sample_ID)(nil),                              // 108: hashicorp.enos.v1.Sample.ID
-	(*Sample_Subset)(nil),                          // 109: hashicorp.enos.v1.Sample.Subset
-	(*Sample_Filter)(nil),                          // 110: hashicorp.enos.v1.Sample.Filter
-	(*Sample_Element)(nil),                         // 111: hashicorp.enos.v1.Sample.Element
-	(*Sample_Observation)(nil),                     // 112: hashicorp.enos.v1.Sample.Observation
-	(*Sample_Attribute)(nil),                       // 113: hashicorp.enos.v1.Sample.Attribute
-	(*Sample_Subset_ID)(nil),                       // 114: hashicorp.enos.v1.Sample.Subset.ID
-	(*Ref_Scenario)(nil),                           // 115: hashicorp.enos.v1.Ref.Scenario
-	(*Ref_Operation)(nil),                          // 116: hashicorp.enos.v1.Ref.Operation
-	(*Ref_Sample)(nil),                             // 117: hashicorp.enos.v1.Ref.Sample
-	(*Ref_Sample_Subset)(nil),                      // 118: hashicorp.enos.v1.Ref.Sample.Subset
-	(*FormatRequest_File)(nil),                     // 119: hashicorp.enos.v1.FormatRequest.File
-	(*FormatRequest_Config)(nil),                   // 120: hashicorp.enos.v1.FormatRequest.Config
-	(*FormatResponse_Response)(nil),                // 121: hashicorp.enos.v1.FormatResponse.Response
-	(*timestamppb.Timestamp)(nil),                  // 122: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),                    // 123: google.protobuf.Duration
-	(*structpb.Struct)(nil),                        // 124: google.protobuf.Struct
+	(UI_Settings_Format)(0),                        // 0: hashicorp.enos.v1.UI.Settings.Format
+	(UI_Settings_Level)(0),                         // 1: hashicorp.enos.v1.UI.Settings.Level
+	(Diagnostic_Severity)(0),                       // 2: hashicorp.enos.v1.Diagnostic.Severity
+	(Operation_Status)(0),                          // 3: hashicorp.enos.v1.Operation.Status
+	(Operation_FailurePolicy_Type)(0),              // 4: hashicorp.enos.v1.Operation.FailurePolicy.Type
+	(Operation_Response_FailurePolicy_Outcome)(0),  // 5: hashicorp.enos.v1.Operation.Response.FailurePolicy.Outcome
+	(Terraform_Command_Progress_Type)(0),           // 6: hashicorp.enos.v1.Terraform.Command.Progress.Type
+	(Matrix_Exclude_Mode)(0),                       // 7: hashicorp.enos.v1.Matrix.Exclude.Mode
+	(Variable_Source)(0),                           // 8: hashicorp.enos.v1.Variable.Source
+	(CleanScenariosResponse_Module_State)(0),       // 9: hashicorp.enos.v1.CleanScenariosResponse.Module.State
+	(*UI)(nil),                                     // 10: hashicorp.enos.v1.UI
+	(*Diagnostic)(nil),                             // 11: hashicorp.enos.v1.Diagnostic
+	(*DiagnosticCode)(nil),                         // 12: hashicorp.enos.v1.DiagnosticCode
+	(*Range)(nil),                                  // 13: hashicorp.enos.v1.Range
+	(*Workspace)(nil),                              // 14: hashicorp.enos.v1.Workspace
+	(*FlightPlan)(nil),                             // 15: hashicorp.enos.v1.FlightPlan
+	(*DecodeResponse)(nil),                         // 16: hashicorp.enos.v1.DecodeResponse
+	(*Scenario)(nil),                               // 17: hashicorp.enos.v1.Scenario
+	(*Operator)(nil),                               // 18: hashicorp.enos.v1.Operator
+	(*Operation)(nil),                              // 19: hashicorp.enos.v1.Operation
+	(*Terraform)(nil),                              // 20: hashicorp.enos.v1.Terraform
+	(*Matrix)(nil),                                 // 21: hashicorp.enos.v1.Matrix
+	(*Sample)(nil),                                 // 22: hashicorp.enos.v1.Sample
+	(*Ref)(nil),                                    // 23: hashicorp.enos.v1.Ref
+	(*LintScenariosRequest)(nil),                   // 24: hashicorp.enos.v1.LintScenariosRequest
+	(*LintScenariosResponse)(nil),                  // 25: hashicorp.enos.v1.LintScenariosResponse
+	(*Lint)(nil),                                   // 26: hashicorp.enos.v1.Lint
+	(*ListScenarioVariablesRequest)(nil),           // 27: hashicorp.enos.v1.ListScenarioVariablesRequest
+	(*ListScenarioVariablesResponse)(nil),          // 28: hashicorp.enos.v1.ListScenarioVariablesResponse
+	(*Variable)(nil),                               // 29: hashicorp.enos.v1.Variable
+	(*ExplainDiagnosticCodeRequest)(nil),           // 30: hashicorp.enos.v1.ExplainDiagnosticCodeRequest
+	(*ExplainDiagnosticCodeResponse)(nil),          // 31: hashicorp.enos.v1.ExplainDiagnosticCodeResponse
+	(*GetVersionRequest)(nil),                      // 32: hashicorp.enos.v1.GetVersionRequest
+	(*GetVersionResponse)(nil),                     // 33: hashicorp.enos.v1.GetVersionResponse
+	(*ValidateScenariosConfigurationRequest)(nil),  // 34: hashicorp.enos.v1.ValidateScenariosConfigurationRequest
+	(*ValidateScenariosConfigurationResponse)(nil), // 35: hashicorp.enos.v1.ValidateScenariosConfigurationResponse
+	(*ListScenariosRequest)(nil),                   // 36: hashicorp.enos.v1.ListScenariosRequest
+	(*ListScenariosResponse)(nil),                  // 37: hashicorp.enos.v1.ListScenariosResponse
+	(*EnosServiceListScenariosResponse)(nil),       // 38: hashicorp.enos.v1.EnosServiceListScenariosResponse
+	(*GenerateScenariosRequest)(nil),               // 39: hashicorp.enos.v1.GenerateScenariosRequest
+	(*GenerateScenariosResponse)(nil),              // 40: hashicorp.enos.v1.GenerateScenariosResponse
+	(*CheckScenariosRequest)(nil),                  // 41: hashicorp.enos.v1.CheckScenariosRequest
+	(*CheckScenariosResponse)(nil),                 // 42: hashicorp.enos.v1.CheckScenariosResponse
+	(*LaunchScenariosRequest)(nil),                 // 43: hashicorp.enos.v1.LaunchScenariosRequest
+	(*LaunchScenariosResponse)(nil),                // 44: hashicorp.enos.v1.LaunchScenariosResponse
+	(*DestroyScenariosRequest)(nil),                // 45: hashicorp.enos.v1.DestroyScenariosRequest
+	(*DestroyScenariosResponse)(nil),               // 46: hashicorp.enos.v1.DestroyScenariosResponse
+	(*ReapScenariosRequest)(nil),                   // 47: hashicorp.enos.v1.ReapScenariosRequest
+	(*ReapScenariosResponse)(nil),                  // 48: hashicorp.enos.v1.ReapScenariosResponse
+	(*RunScenariosRequest)(nil),                    // 49: hashicorp.enos.v1.RunScenariosRequest
+	(*RunScenariosResponse)(nil),                   // 50: hashicorp.enos.v1.RunScenariosResponse
+	(*ExecScenariosRequest)(nil),                   // 51: hashicorp.enos.v1.ExecScenariosRequest
+	(*ExecScenariosResponse)(nil),                  // 52: hashicorp.enos.v1.ExecScenariosResponse
+	(*ExecScenarioStreamRequest)(nil),              // 53: hashicorp.enos.v1.ExecScenarioStreamRequest
+	(*ExecScenarioStreamResponse)(nil),             // 54: hashicorp.enos.v1.ExecScenarioStreamResponse
+	(*OutputScenariosRequest)(nil),                 // 55: hashicorp.enos.v1.OutputScenariosRequest
+	(*OutputScenariosResponse)(nil),                // 56: hashicorp.enos.v1.OutputScenariosResponse
+	(*StatusScenariosRequest)(nil),                 // 57: hashicorp.enos.v1.StatusScenariosRequest
+	(*StatusScenariosResponse)(nil),                // 58: hashicorp.enos.v1.StatusScenariosResponse
+	(*ListSamplesRequest)(nil),                     // 59: hashicorp.enos.v1.ListSamplesRequest
+	(*ListSamplesResponse)(nil),                    // 60: hashicorp.enos.v1.ListSamplesResponse
+	(*ObserveSampleRequest)(nil),                   // 61: hashicorp.enos.v1.ObserveSampleRequest
+	(*ObserveSampleResponse)(nil),                  // 62: hashicorp.enos.v1.ObserveSampleResponse
+	(*FormatRequest)(nil),                          // 63: hashicorp.enos.v1.FormatRequest
+	(*FormatResponse)(nil),                         // 64: hashicorp.enos.v1.FormatResponse
+	(*OperationEventStreamRequest)(nil),            // 65: hashicorp.enos.v1.OperationEventStreamRequest
+	(*OperationEventStreamResponse)(nil),           // 66: hashicorp.enos.v1.OperationEventStreamResponse
+	(*OperationRequest)(nil),                       // 67: hashicorp.enos.v1.OperationRequest
+	(*OperationResponse)(nil),                      // 68: hashicorp.enos.v1.OperationResponse
+	(*OperationResponses)(nil),                     // 69: hashicorp.enos.v1.OperationResponses
+	(*OperationRecord)(nil),                        // 70: hashicorp.enos.v1.OperationRecord
+	(*OutlineScenariosRequest)(nil),                // 71: hashicorp.enos.v1.OutlineScenariosRequest
+	(*OutlineScenariosResponse)(nil),               // 72: hashicorp.enos.v1.OutlineScenariosResponse
+	(*CleanScenariosRequest)(nil),                  // 73: hashicorp.enos.v1.CleanScenariosRequest
+	(*CleanScenariosResponse)(nil),                 // 74: hashicorp.enos.v1.CleanScenariosResponse
+	(*Quality)(nil),                                // 75: hashicorp.enos.v1.Quality
+	(*UI_Settings)(nil),                            // 76: hashicorp.enos.v1.UI.Settings
+	(*Diagnostic_Snippet)(nil),                     // 77: hashicorp.enos.v1.Diagnostic.Snippet
+	(*Diagnostic_ExpressionValue)(nil),             // 78: hashicorp.enos.v1.Diagnostic.ExpressionValue
+	(*Range_Pos)(nil),                              // 79: hashicorp.enos.v1.Range.Pos
+	nil,                                            // 80: hashicorp.enos.v1.FlightPlan.EnosHclEntry
+	nil,                                            // 81: hashicorp.enos.v1.FlightPlan.EnosVarsHclEntry
+	(*Scenario_ID)(nil),                            // 82: hashicorp.enos.v1.Scenario.ID
+	(*Scenario_Filter)(nil),                        // 83: hashicorp.enos.v1.Scenario.Filter
+	(*Scenario_Outline)(nil),                       // 84: hashicorp.enos.v1.Scenario.Outline
+	(*Scenario_Filter_SelectAll)(nil),              // 85: hashicorp.enos.v1.Scenario.Filter.SelectAll
+	(*Scenario_Filter_Match)(nil),                  // 86: hashicorp.enos.v1.Scenario.Filter.Match
+	(*Scenario_Outline_Step)(nil),                  // 87: hashicorp.enos.v1.Scenario.Outline.Step
+	(*Operator_Config)(nil),                        // 88: hashicorp.enos.v1.Operator.Config
+	(*Operation_FailurePolicy)(nil),                // 89: hashicorp.enos.v1.Operation.FailurePolicy
+	(*Operation_Request)(nil),                      // 90: hashicorp.enos.v1.Operation.Request
+	(*Operation_Response)(nil),                     // 91: hashicorp.enos.v1.Operation.Response
+	(*Operation_Event)(nil),                        // 92: hashicorp.enos.v1.Operation.Event
+	(*Operation_Request_Generate)(nil),             // 93: hashicorp.enos.v1.Operation.Request.Generate
+	(*Operation_Request_Check)(nil),                // 94: hashicorp.enos.v1.Operation.Request.Check
+	(*Operation_Request_Launch)(nil),               // 95: hashicorp.enos.v1.Operation.Request.Launch
+	(*Operation_Request_Destroy)(nil),              // 96: hashicorp.enos.v1.Operation.Request.Destroy
+	(*Operation_Request_Run)(nil),                  // 97: hashicorp.enos.v1.Operation.Request.Run
+	(*Operation_Request_Exec)(nil),                 // 98: hashicorp.enos.v1.Operation.Request.Exec
+	(*Operation_Request_Output)(nil),               // 99: hashicorp.enos.v1.Operation.Request.Output
+	(*Operation_Request_State)(nil),                // 100: hashicorp.enos.v1.Operation.Request.State
+	(*Operation_Response_Generate)(nil),            // 101: hashicorp.enos.v1.Operation.Response.Generate
+	(*Operation_Response_Check)(nil),               // 102: hashicorp.enos.v1.Operation.Response.Check
+	(*Operation_Response_Launch)(nil),              // 103: hashicorp.enos.v1.Operation.Response.Launch
+	(*Operation_Response_Destroy)(nil),             // 104: hashicorp.enos.v1.Operation.Response.Destroy
+	(*Operation_Response_Run)(nil),                 // 105: hashicorp.enos.v1.Operation.Response.Run
+	(*Operation_Response_FailurePolicy)(nil),       // 106: hashicorp.enos.v1.Operation.Response.FailurePolicy
+	(*Operation_Response_Hook)(nil),                // 107: hashicorp.enos.v1.Operation.Response.Hook
+	(*Operation_Response_Exec)(nil),                // 108: hashicorp.enos.v1.Operation.Response.Exec
+	(*Operation_Response_Output)(nil),              // 109: hashicorp.enos.v1.Operation.Response.Output
+	(*Operation_Response_State)(nil),               // 110: hashicorp.enos.v1.Operation.Response.State
+	(*Operation_Response_State_Step)(nil),          // 111: hashicorp.enos.v1.Operation.Response.State.Step
+	(*Terraform_Module)(nil),                       // 112: hashicorp.enos.v1.Terraform.Module
+	(*Terraform_Command)(nil),                      // 113: hashicorp.enos.v1.Terraform.Command
+	(*Terraform_Runner)(nil),                       // 114: hashicorp.enos.v1.Terraform.Runner
+	(*Terraform_Command_Init)(nil),                 // 115: hashicorp.enos.v1.Terraform.Command.Init
+	(*Terraform_Command_Validate)(nil),             // 116: hashicorp.enos.v1.Terraform.Command.Validate
+	(*Terraform_Command_Plan)(nil),                 // 117: hashicorp.enos.v1.Terraform.Command.Plan
+	(*Terraform_Command_Apply)(nil),                // 118: hashicorp.enos.v1.Terraform.Command.Apply
+	(*Terraform_Command_Destroy)(nil),              // 119: hashicorp.enos.v1.Terraform.Command.Destroy
+	(*Terraform_Command_Progress)(nil),             // 120: hashicorp.enos.v1.Terraform.Command.Progress
+	(*Terraform_Command_Exec)(nil),                 // 121: hashicorp.enos.v1.Terraform.Command.Exec
+	(*Terraform_Command_Output)(nil),               // 122: hashicorp.enos.v1.Terraform.Command.Output
+	(*Terraform_Command_Show)(nil),                 // 123: hashicorp.enos.v1.Terraform.Command.Show
+	(*Terraform_Command_Init_Response)(nil),        // 124: hashicorp.enos.v1.Terraform.Command.Init.Response
+	(*Terraform_Command_Validate_Response)(nil),    // 125: hashicorp.enos.v1.Terraform.Command.Validate.Response
+	(*Terraform_Command_Plan_Response)(nil),        // 126: hashicorp.enos.v1.Terraform.Command.Plan.Response
+	(*Terraform_Command_Apply_Response)(nil),       // 127: hashicorp.enos.v1.Terraform.Command.Apply.Response
+	(*Terraform_Command_Destroy_Response)(nil),     // 128: hashicorp.enos.v1.Terraform.Command.Destroy.Response
+	(*Terraform_Command_Exec_Response)(nil),        // 129: hashicorp.enos.v1.Terraform.Command.Exec.Response
+	(*Terraform_Command_Output_Response)(nil),      // 130: hashicorp.enos.v1.Terraform.Command.Output.Response
+	(*Terraform_Command_Output_Response_Meta)(nil), // 131: hashicorp.enos.v1.Terraform.Command.Output.Response.Meta
+	(*Terraform_Command_Show_Response)(nil),        // 132: hashicorp.enos.v1.Terraform.Command.Show.Response
+	(*Terraform_Runner_Config)(nil),                // 133: hashicorp.enos.v1.Terraform.Runner.Config
+	nil,                                            // 134: hashicorp.enos.v1.Terraform.Runner.Config.EnvEntry
+	(*Terraform_Runner_Config_Flags)(nil),          // 135: hashicorp.enos.v1.Terraform.Runner.Config.Flags
+	(*Matrix_Vector)(nil),                          // 136: hashicorp.enos.v1.Matrix.Vector
+	(*Matrix_Element)(nil),                         // 137: hashicorp.enos.v1.Matrix.Element
+	(*Matrix_Exclude)(nil),                         // 138: hashicorp.enos.v1.Matrix.Exclude
+	(*Sample_ID)(nil),                              // 139: hashicorp.enos.v1.Sample.ID
+	(*Sample_Subset)(nil),                          // 140: hashicorp.enos.v1.Sample.Subset
+	(*Sample_Filter)(nil),                          // 141: hashicorp.enos.v1.Sample.Filter
+	(*Sample_Element)(nil),                         // 142: hashicorp.enos.v1.Sample.Element
+	(*Sample_Observation)(nil),                     // 143: hashicorp.enos.v1.Sample.Observation
+	(*Sample_Attribute)(nil),                       // 144: hashicorp.enos.v1.Sample.Attribute
+	(*Sample_Subset_ID)(nil),                       // 145: hashicorp.enos.v1.Sample.Subset.ID
+	(*Ref_Scenario)(nil),                           // 146: hashicorp.enos.v1.Ref.Scenario
+	(*Ref_Operation)(nil),                          // 147: hashicorp.enos.v1.Ref.Operation
+	(*Ref_Sample)(nil),                             // 148: hashicorp.enos.v1.Ref.Sample
+	(*Ref_Sample_Subset)(nil),                      // 149: hashicorp.enos.v1.Ref.Sample.Subset
+	(*Lint_Config)(nil),                            // 150: hashicorp.enos.v1.Lint.Config
+	(*ExecScenarioStreamRequest_Start)(nil),        // 151: hashicorp.enos.v1.ExecScenarioStreamRequest.Start
+	(*ExecScenarioStreamRequest_Stdin)(nil),        // 152: hashicorp.enos.v1.ExecScenarioStreamRequest.Stdin
+	(*ExecScenarioStreamRequest_TerminalSize)(nil), // 153: hashicorp.enos.v1.ExecScenarioStreamRequest.TerminalSize
+	(*ExecScenarioStreamResponse_Exit)(nil),        // 154: hashicorp.enos.v1.ExecScenarioStreamResponse.Exit
+	(*FormatRequest_File)(nil),                     // 155: hashicorp.enos.v1.FormatRequest.File
+	(*FormatRequest_Config)(nil),                   // 156: hashicorp.enos.v1.FormatRequest.Config
+	(*FormatResponse_Response)(nil),                // 157: hashicorp.enos.v1.FormatResponse.Response
+	(*CleanScenariosResponse_Module)(nil),          // 158: hashicorp.enos.v1.CleanScenariosResponse.Module
+	(*durationpb.Duration)(nil),                    // 159: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                  // 160: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                        // 161: google.protobuf.Struct
 }
 var file_hashicorp_enos_v1_enos_proto_depIdxs = []int32{
 	2,   // 0: hashicorp.enos.v1.Diagnostic.severity:type_name -> hashicorp.enos.v1.Diagnostic.Severity
-	7,   // 1: hashicorp.enos.v1.Diagnostic.range:type_name -> hashicorp.enos.v1.Range
-	54,  // 2: hashicorp.enos.v1.Diagnostic.snippet:type_name -> hashicorp.enos.v1.Diagnostic.Snippet
-	56,  // 3: hashicorp.enos.v1.Range.start:type_name -> hashicorp.enos.v1.Range.Pos
-	56,  // 4: hashicorp.enos.v1.Range.end:type_name -> hashicorp.enos.v1.Range.Pos
-	9,   // 5: hashicorp.enos.v1.Workspace.flightplan:type_name -> hashicorp.enos.v1.FlightPlan
-	102, // 6: hashicorp.enos.v1.Workspace.tf_exec_cfg:type_name -> hashicorp.enos.v1.Terraform.Runner.Config
-	57,  // 7: hashicorp.enos.v1.FlightPlan.enos_hcl:type_name -> hashicorp.enos.v1.FlightPlan.EnosHclEntry
-	58,  // 8: hashicorp.enos.v1.FlightPlan.enos_vars_hcl:type_name -> hashicorp.enos.v1.FlightPlan.EnosVarsHclEntry
-	6,   // 9: hashicorp.enos.v1.DecodeResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	9,   // 10: hashicorp.enos.v1.DecodeResponse.flightplan:type_name -> hashicorp.enos.v1.FlightPlan
-	64,  // 11: hashicorp.enos.v1.Operator.config:type_name -> hashicorp.enos.v1.Operator.Config
-	105, // 12: hashicorp.enos.v1.Matrix.vectors:type_name -> hashicorp.enos.v1.Matrix.Vector
-	105, // 13: hashicorp.enos.v1.Matrix.include:type_name -> hashicorp.enos.v1.Matrix.Vector
-	107, // 14: hashicorp.enos.v1.Matrix.exclude:type_name -> hashicorp.enos.v1.Matrix.Exclude
-	108, // 15: hashicorp.enos.v1.Sample.id:type_name -> hashicorp.enos.v1.Sample.ID
-	113, // 16: hashicorp.enos.v1.Sample.attributes:type_name -> hashicorp.enos.v1.Sample.Attribute
-	109, // 17: hashicorp.enos.v1.Sample.subsets:type_name -> hashicorp.enos.v1.Sample.Subset
-	6,   // 18: hashicorp.enos.v1.GetVersionResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	8,   // 19: hashicorp.enos.v1.ValidateScenariosConfigurationRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
-	60,  // 20: hashicorp.enos.v1.ValidateScenariosConfigurationRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
-	110, // 21: hashicorp.enos.v1.ValidateScenariosConfigurationRequest.sample_filter:type_name -> hashicorp.enos.v1.Sample.Filter
-	6,   // 22: hashicorp.enos.v1.ValidateScenariosConfigurationResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 23: hashicorp.enos.v1.ValidateScenariosConfigurationResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	10,  // 24: hashicorp.enos.v1.ValidateScenariosConfigurationResponse.sample_decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	8,   // 25: hashicorp.enos.v1.ListScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
-	60,  // 26: hashicorp.enos.v1.ListScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
-	6,   // 27: hashicorp.enos.v1.ListScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 28: hashicorp.enos.v1.ListScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	115, // 29: hashicorp.enos.v1.ListScenariosResponse.scenarios:type_name -> hashicorp.enos.v1.Ref.Scenario
-	115, // 30: hashicorp.enos.v1.EnosServiceListScenariosResponse.scenario:type_name -> hashicorp.enos.v1.Ref.Scenario
-	10,  // 31: hashicorp.enos.v1.EnosServiceListScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	8,   // 32: hashicorp.enos.v1.GenerateScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
-	60,  // 33: hashicorp.enos.v1.GenerateScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
-	6,   // 34: hashicorp.enos.v1.GenerateScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 35: hashicorp.enos.v1.GenerateScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	116, // 36: hashicorp.enos.v1.GenerateScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
-	8,   // 37: hashicorp.enos.v1.CheckScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
-	60,  // 38: hashicorp.enos.v1.CheckScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
-	6,   // 39: hashicorp.enos.v1.CheckScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 40: hashicorp.enos.v1.CheckScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	116, // 41: hashicorp.enos.v1.CheckScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
-	8,   // 42: hashicorp.enos.v1.LaunchScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
-	60,  // 43: hashicorp.enos.v1.LaunchScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
-	6,   // 44: hashicorp.enos.v1.LaunchScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 45: hashicorp.enos.v1.LaunchScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	116, // 46: hashicorp.enos.v1.LaunchScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
-	8,   // 47: hashicorp.enos.v1.DestroyScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
-	60,  // 48: hashicorp.enos.v1.DestroyScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
-	6,   // 49: hashicorp.enos.v1.DestroyScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 50: hashicorp.enos.v1.DestroyScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	116, // 51: hashicorp.enos.v1.DestroyScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
-	8,   // 52: hashicorp.enos.v1.RunScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
-	60,  // 53: hashicorp.enos.v1.RunScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
-	6,   // 54: hashicorp.enos.v1.RunScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 55: hashicorp.enos.v1.RunScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	116, // 56: hashicorp.enos.v1.RunScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
-	8,   // 57: hashicorp.enos.v1.ExecScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
-	60,  // 58: hashicorp.enos.v1.ExecScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
-	6,   // 59: hashicorp.enos.v1.ExecScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 60: hashicorp.enos.v1.ExecScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	116, // 61: hashicorp.enos.v1.ExecScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
-	8,   // 62: hashicorp.enos.v1.OutputScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
-	60,  // 63: hashicorp.enos.v1.OutputScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
-	6,   // 64: hashicorp.enos.v1.OutputScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 65: hashicorp.enos.v1.OutputScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	116, // 66: hashicorp.enos.v1.OutputScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
-	8,   // 67: hashicorp.enos.v1.ListSamplesRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
-	6,   // 68: hashicorp.enos.v1.ListSamplesResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 69: hashicorp.enos.v1.ListSamplesResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	117, // 70: hashicorp.enos.v1.ListSamplesResponse.samples:type_name -> hashicorp.enos.v1.Ref.Sample
-	8,   // 71: hashicorp.enos.v1.ObserveSampleRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
-	110, // 72: hashicorp.enos.v1.ObserveSampleRequest.filter:type_name -> hashicorp.enos.v1.Sample.Filter
-	6,   // 73: hashicorp.enos.v1.ObserveSampleResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 74: hashicorp.enos.v1.ObserveSampleResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	112, // 75: hashicorp.enos.v1.ObserveSampleResponse.observation:type_name -> hashicorp.enos.v1.Sample.Observation
-	119, // 76: hashicorp.enos.v1.FormatRequest.files:type_name -> hashicorp.enos.v1.FormatRequest.File
-	120, // 77: hashicorp.enos.v1.FormatRequest.config:type_name -> hashicorp.enos.v1.FormatRequest.Config
-	6,   // 78: hashicorp.enos.v1.FormatResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	121, // 79: hashicorp.enos.v1.FormatResponse.responses:type_name -> hashicorp.enos.v1.FormatResponse.Response
-	116, // 80: hashicorp.enos.v1.OperationEventStreamRequest.op:type_name -> hashicorp.enos.v1.Ref.Operation
-	6,   // 81: hashicorp.enos.v1.OperationEventStreamResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	67,  // 82: hashicorp.enos.v1.OperationEventStreamResponse.event:type_name -> hashicorp.enos.v1.Operation.Event
-	116, // 83: hashicorp.enos.v1.OperationRequest.op:type_name -> hashicorp.enos.v1.Ref.Operation
-	6,   // 84: hashicorp.enos.v1.OperationResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	66,  // 85: hashicorp.enos.v1.OperationResponse.response:type_name -> hashicorp.enos.v1.Operation.Response
-	6,   // 86: hashicorp.enos.v1.OperationResponses.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 87: hashicorp.enos.v1.OperationResponses.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	66,  // 88: hashicorp.enos.v1.OperationResponses.responses:type_name -> hashicorp.enos.v1.Operation.Response
-	8,   // 89: hashicorp.enos.v1.OutlineScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
-	60,  // 90: hashicorp.enos.v1.OutlineScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
-	6,   // 91: hashicorp.enos.v1.OutlineScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	10,  // 92: hashicorp.enos.v1.OutlineScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	61,  // 93: hashicorp.enos.v1.OutlineScenariosResponse.outlines:type_name -> hashicorp.enos.v1.Scenario.Outline
-	52,  // 94: hashicorp.enos.v1.OutlineScenariosResponse.verifies:type_name -> hashicorp.enos.v1.Quality
-	0,   // 95: hashicorp.enos.v1.UI.Settings.format:type_name -> hashicorp.enos.v1.UI.Settings.Format
-	1,   // 96: hashicorp.enos.v1.UI.Settings.level:type_name -> hashicorp.enos.v1.UI.Settings.Level
-	55,  // 97: hashicorp.enos.v1.Diagnostic.Snippet.values:type_name -> hashicorp.enos.v1.Diagnostic.ExpressionValue
-	105, // 98: hashicorp.enos.v1.Scenario.ID.variants:type_name -> hashicorp.enos.v1.Matrix.Vector
-	62,  // 99: hashicorp.enos.v1.Scenario.Filter.select_all:type_name -> hashicorp.enos.v1.Scenario.Filter.SelectAll
-	105, // 100: hashicorp.enos.v1.Scenario.Filter.include:type_name -> hashicorp.enos.v1.Matrix.Vector
-	107, // 101: hashicorp.enos.v1.Scenario.Filter.exclude:type_name -> hashicorp.enos.v1.Matrix.Exclude
-	15,  // 102: hashicorp.enos.v1.Scenario.Filter.intersection_matrix:type_name -> hashicorp.enos.v1.Matrix
-	115, // 103: hashicorp.enos.v1.Scenario.Outline.scenario:type_name -> hashicorp.enos.v1.Ref.Scenario
-	15,  // 104: hashicorp.enos.v1.Scenario.Outline.matrix:type_name -> hashicorp.enos.v1.Matrix
-	63,  // 105: hashicorp.enos.v1.Scenario.Outline.steps:type_name -> hashicorp.enos.v1.Scenario.Outline.Step
-	52,  // 106: hashicorp.enos.v1.Scenario.Outline.verifies:type_name -> hashicorp.enos.v1.Quality
-	52,  // 107: hashicorp.enos.v1.Scenario.Outline.Step.verifies:type_name -> hashicorp.enos.v1.Quality
-	115, // 108: hashicorp.enos.v1.Operation.Request.scenario:type_name -> hashicorp.enos.v1.Ref.Scenario
-	8,   // 109: hashicorp.enos.v1.Operation.Request.workspace:type_name -> hashicorp.enos.v1.Workspace
-	68,  // 110: hashicorp.enos.v1.Operation.Request.generate:type_name -> hashicorp.enos.v1.Operation.Request.Generate
-	69,  // 111: hashicorp.enos.v1.Operation.Request.check:type_name -> hashicorp.enos.v1.Operation.Request.Check
-	70,  // 112: hashicorp.enos.v1.Operation.Request.launch:type_name -> hashicorp.enos.v1.Operation.Request.Launch
-	71,  // 113: hashicorp.enos.v1.Operation.Request.destroy:type_name -> hashicorp.enos.v1.Operation.Request.Destroy
-	72,  // 114: hashicorp.enos.v1.Operation.Request.run:type_name -> hashicorp.enos.v1.Operation.Request.Run
-	73,  // 115: hashicorp.enos.v1.Operation.Request.exec:type_name -> hashicorp.enos.v1.Operation.Request.Exec
-	74,  // 116: hashicorp.enos.v1.Operation.Request.output:type_name -> hashicorp.enos.v1.Operation.Request.Output
-	6,   // 117: hashicorp.enos.v1.Operation.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	116, // 118: hashicorp.enos.v1.Operation.Response.op:type_name -> hashicorp.enos.v1.Ref.Operation
-	3,   // 119: hashicorp.enos.v1.Operation.Response.status:type_name -> hashicorp.enos.v1.Operation.Status
-	75,  // 120: hashicorp.enos.v1.Operation.Response.generate:type_name -> hashicorp.enos.v1.Operation.Response.Generate
-	76,  // 121: hashicorp.enos.v1.Operation.Response.check:type_name -> hashicorp.enos.v1.Operation.Response.Check
-	77,  // 122: hashicorp.enos.v1.Operation.Response.launch:type_name -> hashicorp.enos.v1.Operation.Response.Launch
-	78,  // 123: hashicorp.enos.v1.Operation.Response.destroy:type_name -> hashicorp.enos.v1.Operation.Response.Destroy
-	79,  // 124: hashicorp.enos.v1.Operation.Response.run:type_name -> hashicorp.enos.v1.Operation.Response.Run
-	80,  // 125: hashicorp.enos.v1.Operation.Response.exec:type_name -> hashicorp.enos.v1.Operation.Response.Exec
-	81,  // 126: hashicorp.enos.v1.Operation.Response.output:type_name -> hashicorp.enos.v1.Operation.Response.Output
-	6,   // 127: hashicorp.enos.v1.Operation.Event.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	116, // 128: hashicorp.enos.v1.Operation.Event.op:type_name -> hashicorp.enos.v1.Ref.Operation
-	3,   // 129: hashicorp.enos.v1.Operation.Event.status:type_name -> hashicorp.enos.v1.Operation.Status
-	122, // 130: hashicorp.enos.v1.Operation.Event.published_at:type_name -> google.protobuf.Timestamp
-	10,  // 131: hashicorp.enos.v1.Operation.Event.decode:type_name -> hashicorp.enos.v1.DecodeResponse
-	75,  // 132: hashicorp.enos.v1.Operation.Event.generate:type_name -> hashicorp.enos.v1.Operation.Response.Generate
-	93,  // 133: hashicorp.enos.v1.Operation.Event.init:type_name -> hashicorp.enos.v1.Terraform.Command.Init.Response
-	94,  // 134: hashicorp.enos.v1.Operation.Event.validate:type_name -> hashicorp.enos.v1.Terraform.Command.Validate.Response
-	95,  // 135: hashicorp.enos.v1.Operation.Event.plan:type_name -> hashicorp.enos.v1.Terraform.Command.Plan.Response
-	96,  // 136: hashicorp.enos.v1.Operation.Event.apply:type_name -> hashicorp.enos.v1.Terraform.Command.Apply.Response
-	97,  // 137: hashicorp.enos.v1.Operation.Event.destroy:type_name -> hashicorp.enos.v1.Terraform.Command.Destroy.Response
-	98,  // 138: hashicorp.enos.v1.Operation.Event.exec:type_name -> hashicorp.enos.v1.Terraform.Command.Exec.Response
-	99,  // 139: hashicorp.enos.v1.Operation.Event.output:type_name -> hashicorp.enos.v1.Terraform.Command.Output.Response
-	101, // 140: hashicorp.enos.v1.Operation.Event.show:type_name -> hashicorp.enos.v1.Terraform.Command.Show.Response
-	6,   // 141: hashicorp.enos.v1.Operation.Response.Generate.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	82,  // 142: hashicorp.enos.v1.Operation.Response.Generate.terraform_module:type_name -> hashicorp.enos.v1.Terraform.Module
-	6,   // 143: hashicorp.enos.v1.Operation.Response.Check.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	75,  // 144: hashicorp.enos.v1.Operation.Response.Check.generate:type_name -> hashicorp.enos.v1.Operation.Response.Generate
-	93,  // 145: hashicorp.enos.v1.Operation.Response.Check.init:type_name -> hashicorp.enos.v1.Terraform.Command.Init.Response
-	94,  // 146: hashicorp.enos.v1.Operation.Response.Check.validate:type_name -> hashicorp.enos.v1.Terraform.Command.Validate.Response
-	95,  // 147: hashicorp.enos.v1.Operation.Response.Check.plan:type_name -> hashicorp.enos.v1.Terraform.Command.Plan.Response
-	6,   // 148: hashicorp.enos.v1.Operation.Response.Launch.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	75,  // 149: hashicorp.enos.v1.Operation.Response.Launch.generate:type_name -> hashicorp.enos.v1.Operation.Response.Generate
-	93,  // 150: hashicorp.enos.v1.Operation.Response.Launch.init:type_name -> hashicorp.enos.v1.Terraform.Command.Init.Response
-	94,  // 151: hashicorp.enos.v1.Operation.Response.Launch.validate:type_name -> hashicorp.enos.v1.Terraform.Command.Validate.Response
-	95,  // 152: hashicorp.enos.v1.Operation.Response.Launch.plan:type_name -> hashicorp.enos.v1.Terraform.Command.Plan.Response
-	96,  // 153: hashicorp.enos.v1.Operation.Response.Launch.apply:type_name -> hashicorp.enos.v1.Terraform.Command.Apply.Response
-	6,   // 154: hashicorp.enos.v1.Operation.Response.Destroy.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	101, // 155: hashicorp.enos.v1.Operation.Response.Destroy.prior_state_show:type_name -> hashicorp.enos.v1.Terraform.Command.Show.Response
-	75,  // 156: hashicorp.enos.v1.Operation.Response.Destroy.generate:type_name -> hashicorp.enos.v1.Operation.Response.Generate
-	93,  // 157: hashicorp.enos.v1.Operation.Response.Destroy.init:type_name -> hashicorp.enos.v1.Terraform.Command.Init.Response
-	97,  // 158: hashicorp.enos.v1.Operation.Response.Destroy.destroy:type_name -> hashicorp.enos.v1.Terraform.Command.Destroy.Response
-	6,   // 159: hashicorp.enos.v1.Operation.Response.Run.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	75,  // 160: hashicorp.enos.v1.Operation.Response.Run.generate:type_name -> hashicorp.enos.v1.Operation.Response.Generate
-	93,  // 161: hashicorp.enos.v1.Operation.Response.Run.init:type_name -> hashicorp.enos.v1.Terraform.Command.Init.Response
-	94,  // 162: hashicorp.enos.v1.Operation.Response.Run.validate:type_name -> hashicorp.enos.v1.Terraform.Command.Validate.Response
-	95,  // 163: hashicorp.enos.v1.Operation.Response.Run.plan:type_name -> hashicorp.enos.v1.Terraform.Command.Plan.Response
-	96,  // 164: hashicorp.enos.v1.Operation.Response.Run.apply:type_name -> hashicorp.enos.v1.Terraform.Command.Apply.Response
-	101, // 165: hashicorp.enos.v1.Operation.Response.Run.prior_state_show:type_name -> hashicorp.enos.v1.Terraform.Command.Show.Response
-	97,  // 166: hashicorp.enos.v1.Operation.Response.Run.destroy:type_name -> hashicorp.enos.v1.Terraform.Command.Destroy.Response
-	6,   // 167: hashicorp.enos.v1.Operation.Response.Exec.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	82,  // 168: hashicorp.enos.v1.Operation.Response.Exec.terraform_module:type_name -> hashicorp.enos.v1.Terraform.Module
-	98,  // 169: hashicorp.enos.v1.Operation.Response.Exec.exec:type_name -> hashicorp.enos.v1.Terraform.Command.Exec.Response
-	6,   // 170: hashicorp.enos.v1.Operation.Response.Output.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	82,  // 171: hashicorp.enos.v1.Operation.Response.Output.terraform_module:type_name -> hashicorp.enos.v1.Terraform.Module
-	99,  // 172: hashicorp.enos.v1.Operation.Response.Output.output:type_name -> hashicorp.enos.v1.Terraform.Command.Output.Response
-	115, // 173: hashicorp.enos.v1.Terraform.Module.scenario_ref:type_name -> hashicorp.enos.v1.Ref.Scenario
-	102, // 174: hashicorp.enos.v1.Terraform.Runner.config:type_name -> hashicorp.enos.v1.Terraform.Runner.Config
-	6,   // 175: hashicorp.enos.v1.Terraform.Command.Init.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	6,   // 176: hashicorp.enos.v1.Terraform.Command.Validate.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	6,   // 177: hashicorp.enos.v1.Terraform.Command.Plan.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	6,   // 178: hashicorp.enos.v1.Terraform.Command.Apply.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	6,   // 179: hashicorp.enos.v1.Terraform.Command.Destroy.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	6,   // 180: hashicorp.enos.v1.Terraform.Command.Exec.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	6,   // 181: hashicorp.enos.v1.Terraform.Command.Output.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	100, // 182: hashicorp.enos.v1.Terraform.Command.Output.Response.meta:type_name -> hashicorp.enos.v1.Terraform.Command.Output.Response.Meta
-	6,   // 183: hashicorp.enos.v1.Terraform.Command.Show.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	104, // 184: hashicorp.enos.v1.Terraform.Runner.Config.flags:type_name -> hashicorp.enos.v1.Terraform.Runner.Config.Flags
-	103, // 185: hashicorp.enos.v1.Terraform.Runner.Config.env:type_name -> hashicorp.enos.v1.Terraform.Runner.Config.EnvEntry
-	123, // 186: hashicorp.enos.v1.Terraform.Runner.Config.Flags.lock_timeout:type_name -> google.protobuf.Duration
-	106, // 187: hashicorp.enos.v1.Matrix.Vector.elements:type_name -> hashicorp.enos.v1.Matrix.Element
-	105, // 188: hashicorp.enos.v1.Matrix.Exclude.vector:type_name -> hashicorp.enos.v1.Matrix.Vector
-	4,   // 189: hashicorp.enos.v1.Matrix.Exclude.mode:type_name -> hashicorp.enos.v1.Matrix.Exclude.Mode
-	114, // 190: hashicorp.enos.v1.Sample.Subset.id:type_name -> hashicorp.enos.v1.Sample.Subset.ID
-	113, // 191: hashicorp.enos.v1.Sample.Subset.attributes:type_name -> hashicorp.enos.v1.Sample.Attribute
-	15,  // 192: hashicorp.enos.v1.Sample.Subset.matrix:type_name -> hashicorp.enos.v1.Matrix
-	117, // 193: hashicorp.enos.v1.Sample.Filter.sample:type_name -> hashicorp.enos.v1.Ref.Sample
-	114, // 194: hashicorp.enos.v1.Sample.Filter.subsets:type_name -> hashicorp.enos.v1.Sample.Subset.ID
-	114, // 195: hashicorp.enos.v1.Sample.Filter.exclude_subsets:type_name -> hashicorp.enos.v1.Sample.Subset.ID
-	117, // 196: hashicorp.enos.v1.Sample.Element.sample:type_name -> hashicorp.enos.v1.Ref.Sample
-	118, // 197: hashicorp.enos.v1.Sample.Element.subset:type_name -> hashicorp.enos.v1.Ref.Sample.Subset
-	115, // 198: hashicorp.enos.v1.Sample.Element.scenario:type_name -> hashicorp.enos.v1.Ref.Scenario
-	124, // 199: hashicorp.enos.v1.Sample.Element.attributes:type_name -> google.protobuf.Struct
-	6,   // 200: hashicorp.enos.v1.Sample.Observation.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	111, // 201: hashicorp.enos.v1.Sample.Observation.elements:type_name -> hashicorp.enos.v1.Sample.Element
-	110, // 202: hashicorp.enos.v1.Sample.Observation.filter:type_name -> hashicorp.enos.v1.Sample.Filter
-	59,  // 203: hashicorp.enos.v1.Ref.Scenario.id:type_name -> hashicorp.enos.v1.Scenario.ID
-	115, // 204: hashicorp.enos.v1.Ref.Operation.scenario:type_name -> hashicorp.enos.v1.Ref.Scenario
-	108, // 205: hashicorp.enos.v1.Ref.Sample.id:type_name -> hashicorp.enos.v1.Sample.ID
-	114, // 206: hashicorp.enos.v1.Ref.Sample.Subset.id:type_name -> hashicorp.enos.v1.Sample.Subset.ID
-	6,   // 207: hashicorp.enos.v1.FormatResponse.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
-	18,  // 208: hashicorp.enos.v1.EnosService.GetVersion:input_type -> hashicorp.enos.v1.GetVersionRequest
-	20,  // 209: hashicorp.enos.v1.EnosService.ValidateScenariosConfiguration:input_type -> hashicorp.enos.v1.ValidateScenariosConfigurationRequest
-	22,  // 210: hashicorp.enos.v1.EnosService.ListScenarios:input_type -> hashicorp.enos.v1.ListScenariosRequest
-	27,  // 211: hashicorp.enos.v1.EnosService.CheckScenarios:input_type -> hashicorp.enos.v1.CheckScenariosRequest
-	25,  // 212: hashicorp.enos.v1.EnosService.GenerateScenarios:input_type -> hashicorp.enos.v1.GenerateScenariosRequest
-	29,  // 213: hashicorp.enos.v1.EnosService.LaunchScenarios:input_type -> hashicorp.enos.v1.LaunchScenariosRequest
-	31,  // 214: hashicorp.enos.v1.EnosService.DestroyScenarios:input_type -> hashicorp.enos.v1.DestroyScenariosRequest
-	33,  // 215: hashicorp.enos.v1.EnosService.RunScenarios:input_type -> hashicorp.enos.v1.RunScenariosRequest
-	35,  // 216: hashicorp.enos.v1.EnosService.ExecScenarios:input_type -> hashicorp.enos.v1.ExecScenariosRequest
-	37,  // 217: hashicorp.enos.v1.EnosService.OutputScenarios:input_type -> hashicorp.enos.v1.OutputScenariosRequest
-	43,  // 218: hashicorp.enos.v1.EnosService.Format:input_type -> hashicorp.enos.v1.FormatRequest
-	45,  // 219: hashicorp.enos.v1.EnosService.OperationEventStream:input_type -> hashicorp.enos.v1.OperationEventStreamRequest
-	47,  // 220: hashicorp.enos.v1.EnosService.Operation:input_type -> hashicorp.enos.v1.OperationRequest
-	39,  // 221: hashicorp.enos.v1.EnosService.ListSamples:input_type -> hashicorp.enos.v1.ListSamplesRequest
-	41,  // 222: hashicorp.enos.v1.EnosService.ObserveSample:input_type -> hashicorp.enos.v1.ObserveSampleRequest
-	50,  // 223: hashicorp.enos.v1.EnosService.OutlineScenarios:input_type -> hashicorp.enos.v1.OutlineScenariosRequest
-	19,  // 224: hashicorp.enos.v1.EnosService.GetVersion:output_type -> hashicorp.enos.v1.GetVersionResponse
-	21,  // 225: hashicorp.enos.v1.EnosService.ValidateScenariosConfiguration:output_type -> hashicorp.enos.v1.ValidateScenariosConfigurationResponse
-	24,  // 226: hashicorp.enos.v1.EnosService.ListScenarios:output_type -> hashicorp.enos.v1.EnosServiceListScenariosResponse
-	28,  // 227: hashicorp.enos.v1.EnosService.CheckScenarios:output_type -> hashicorp.enos.v1.CheckScenariosResponse
-	26,  // 228: hashicorp.enos.v1.EnosService.GenerateScenarios:output_type -> hashicorp.enos.v1.GenerateScenariosResponse
-	30,  // 229: hashicorp.enos.v1.EnosService.LaunchScenarios:output_type -> hashicorp.enos.v1.LaunchScenariosResponse
-	32,  // 230: hashicorp.enos.v1.EnosService.DestroyScenarios:output_type -> hashicorp.enos.v1.DestroyScenariosResponse
-	34,  // 231: hashicorp.enos.v1.EnosService.RunScenarios:output_type -> hashicorp.enos.v1.RunScenariosResponse
-	36,  // 232: hashicorp.enos.v1.EnosService.ExecScenarios:output_type -> hashicorp.enos.v1.ExecScenariosResponse
-	38,  // 233: hashicorp.enos.v1.EnosService.OutputScenarios:output_type -> hashicorp.enos.v1.OutputScenariosResponse
-	44,  // 234: hashicorp.enos.v1.EnosService.Format:output_type -> hashicorp.enos.v1.FormatResponse
-	46,  // 235: hashicorp.enos.v1.EnosService.OperationEventStream:output_type -> hashicorp.enos.v1.OperationEventStreamResponse
-	48,  // 236: hashicorp.enos.v1.EnosService.Operation:output_type -> hashicorp.enos.v1.OperationResponse
-	40,  // 237: hashicorp.enos.v1.EnosService.ListSamples:output_type -> hashicorp.enos.v1.ListSamplesResponse
-	42,  // 238: hashicorp.enos.v1.EnosService.ObserveSample:output_type -> hashicorp.enos.v1.ObserveSampleResponse
-	51,  // 239: hashicorp.enos.v1.EnosService.OutlineScenarios:output_type -> hashicorp.enos.v1.OutlineScenariosResponse
-	224, // [224:240] is the sub-list for method output_type
-	208, // [208:224] is the sub-list for method input_type
-	208, // [208:208] is the sub-list for extension type_name
-	208, // [208:208] is the sub-list for extension extendee
-	0,   // [0:208] is the sub-list for field type_name
+	13,  // 1: hashicorp.enos.v1.Diagnostic.range:type_name -> hashicorp.enos.v1.Range
+	77,  // 2: hashicorp.enos.v1.Diagnostic.snippet:type_name -> hashicorp.enos.v1.Diagnostic.Snippet
+	79,  // 3: hashicorp.enos.v1.Range.start:type_name -> hashicorp.enos.v1.Range.Pos
+	79,  // 4: hashicorp.enos.v1.Range.end:type_name -> hashicorp.enos.v1.Range.Pos
+	15,  // 5: hashicorp.enos.v1.Workspace.flightplan:type_name -> hashicorp.enos.v1.FlightPlan
+	133, // 6: hashicorp.enos.v1.Workspace.tf_exec_cfg:type_name -> hashicorp.enos.v1.Terraform.Runner.Config
+	80,  // 7: hashicorp.enos.v1.FlightPlan.enos_hcl:type_name -> hashicorp.enos.v1.FlightPlan.EnosHclEntry
+	81,  // 8: hashicorp.enos.v1.FlightPlan.enos_vars_hcl:type_name -> hashicorp.enos.v1.FlightPlan.EnosVarsHclEntry
+	11,  // 9: hashicorp.enos.v1.DecodeResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	15,  // 10: hashicorp.enos.v1.DecodeResponse.flightplan:type_name -> hashicorp.enos.v1.FlightPlan
+	88,  // 11: hashicorp.enos.v1.Operator.config:type_name -> hashicorp.enos.v1.Operator.Config
+	136, // 12: hashicorp.enos.v1.Matrix.vectors:type_name -> hashicorp.enos.v1.Matrix.Vector
+	136, // 13: hashicorp.enos.v1.Matrix.include:type_name -> hashicorp.enos.v1.Matrix.Vector
+	138, // 14: hashicorp.enos.v1.Matrix.exclude:type_name -> hashicorp.enos.v1.Matrix.Exclude
+	139, // 15: hashicorp.enos.v1.Sample.id:type_name -> hashicorp.enos.v1.Sample.ID
+	144, // 16: hashicorp.enos.v1.Sample.attributes:type_name -> hashicorp.enos.v1.Sample.Attribute
+	140, // 17: hashicorp.enos.v1.Sample.subsets:type_name -> hashicorp.enos.v1.Sample.Subset
+	14,  // 18: hashicorp.enos.v1.LintScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	150, // 19: hashicorp.enos.v1.LintScenariosRequest.config:type_name -> hashicorp.enos.v1.Lint.Config
+	11,  // 20: hashicorp.enos.v1.LintScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 21: hashicorp.enos.v1.LintScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	14,  // 22: hashicorp.enos.v1.ListScenarioVariablesRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	11,  // 23: hashicorp.enos.v1.ListScenarioVariablesResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 24: hashicorp.enos.v1.ListScenarioVariablesResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	29,  // 25: hashicorp.enos.v1.ListScenarioVariablesResponse.variables:type_name -> hashicorp.enos.v1.Variable
+	8,   // 26: hashicorp.enos.v1.Variable.source:type_name -> hashicorp.enos.v1.Variable.Source
+	13,  // 27: hashicorp.enos.v1.Variable.range:type_name -> hashicorp.enos.v1.Range
+	11,  // 28: hashicorp.enos.v1.ExplainDiagnosticCodeResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	12,  // 29: hashicorp.enos.v1.ExplainDiagnosticCodeResponse.codes:type_name -> hashicorp.enos.v1.DiagnosticCode
+	11,  // 30: hashicorp.enos.v1.GetVersionResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	14,  // 31: hashicorp.enos.v1.ValidateScenariosConfigurationRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 32: hashicorp.enos.v1.ValidateScenariosConfigurationRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	141, // 33: hashicorp.enos.v1.ValidateScenariosConfigurationRequest.sample_filter:type_name -> hashicorp.enos.v1.Sample.Filter
+	11,  // 34: hashicorp.enos.v1.ValidateScenariosConfigurationResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 35: hashicorp.enos.v1.ValidateScenariosConfigurationResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	16,  // 36: hashicorp.enos.v1.ValidateScenariosConfigurationResponse.sample_decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	14,  // 37: hashicorp.enos.v1.ListScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 38: hashicorp.enos.v1.ListScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	11,  // 39: hashicorp.enos.v1.ListScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 40: hashicorp.enos.v1.ListScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	146, // 41: hashicorp.enos.v1.ListScenariosResponse.scenarios:type_name -> hashicorp.enos.v1.Ref.Scenario
+	146, // 42: hashicorp.enos.v1.EnosServiceListScenariosResponse.scenario:type_name -> hashicorp.enos.v1.Ref.Scenario
+	16,  // 43: hashicorp.enos.v1.EnosServiceListScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	14,  // 44: hashicorp.enos.v1.GenerateScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 45: hashicorp.enos.v1.GenerateScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	11,  // 46: hashicorp.enos.v1.GenerateScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 47: hashicorp.enos.v1.GenerateScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	147, // 48: hashicorp.enos.v1.GenerateScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
+	14,  // 49: hashicorp.enos.v1.CheckScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 50: hashicorp.enos.v1.CheckScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	11,  // 51: hashicorp.enos.v1.CheckScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 52: hashicorp.enos.v1.CheckScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	147, // 53: hashicorp.enos.v1.CheckScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
+	14,  // 54: hashicorp.enos.v1.LaunchScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 55: hashicorp.enos.v1.LaunchScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	89,  // 56: hashicorp.enos.v1.LaunchScenariosRequest.failure_policy:type_name -> hashicorp.enos.v1.Operation.FailurePolicy
+	159, // 57: hashicorp.enos.v1.LaunchScenariosRequest.ttl:type_name -> google.protobuf.Duration
+	11,  // 58: hashicorp.enos.v1.LaunchScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 59: hashicorp.enos.v1.LaunchScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	147, // 60: hashicorp.enos.v1.LaunchScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
+	14,  // 61: hashicorp.enos.v1.DestroyScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 62: hashicorp.enos.v1.DestroyScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	11,  // 63: hashicorp.enos.v1.DestroyScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 64: hashicorp.enos.v1.DestroyScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	147, // 65: hashicorp.enos.v1.DestroyScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
+	14,  // 66: hashicorp.enos.v1.ReapScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	11,  // 67: hashicorp.enos.v1.ReapScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 68: hashicorp.enos.v1.ReapScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	147, // 69: hashicorp.enos.v1.ReapScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
+	14,  // 70: hashicorp.enos.v1.RunScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 71: hashicorp.enos.v1.RunScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	89,  // 72: hashicorp.enos.v1.RunScenariosRequest.failure_policy:type_name -> hashicorp.enos.v1.Operation.FailurePolicy
+	11,  // 73: hashicorp.enos.v1.RunScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 74: hashicorp.enos.v1.RunScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	147, // 75: hashicorp.enos.v1.RunScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
+	14,  // 76: hashicorp.enos.v1.ExecScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 77: hashicorp.enos.v1.ExecScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	11,  // 78: hashicorp.enos.v1.ExecScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 79: hashicorp.enos.v1.ExecScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	147, // 80: hashicorp.enos.v1.ExecScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
+	151, // 81: hashicorp.enos.v1.ExecScenarioStreamRequest.start:type_name -> hashicorp.enos.v1.ExecScenarioStreamRequest.Start
+	152, // 82: hashicorp.enos.v1.ExecScenarioStreamRequest.stdin:type_name -> hashicorp.enos.v1.ExecScenarioStreamRequest.Stdin
+	153, // 83: hashicorp.enos.v1.ExecScenarioStreamRequest.resize:type_name -> hashicorp.enos.v1.ExecScenarioStreamRequest.TerminalSize
+	154, // 84: hashicorp.enos.v1.ExecScenarioStreamResponse.exit:type_name -> hashicorp.enos.v1.ExecScenarioStreamResponse.Exit
+	14,  // 85: hashicorp.enos.v1.OutputScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 86: hashicorp.enos.v1.OutputScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	11,  // 87: hashicorp.enos.v1.OutputScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 88: hashicorp.enos.v1.OutputScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	147, // 89: hashicorp.enos.v1.OutputScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
+	14,  // 90: hashicorp.enos.v1.StatusScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 91: hashicorp.enos.v1.StatusScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	11,  // 92: hashicorp.enos.v1.StatusScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 93: hashicorp.enos.v1.StatusScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	147, // 94: hashicorp.enos.v1.StatusScenariosResponse.operations:type_name -> hashicorp.enos.v1.Ref.Operation
+	14,  // 95: hashicorp.enos.v1.ListSamplesRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	11,  // 96: hashicorp.enos.v1.ListSamplesResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 97: hashicorp.enos.v1.ListSamplesResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	148, // 98: hashicorp.enos.v1.ListSamplesResponse.samples:type_name -> hashicorp.enos.v1.Ref.Sample
+	14,  // 99: hashicorp.enos.v1.ObserveSampleRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	141, // 100: hashicorp.enos.v1.ObserveSampleRequest.filter:type_name -> hashicorp.enos.v1.Sample.Filter
+	11,  // 101: hashicorp.enos.v1.ObserveSampleResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 102: hashicorp.enos.v1.ObserveSampleResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	143, // 103: hashicorp.enos.v1.ObserveSampleResponse.observation:type_name -> hashicorp.enos.v1.Sample.Observation
+	155, // 104: hashicorp.enos.v1.FormatRequest.files:type_name -> hashicorp.enos.v1.FormatRequest.File
+	156, // 105: hashicorp.enos.v1.FormatRequest.config:type_name -> hashicorp.enos.v1.FormatRequest.Config
+	11,  // 106: hashicorp.enos.v1.FormatResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	157, // 107: hashicorp.enos.v1.FormatResponse.responses:type_name -> hashicorp.enos.v1.FormatResponse.Response
+	147, // 108: hashicorp.enos.v1.OperationEventStreamRequest.op:type_name -> hashicorp.enos.v1.Ref.Operation
+	11,  // 109: hashicorp.enos.v1.OperationEventStreamResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	92,  // 110: hashicorp.enos.v1.OperationEventStreamResponse.event:type_name -> hashicorp.enos.v1.Operation.Event
+	147, // 111: hashicorp.enos.v1.OperationRequest.op:type_name -> hashicorp.enos.v1.Ref.Operation
+	11,  // 112: hashicorp.enos.v1.OperationResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	91,  // 113: hashicorp.enos.v1.OperationResponse.response:type_name -> hashicorp.enos.v1.Operation.Response
+	11,  // 114: hashicorp.enos.v1.OperationResponses.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 115: hashicorp.enos.v1.OperationResponses.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	91,  // 116: hashicorp.enos.v1.OperationResponses.responses:type_name -> hashicorp.enos.v1.Operation.Response
+	92,  // 117: hashicorp.enos.v1.OperationRecord.event:type_name -> hashicorp.enos.v1.Operation.Event
+	69,  // 118: hashicorp.enos.v1.OperationRecord.responses:type_name -> hashicorp.enos.v1.OperationResponses
+	14,  // 119: hashicorp.enos.v1.OutlineScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 120: hashicorp.enos.v1.OutlineScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	11,  // 121: hashicorp.enos.v1.OutlineScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 122: hashicorp.enos.v1.OutlineScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	84,  // 123: hashicorp.enos.v1.OutlineScenariosResponse.outlines:type_name -> hashicorp.enos.v1.Scenario.Outline
+	75,  // 124: hashicorp.enos.v1.OutlineScenariosResponse.verifies:type_name -> hashicorp.enos.v1.Quality
+	14,  // 125: hashicorp.enos.v1.CleanScenariosRequest.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 126: hashicorp.enos.v1.CleanScenariosRequest.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	11,  // 127: hashicorp.enos.v1.CleanScenariosResponse.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 128: hashicorp.enos.v1.CleanScenariosResponse.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	158, // 129: hashicorp.enos.v1.CleanScenariosResponse.modules:type_name -> hashicorp.enos.v1.CleanScenariosResponse.Module
+	0,   // 130: hashicorp.enos.v1.UI.Settings.format:type_name -> hashicorp.enos.v1.UI.Settings.Format
+	1,   // 131: hashicorp.enos.v1.UI.Settings.level:type_name -> hashicorp.enos.v1.UI.Settings.Level
+	78,  // 132: hashicorp.enos.v1.Diagnostic.Snippet.values:type_name -> hashicorp.enos.v1.Diagnostic.ExpressionValue
+	136, // 133: hashicorp.enos.v1.Scenario.ID.variants:type_name -> hashicorp.enos.v1.Matrix.Vector
+	85,  // 134: hashicorp.enos.v1.Scenario.Filter.select_all:type_name -> hashicorp.enos.v1.Scenario.Filter.SelectAll
+	136, // 135: hashicorp.enos.v1.Scenario.Filter.include:type_name -> hashicorp.enos.v1.Matrix.Vector
+	138, // 136: hashicorp.enos.v1.Scenario.Filter.exclude:type_name -> hashicorp.enos.v1.Matrix.Exclude
+	21,  // 137: hashicorp.enos.v1.Scenario.Filter.intersection_matrix:type_name -> hashicorp.enos.v1.Matrix
+	86,  // 138: hashicorp.enos.v1.Scenario.Filter.variants:type_name -> hashicorp.enos.v1.Scenario.Filter.Match
+	86,  // 139: hashicorp.enos.v1.Scenario.Filter.verifies:type_name -> hashicorp.enos.v1.Scenario.Filter.Match
+	86,  // 140: hashicorp.enos.v1.Scenario.Filter.modules:type_name -> hashicorp.enos.v1.Scenario.Filter.Match
+	146, // 141: hashicorp.enos.v1.Scenario.Outline.scenario:type_name -> hashicorp.enos.v1.Ref.Scenario
+	21,  // 142: hashicorp.enos.v1.Scenario.Outline.matrix:type_name -> hashicorp.enos.v1.Matrix
+	87,  // 143: hashicorp.enos.v1.Scenario.Outline.steps:type_name -> hashicorp.enos.v1.Scenario.Outline.Step
+	75,  // 144: hashicorp.enos.v1.Scenario.Outline.verifies:type_name -> hashicorp.enos.v1.Quality
+	75,  // 145: hashicorp.enos.v1.Scenario.Outline.Step.verifies:type_name -> hashicorp.enos.v1.Quality
+	4,   // 146: hashicorp.enos.v1.Operation.FailurePolicy.type:type_name -> hashicorp.enos.v1.Operation.FailurePolicy.Type
+	159, // 147: hashicorp.enos.v1.Operation.FailurePolicy.keep_for:type_name -> google.protobuf.Duration
+	146, // 148: hashicorp.enos.v1.Operation.Request.scenario:type_name -> hashicorp.enos.v1.Ref.Scenario
+	14,  // 149: hashicorp.enos.v1.Operation.Request.workspace:type_name -> hashicorp.enos.v1.Workspace
+	93,  // 150: hashicorp.enos.v1.Operation.Request.generate:type_name -> hashicorp.enos.v1.Operation.Request.Generate
+	94,  // 151: hashicorp.enos.v1.Operation.Request.check:type_name -> hashicorp.enos.v1.Operation.Request.Check
+	95,  // 152: hashicorp.enos.v1.Operation.Request.launch:type_name -> hashicorp.enos.v1.Operation.Request.Launch
+	96,  // 153: hashicorp.enos.v1.Operation.Request.destroy:type_name -> hashicorp.enos.v1.Operation.Request.Destroy
+	97,  // 154: hashicorp.enos.v1.Operation.Request.run:type_name -> hashicorp.enos.v1.Operation.Request.Run
+	98,  // 155: hashicorp.enos.v1.Operation.Request.exec:type_name -> hashicorp.enos.v1.Operation.Request.Exec
+	99,  // 156: hashicorp.enos.v1.Operation.Request.output:type_name -> hashicorp.enos.v1.Operation.Request.Output
+	100, // 157: hashicorp.enos.v1.Operation.Request.state:type_name -> hashicorp.enos.v1.Operation.Request.State
+	11,  // 158: hashicorp.enos.v1.Operation.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	147, // 159: hashicorp.enos.v1.Operation.Response.op:type_name -> hashicorp.enos.v1.Ref.Operation
+	3,   // 160: hashicorp.enos.v1.Operation.Response.status:type_name -> hashicorp.enos.v1.Operation.Status
+	101, // 161: hashicorp.enos.v1.Operation.Response.generate:type_name -> hashicorp.enos.v1.Operation.Response.Generate
+	102, // 162: hashicorp.enos.v1.Operation.Response.check:type_name -> hashicorp.enos.v1.Operation.Response.Check
+	103, // 163: hashicorp.enos.v1.Operation.Response.launch:type_name -> hashicorp.enos.v1.Operation.Response.Launch
+	104, // 164: hashicorp.enos.v1.Operation.Response.destroy:type_name -> hashicorp.enos.v1.Operation.Response.Destroy
+	105, // 165: hashicorp.enos.v1.Operation.Response.run:type_name -> hashicorp.enos.v1.Operation.Response.Run
+	108, // 166: hashicorp.enos.v1.Operation.Response.exec:type_name -> hashicorp.enos.v1.Operation.Response.Exec
+	109, // 167: hashicorp.enos.v1.Operation.Response.output:type_name -> hashicorp.enos.v1.Operation.Response.Output
+	110, // 168: hashicorp.enos.v1.Operation.Response.state:type_name -> hashicorp.enos.v1.Operation.Response.State
+	11,  // 169: hashicorp.enos.v1.Operation.Event.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	147, // 170: hashicorp.enos.v1.Operation.Event.op:type_name -> hashicorp.enos.v1.Ref.Operation
+	3,   // 171: hashicorp.enos.v1.Operation.Event.status:type_name -> hashicorp.enos.v1.Operation.Status
+	160, // 172: hashicorp.enos.v1.Operation.Event.published_at:type_name -> google.protobuf.Timestamp
+	16,  // 173: hashicorp.enos.v1.Operation.Event.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	101, // 174: hashicorp.enos.v1.Operation.Event.generate:type_name -> hashicorp.enos.v1.Operation.Response.Generate
+	124, // 175: hashicorp.enos.v1.Operation.Event.init:type_name -> hashicorp.enos.v1.Terraform.Command.Init.Response
+	125, // 176: hashicorp.enos.v1.Operation.Event.validate:type_name -> hashicorp.enos.v1.Terraform.Command.Validate.Response
+	126, // 177: hashicorp.enos.v1.Operation.Event.plan:type_name -> hashicorp.enos.v1.Terraform.Command.Plan.Response
+	127, // 178: hashicorp.enos.v1.Operation.Event.apply:type_name -> hashicorp.enos.v1.Terraform.Command.Apply.Response
+	128, // 179: hashicorp.enos.v1.Operation.Event.destroy:type_name -> hashicorp.enos.v1.Terraform.Command.Destroy.Response
+	129, // 180: hashicorp.enos.v1.Operation.Event.exec:type_name -> hashicorp.enos.v1.Terraform.Command.Exec.Response
+	130, // 181: hashicorp.enos.v1.Operation.Event.output:type_name -> hashicorp.enos.v1.Terraform.Command.Output.Response
+	132, // 182: hashicorp.enos.v1.Operation.Event.show:type_name -> hashicorp.enos.v1.Terraform.Command.Show.Response
+	120, // 183: hashicorp.enos.v1.Operation.Event.progress:type_name -> hashicorp.enos.v1.Terraform.Command.Progress
+	107, // 184: hashicorp.enos.v1.Operation.Event.hook:type_name -> hashicorp.enos.v1.Operation.Response.Hook
+	89,  // 185: hashicorp.enos.v1.Operation.Request.Launch.failure_policy:type_name -> hashicorp.enos.v1.Operation.FailurePolicy
+	159, // 186: hashicorp.enos.v1.Operation.Request.Launch.ttl:type_name -> google.protobuf.Duration
+	112, // 187: hashicorp.enos.v1.Operation.Request.Destroy.terraform_module:type_name -> hashicorp.enos.v1.Terraform.Module
+	89,  // 188: hashicorp.enos.v1.Operation.Request.Run.failure_policy:type_name -> hashicorp.enos.v1.Operation.FailurePolicy
+	11,  // 189: hashicorp.enos.v1.Operation.Response.Generate.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	112, // 190: hashicorp.enos.v1.Operation.Response.Generate.terraform_module:type_name -> hashicorp.enos.v1.Terraform.Module
+	11,  // 191: hashicorp.enos.v1.Operation.Response.Check.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	101, // 192: hashicorp.enos.v1.Operation.Response.Check.generate:type_name -> hashicorp.enos.v1.Operation.Response.Generate
+	124, // 193: hashicorp.enos.v1.Operation.Response.Check.init:type_name -> hashicorp.enos.v1.Terraform.Command.Init.Response
+	125, // 194: hashicorp.enos.v1.Operation.Response.Check.validate:type_name -> hashicorp.enos.v1.Terraform.Command.Validate.Response
+	126, // 195: hashicorp.enos.v1.Operation.Response.Check.plan:type_name -> hashicorp.enos.v1.Terraform.Command.Plan.Response
+	11,  // 196: hashicorp.enos.v1.Operation.Response.Launch.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	101, // 197: hashicorp.enos.v1.Operation.Response.Launch.generate:type_name -> hashicorp.enos.v1.Operation.Response.Generate
+	124, // 198: hashicorp.enos.v1.Operation.Response.Launch.init:type_name -> hashicorp.enos.v1.Terraform.Command.Init.Response
+	125, // 199: hashicorp.enos.v1.Operation.Response.Launch.validate:type_name -> hashicorp.enos.v1.Terraform.Command.Validate.Response
+	126, // 200: hashicorp.enos.v1.Operation.Response.Launch.plan:type_name -> hashicorp.enos.v1.Terraform.Command.Plan.Response
+	127, // 201: hashicorp.enos.v1.Operation.Response.Launch.apply:type_name -> hashicorp.enos.v1.Terraform.Command.Apply.Response
+	107, // 202: hashicorp.enos.v1.Operation.Response.Launch.hooks:type_name -> hashicorp.enos.v1.Operation.Response.Hook
+	106, // 203: hashicorp.enos.v1.Operation.Response.Launch.failure_policy:type_name -> hashicorp.enos.v1.Operation.Response.FailurePolicy
+	160, // 204: hashicorp.enos.v1.Operation.Response.Launch.expires_at:type_name -> google.protobuf.Timestamp
+	11,  // 205: hashicorp.enos.v1.Operation.Response.Destroy.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	132, // 206: hashicorp.enos.v1.Operation.Response.Destroy.prior_state_show:type_name -> hashicorp.enos.v1.Terraform.Command.Show.Response
+	101, // 207: hashicorp.enos.v1.Operation.Response.Destroy.generate:type_name -> hashicorp.enos.v1.Operation.Response.Generate
+	124, // 208: hashicorp.enos.v1.Operation.Response.Destroy.init:type_name -> hashicorp.enos.v1.Terraform.Command.Init.Response
+	128, // 209: hashicorp.enos.v1.Operation.Response.Destroy.destroy:type_name -> hashicorp.enos.v1.Terraform.Command.Destroy.Response
+	107, // 210: hashicorp.enos.v1.Operation.Response.Destroy.hooks:type_name -> hashicorp.enos.v1.Operation.Response.Hook
+	11,  // 211: hashicorp.enos.v1.Operation.Response.Run.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	101, // 212: hashicorp.enos.v1.Operation.Response.Run.generate:type_name -> hashicorp.enos.v1.Operation.Response.Generate
+	124, // 213: hashicorp.enos.v1.Operation.Response.Run.init:type_name -> hashicorp.enos.v1.Terraform.Command.Init.Response
+	125, // 214: hashicorp.enos.v1.Operation.Response.Run.validate:type_name -> hashicorp.enos.v1.Terraform.Command.Validate.Response
+	126, // 215: hashicorp.enos.v1.Operation.Response.Run.plan:type_name -> hashicorp.enos.v1.Terraform.Command.Plan.Response
+	127, // 216: hashicorp.enos.v1.Operation.Response.Run.apply:type_name -> hashicorp.enos.v1.Terraform.Command.Apply.Response
+	132, // 217: hashicorp.enos.v1.Operation.Response.Run.prior_state_show:type_name -> hashicorp.enos.v1.Terraform.Command.Show.Response
+	128, // 218: hashicorp.enos.v1.Operation.Response.Run.destroy:type_name -> hashicorp.enos.v1.Terraform.Command.Destroy.Response
+	107, // 219: hashicorp.enos.v1.Operation.Response.Run.hooks:type_name -> hashicorp.enos.v1.Operation.Response.Hook
+	106, // 220: hashicorp.enos.v1.Operation.Response.Run.failure_policy:type_name -> hashicorp.enos.v1.Operation.Response.FailurePolicy
+	11,  // 221: hashicorp.enos.v1.Operation.Response.FailurePolicy.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	89,  // 222: hashicorp.enos.v1.Operation.Response.FailurePolicy.policy:type_name -> hashicorp.enos.v1.Operation.FailurePolicy
+	5,   // 223: hashicorp.enos.v1.Operation.Response.FailurePolicy.outcome:type_name -> hashicorp.enos.v1.Operation.Response.FailurePolicy.Outcome
+	132, // 224: hashicorp.enos.v1.Operation.Response.FailurePolicy.prior_state_show:type_name -> hashicorp.enos.v1.Terraform.Command.Show.Response
+	128, // 225: hashicorp.enos.v1.Operation.Response.FailurePolicy.destroy:type_name -> hashicorp.enos.v1.Terraform.Command.Destroy.Response
+	160, // 226: hashicorp.enos.v1.Operation.Response.FailurePolicy.expires_at:type_name -> google.protobuf.Timestamp
+	11,  // 227: hashicorp.enos.v1.Operation.Response.Hook.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	159, // 228: hashicorp.enos.v1.Operation.Response.Hook.elapsed:type_name -> google.protobuf.Duration
+	11,  // 229: hashicorp.enos.v1.Operation.Response.Exec.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	112, // 230: hashicorp.enos.v1.Operation.Response.Exec.terraform_module:type_name -> hashicorp.enos.v1.Terraform.Module
+	129, // 231: hashicorp.enos.v1.Operation.Response.Exec.exec:type_name -> hashicorp.enos.v1.Terraform.Command.Exec.Response
+	11,  // 232: hashicorp.enos.v1.Operation.Response.Output.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	112, // 233: hashicorp.enos.v1.Operation.Response.Output.terraform_module:type_name -> hashicorp.enos.v1.Terraform.Module
+	130, // 234: hashicorp.enos.v1.Operation.Response.Output.output:type_name -> hashicorp.enos.v1.Terraform.Command.Output.Response
+	11,  // 235: hashicorp.enos.v1.Operation.Response.State.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	112, // 236: hashicorp.enos.v1.Operation.Response.State.terraform_module:type_name -> hashicorp.enos.v1.Terraform.Module
+	132, // 237: hashicorp.enos.v1.Operation.Response.State.show:type_name -> hashicorp.enos.v1.Terraform.Command.Show.Response
+	111, // 238: hashicorp.enos.v1.Operation.Response.State.steps:type_name -> hashicorp.enos.v1.Operation.Response.State.Step
+	160, // 239: hashicorp.enos.v1.Operation.Response.State.last_modified:type_name -> google.protobuf.Timestamp
+	124, // 240: hashicorp.enos.v1.Operation.Response.State.init:type_name -> hashicorp.enos.v1.Terraform.Command.Init.Response
+	146, // 241: hashicorp.enos.v1.Terraform.Module.scenario_ref:type_name -> hashicorp.enos.v1.Ref.Scenario
+	133, // 242: hashicorp.enos.v1.Terraform.Runner.config:type_name -> hashicorp.enos.v1.Terraform.Runner.Config
+	6,   // 243: hashicorp.enos.v1.Terraform.Command.Progress.type:type_name -> hashicorp.enos.v1.Terraform.Command.Progress.Type
+	159, // 244: hashicorp.enos.v1.Terraform.Command.Progress.elapsed:type_name -> google.protobuf.Duration
+	11,  // 245: hashicorp.enos.v1.Terraform.Command.Init.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	11,  // 246: hashicorp.enos.v1.Terraform.Command.Validate.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	11,  // 247: hashicorp.enos.v1.Terraform.Command.Plan.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	11,  // 248: hashicorp.enos.v1.Terraform.Command.Apply.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	11,  // 249: hashicorp.enos.v1.Terraform.Command.Destroy.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	11,  // 250: hashicorp.enos.v1.Terraform.Command.Exec.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	11,  // 251: hashicorp.enos.v1.Terraform.Command.Output.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	131, // 252: hashicorp.enos.v1.Terraform.Command.Output.Response.meta:type_name -> hashicorp.enos.v1.Terraform.Command.Output.Response.Meta
+	11,  // 253: hashicorp.enos.v1.Terraform.Command.Show.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	135, // 254: hashicorp.enos.v1.Terraform.Runner.Config.flags:type_name -> hashicorp.enos.v1.Terraform.Runner.Config.Flags
+	134, // 255: hashicorp.enos.v1.Terraform.Runner.Config.env:type_name -> hashicorp.enos.v1.Terraform.Runner.Config.EnvEntry
+	159, // 256: hashicorp.enos.v1.Terraform.Runner.Config.Flags.lock_timeout:type_name -> google.protobuf.Duration
+	137, // 257: hashicorp.enos.v1.Matrix.Vector.elements:type_name -> hashicorp.enos.v1.Matrix.Element
+	136, // 258: hashicorp.enos.v1.Matrix.Exclude.vector:type_name -> hashicorp.enos.v1.Matrix.Vector
+	7,   // 259: hashicorp.enos.v1.Matrix.Exclude.mode:type_name -> hashicorp.enos.v1.Matrix.Exclude.Mode
+	145, // 260: hashicorp.enos.v1.Sample.Subset.id:type_name -> hashicorp.enos.v1.Sample.Subset.ID
+	144, // 261: hashicorp.enos.v1.Sample.Subset.attributes:type_name -> hashicorp.enos.v1.Sample.Attribute
+	21,  // 262: hashicorp.enos.v1.Sample.Subset.matrix:type_name -> hashicorp.enos.v1.Matrix
+	148, // 263: hashicorp.enos.v1.Sample.Filter.sample:type_name -> hashicorp.enos.v1.Ref.Sample
+	145, // 264: hashicorp.enos.v1.Sample.Filter.subsets:type_name -> hashicorp.enos.v1.Sample.Subset.ID
+	145, // 265: hashicorp.enos.v1.Sample.Filter.exclude_subsets:type_name -> hashicorp.enos.v1.Sample.Subset.ID
+	148, // 266: hashicorp.enos.v1.Sample.Element.sample:type_name -> hashicorp.enos.v1.Ref.Sample
+	149, // 267: hashicorp.enos.v1.Sample.Element.subset:type_name -> hashicorp.enos.v1.Ref.Sample.Subset
+	146, // 268: hashicorp.enos.v1.Sample.Element.scenario:type_name -> hashicorp.enos.v1.Ref.Scenario
+	161, // 269: hashicorp.enos.v1.Sample.Element.attributes:type_name -> google.protobuf.Struct
+	11,  // 270: hashicorp.enos.v1.Sample.Observation.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	142, // 271: hashicorp.enos.v1.Sample.Observation.elements:type_name -> hashicorp.enos.v1.Sample.Element
+	141, // 272: hashicorp.enos.v1.Sample.Observation.filter:type_name -> hashicorp.enos.v1.Sample.Filter
+	82,  // 273: hashicorp.enos.v1.Ref.Scenario.id:type_name -> hashicorp.enos.v1.Scenario.ID
+	146, // 274: hashicorp.enos.v1.Ref.Operation.scenario:type_name -> hashicorp.enos.v1.Ref.Scenario
+	139, // 275: hashicorp.enos.v1.Ref.Sample.id:type_name -> hashicorp.enos.v1.Sample.ID
+	145, // 276: hashicorp.enos.v1.Ref.Sample.Subset.id:type_name -> hashicorp.enos.v1.Sample.Subset.ID
+	14,  // 277: hashicorp.enos.v1.ExecScenarioStreamRequest.Start.workspace:type_name -> hashicorp.enos.v1.Workspace
+	83,  // 278: hashicorp.enos.v1.ExecScenarioStreamRequest.Start.filter:type_name -> hashicorp.enos.v1.Scenario.Filter
+	153, // 279: hashicorp.enos.v1.ExecScenarioStreamRequest.Start.size:type_name -> hashicorp.enos.v1.ExecScenarioStreamRequest.TerminalSize
+	11,  // 280: hashicorp.enos.v1.ExecScenarioStreamResponse.Exit.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	16,  // 281: hashicorp.enos.v1.ExecScenarioStreamResponse.Exit.decode:type_name -> hashicorp.enos.v1.DecodeResponse
+	146, // 282: hashicorp.enos.v1.ExecScenarioStreamResponse.Exit.scenario:type_name -> hashicorp.enos.v1.Ref.Scenario
+	11,  // 283: hashicorp.enos.v1.FormatResponse.Response.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	11,  // 284: hashicorp.enos.v1.CleanScenariosResponse.Module.diagnostics:type_name -> hashicorp.enos.v1.Diagnostic
+	146, // 285: hashicorp.enos.v1.CleanScenariosResponse.Module.scenario:type_name -> hashicorp.enos.v1.Ref.Scenario
+	9,   // 286: hashicorp.enos.v1.CleanScenariosResponse.Module.state:type_name -> hashicorp.enos.v1.CleanScenariosResponse.Module.State
+	32,  // 287: hashicorp.enos.v1.EnosService.GetVersion:input_type -> hashicorp.enos.v1.GetVersionRequest
+	34,  // 288: hashicorp.enos.v1.EnosService.ValidateScenariosConfiguration:input_type -> hashicorp.enos.v1.ValidateScenariosConfigurationRequest
+	36,  // 289: hashicorp.enos.v1.EnosService.ListScenarios:input_type -> hashicorp.enos.v1.ListScenariosRequest
+	41,  // 290: hashicorp.enos.v1.EnosService.CheckScenarios:input_type -> hashicorp.enos.v1.CheckScenariosRequest
+	39,  // 291: hashicorp.enos.v1.EnosService.GenerateScenarios:input_type -> hashicorp.enos.v1.GenerateScenariosRequest
+	43,  // 292: hashicorp.enos.v1.EnosService.LaunchScenarios:input_type -> hashicorp.enos.v1.LaunchScenariosRequest
+	45,  // 293: hashicorp.enos.v1.EnosService.DestroyScenarios:input_type -> hashicorp.enos.v1.DestroyScenariosRequest
+	49,  // 294: hashicorp.enos.v1.EnosService.RunScenarios:input_type -> hashicorp.enos.v1.RunScenariosRequest
+	51,  // 295: hashicorp.enos.v1.EnosService.ExecScenarios:input_type -> hashicorp.enos.v1.ExecScenariosRequest
+	55,  // 296: hashicorp.enos.v1.EnosService.OutputScenarios:input_type -> hashicorp.enos.v1.OutputScenariosRequest
+	63,  // 297: hashicorp.enos.v1.EnosService.Format:input_type -> hashicorp.enos.v1.FormatRequest
+	65,  // 298: hashicorp.enos.v1.EnosService.OperationEventStream:input_type -> hashicorp.enos.v1.OperationEventStreamRequest
+	67,  // 299: hashicorp.enos.v1.EnosService.Operation:input_type -> hashicorp.enos.v1.OperationRequest
+	59,  // 300: hashicorp.enos.v1.EnosService.ListSamples:input_type -> hashicorp.enos.v1.ListSamplesRequest
+	61,  // 301: hashicorp.enos.v1.EnosService.ObserveSample:input_type -> hashicorp.enos.v1.ObserveSampleRequest
+	71,  // 302: hashicorp.enos.v1.EnosService.OutlineScenarios:input_type -> hashicorp.enos.v1.OutlineScenariosRequest
+	73,  // 303: hashicorp.enos.v1.EnosService.CleanScenarios:input_type -> hashicorp.enos.v1.CleanScenariosRequest
+	57,  // 304: hashicorp.enos.v1.EnosService.StatusScenarios:input_type -> hashicorp.enos.v1.StatusScenariosRequest
+	53,  // 305: hashicorp.enos.v1.EnosService.ExecScenarioStream:input_type -> hashicorp.enos.v1.ExecScenarioStreamRequest
+	30,  // 306: hashicorp.enos.v1.EnosService.ExplainDiagnosticCode:input_type -> hashicorp.enos.v1.ExplainDiagnosticCodeRequest
+	24,  // 307: hashicorp.enos.v1.EnosService.LintScenarios:input_type -> hashicorp.enos.v1.LintScenariosRequest
+	27,  // 308: hashicorp.enos.v1.EnosService.ListScenarioVariables:input_type -> hashicorp.enos.v1.ListScenarioVariablesRequest
+	47,  // 309: hashicorp.enos.v1.EnosService.ReapScenarios:input_type -> hashicorp.enos.v1.ReapScenariosRequest
+	33,  // 310: hashicorp.enos.v1.EnosService.GetVersion:output_type -> hashicorp.enos.v1.GetVersionResponse
+	35,  // 311: hashicorp.enos.v1.EnosService.ValidateScenariosConfiguration:output_type -> hashicorp.enos.v1.ValidateScenariosConfigurationResponse
+	38,  // 312: hashicorp.enos.v1.EnosService.ListScenarios:output_type -> hashicorp.enos.v1.EnosServiceListScenariosResponse
+	42,  // 313: hashicorp.enos.v1.EnosService.CheckScenarios:output_type -> hashicorp.enos.v1.CheckScenariosResponse
+	40,  // 314: hashicorp.enos.v1.EnosService.GenerateScenarios:output_type -> hashicorp.enos.v1.GenerateScenariosResponse
+	44,  // 315: hashicorp.enos.v1.EnosService.LaunchScenarios:output_type -> hashicorp.enos.v1.LaunchScenariosResponse
+	46,  // 316: hashicorp.enos.v1.EnosService.DestroyScenarios:output_type -> hashicorp.enos.v1.DestroyScenariosResponse
+	50,  // 317: hashicorp.enos.v1.EnosService.RunScenarios:output_type -> hashicorp.enos.v1.RunScenariosResponse
+	52,  // 318: hashicorp.enos.v1.EnosService.ExecScenarios:output_type -> hashicorp.enos.v1.ExecScenariosResponse
+	56,  // 319: hashicorp.enos.v1.EnosService.OutputScenarios:output_type -> hashicorp.enos.v1.OutputScenariosResponse
+	64,  // 320: hashicorp.enos.v1.EnosService.Format:output_type -> hashicorp.enos.v1.FormatResponse
+	66,  // 321: hashicorp.enos.v1.EnosService.OperationEventStream:output_type -> hashicorp.enos.v1.OperationEventStreamResponse
+	68,  // 322: hashicorp.enos.v1.EnosService.Operation:output_type -> hashicorp.enos.v1.OperationResponse
+	60,  // 323: hashicorp.enos.v1.EnosService.ListSamples:output_type -> hashicorp.enos.v1.ListSamplesResponse
+	62,  // 324: hashicorp.enos.v1.EnosService.ObserveSample:output_type -> hashicorp.enos.v1.ObserveSampleResponse
+	72,  // 325: hashicorp.enos.v1.EnosService.OutlineScenarios:output_type -> hashicorp.enos.v1.OutlineScenariosResponse
+	74,  // 326: hashicorp.enos.v1.EnosService.CleanScenarios:output_type -> hashicorp.enos.v1.CleanScenariosResponse
+	58,  // 327: hashicorp.enos.v1.EnosService.StatusScenarios:output_type -> hashicorp.enos.v1.StatusScenariosResponse
+	54,  // 328: hashicorp.enos.v1.EnosService.ExecScenarioStream:output_type -> hashicorp.enos.v1.ExecScenarioStreamResponse
+	31,  // 329: hashicorp.enos.v1.EnosService.ExplainDiagnosticCode:output_type -> hashicorp.enos.v1.ExplainDiagnosticCodeResponse
+	25,  // 330: hashicorp.enos.v1.EnosService.LintScenarios:output_type -> hashicorp.enos.v1.LintScenariosResponse
+	28,  // 331: hashicorp.enos.v1.EnosService.ListScenarioVariables:output_type -> hashicorp.enos.v1.ListScenarioVariablesResponse
+	48,  // 332: hashicorp.enos.v1.EnosService.ReapScenarios:output_type -> hashicorp.enos.v1.ReapScenariosResponse
+	310, // [310:333] is the sub-list for method output_type
+	287, // [287:310] is the sub-list for method input_type
+	287, // [287:287] is the sub-list for extension type_name
+	287, // [287:287] is the sub-list for extension extendee
+	0,   // [0:287] is the sub-list for field type_name
 }
 
 func init() { file_hashicorp_enos_v1_enos_proto_init() }
@@ -9210,8 +12637,188 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*Diagnostic); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Diagnostic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*DiagnosticCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Range); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Workspace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DecodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*Scenario); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Operator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Terraform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*Matrix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Sample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*Ref); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*LintScenariosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*LintScenariosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*Lint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9222,8 +12829,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*Range); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ListScenarioVariablesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9234,8 +12841,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*Workspace); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ListScenarioVariablesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9246,8 +12853,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*FlightPlan); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*Variable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9258,8 +12865,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*DecodeResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ExplainDiagnosticCodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9270,8 +12877,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*Scenario); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ExplainDiagnosticCodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9282,8 +12889,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*Operator); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVersionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9294,8 +12901,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9306,8 +12913,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*Terraform); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateScenariosConfigurationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9318,8 +12925,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*Matrix); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateScenariosConfigurationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9330,8 +12937,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*Sample); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ListScenariosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9342,8 +12949,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*Ref); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ListScenariosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9354,8 +12961,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*GetVersionRequest); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*EnosServiceListScenariosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9366,8 +12973,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*GetVersionResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateScenariosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9378,8 +12985,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*ValidateScenariosConfigurationRequest); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateScenariosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9390,8 +12997,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*ValidateScenariosConfigurationResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckScenariosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9402,8 +13009,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*ListScenariosRequest); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckScenariosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9414,8 +13021,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*ListScenariosResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*LaunchScenariosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9426,8 +13033,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*EnosServiceListScenariosResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*LaunchScenariosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9438,8 +13045,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*GenerateScenariosRequest); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*DestroyScenariosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9450,8 +13057,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*GenerateScenariosResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*DestroyScenariosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9462,8 +13069,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckScenariosRequest); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ReapScenariosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9474,8 +13081,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckScenariosResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ReapScenariosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9486,8 +13093,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*LaunchScenariosRequest); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*RunScenariosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9498,8 +13105,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*LaunchScenariosResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*RunScenariosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9510,8 +13117,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*DestroyScenariosRequest); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecScenariosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9522,8 +13129,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*DestroyScenariosResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecScenariosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9534,8 +13141,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*RunScenariosRequest); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecScenarioStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9546,8 +13153,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*RunScenariosResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecScenarioStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9558,8 +13165,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*ExecScenariosRequest); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*OutputScenariosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9570,8 +13177,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*ExecScenariosResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*OutputScenariosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9582,8 +13189,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*OutputScenariosRequest); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusScenariosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9594,8 +13201,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*OutputScenariosResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusScenariosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9606,7 +13213,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[34].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[49].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSamplesRequest); i {
 			case 0:
 				return &v.state
@@ -9618,7 +13225,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[35].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[50].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSamplesResponse); i {
 			case 0:
 				return &v.state
@@ -9630,7 +13237,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[36].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[51].Exporter = func(v any, i int) any {
 			switch v := v.(*ObserveSampleRequest); i {
 			case 0:
 				return &v.state
@@ -9642,7 +13249,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[37].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[52].Exporter = func(v any, i int) any {
 			switch v := v.(*ObserveSampleResponse); i {
 			case 0:
 				return &v.state
@@ -9654,7 +13261,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[38].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*FormatRequest); i {
 			case 0:
 				return &v.state
@@ -9666,7 +13273,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[39].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*FormatResponse); i {
 			case 0:
 				return &v.state
@@ -9678,7 +13285,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[40].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[55].Exporter = func(v any, i int) any {
 			switch v := v.(*OperationEventStreamRequest); i {
 			case 0:
 				return &v.state
@@ -9690,8 +13297,128 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*OperationEventStreamResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*OperationEventStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*OperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*OperationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*OperationResponses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*OperationRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*OutlineScenariosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*OutlineScenariosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*CleanScenariosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*CleanScenariosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*Quality); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*UI_Settings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9702,8 +13429,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*OperationRequest); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*Diagnostic_Snippet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9714,8 +13441,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*OperationResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*Diagnostic_ExpressionValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9726,8 +13453,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[44].Exporter = func(v any, i int) any {
-			switch v := v.(*OperationResponses); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*Range_Pos); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9738,8 +13465,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[45].Exporter = func(v any, i int) any {
-			switch v := v.(*OutlineScenariosRequest); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*Scenario_ID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9750,8 +13477,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[46].Exporter = func(v any, i int) any {
-			switch v := v.(*OutlineScenariosResponse); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*Scenario_Filter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9762,8 +13489,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[47].Exporter = func(v any, i int) any {
-			switch v := v.(*Quality); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*Scenario_Outline); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9774,8 +13501,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[48].Exporter = func(v any, i int) any {
-			switch v := v.(*UI_Settings); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*Scenario_Filter_SelectAll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9786,8 +13513,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[49].Exporter = func(v any, i int) any {
-			switch v := v.(*Diagnostic_Snippet); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*Scenario_Filter_Match); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9798,8 +13525,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[50].Exporter = func(v any, i int) any {
-			switch v := v.(*Diagnostic_ExpressionValue); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*Scenario_Outline_Step); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9810,8 +13537,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[51].Exporter = func(v any, i int) any {
-			switch v := v.(*Range_Pos); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*Operator_Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9822,8 +13549,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[54].Exporter = func(v any, i int) any {
-			switch v := v.(*Scenario_ID); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_FailurePolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9834,8 +13561,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[55].Exporter = func(v any, i int) any {
-			switch v := v.(*Scenario_Filter); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9846,8 +13573,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[56].Exporter = func(v any, i int) any {
-			switch v := v.(*Scenario_Outline); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9858,8 +13585,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[57].Exporter = func(v any, i int) any {
-			switch v := v.(*Scenario_Filter_SelectAll); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9870,8 +13597,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[58].Exporter = func(v any, i int) any {
-			switch v := v.(*Scenario_Outline_Step); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Request_Generate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9882,8 +13609,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[59].Exporter = func(v any, i int) any {
-			switch v := v.(*Operator_Config); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Request_Check); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9894,8 +13621,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[60].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Request); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Request_Launch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9906,8 +13633,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[61].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Response); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Request_Destroy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9918,8 +13645,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[62].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Event); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Request_Run); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9930,8 +13657,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[63].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Request_Generate); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Request_Exec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9942,8 +13669,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[64].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Request_Check); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Request_Output); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9954,8 +13681,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[65].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Request_Launch); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Request_State); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9966,8 +13693,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[66].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Request_Destroy); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Response_Generate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9978,8 +13705,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[67].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Request_Run); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Response_Check); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9990,8 +13717,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[68].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Request_Exec); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Response_Launch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10002,8 +13729,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[69].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Request_Output); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Response_Destroy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10014,8 +13741,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[70].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Response_Generate); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Response_Run); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10026,8 +13753,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[71].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Response_Check); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Response_FailurePolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10038,8 +13765,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[72].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Response_Launch); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Response_Hook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10050,8 +13777,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[73].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Response_Destroy); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Response_Exec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10062,8 +13789,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[74].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Response_Run); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Response_Output); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10074,8 +13801,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[75].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Response_Exec); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[100].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Response_State); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10086,8 +13813,8 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[76].Exporter = func(v any, i int) any {
-			switch v := v.(*Operation_Response_Output); i {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[101].Exporter = func(v any, i int) any {
+			switch v := v.(*Operation_Response_State_Step); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10098,7 +13825,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[77].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[102].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Module); i {
 			case 0:
 				return &v.state
@@ -10110,7 +13837,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[78].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[103].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command); i {
 			case 0:
 				return &v.state
@@ -10122,7 +13849,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[79].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[104].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Runner); i {
 			case 0:
 				return &v.state
@@ -10134,7 +13861,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[80].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[105].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Init); i {
 			case 0:
 				return &v.state
@@ -10146,7 +13873,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[81].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[106].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Validate); i {
 			case 0:
 				return &v.state
@@ -10158,7 +13885,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[82].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[107].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Plan); i {
 			case 0:
 				return &v.state
@@ -10170,7 +13897,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[83].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[108].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Apply); i {
 			case 0:
 				return &v.state
@@ -10182,7 +13909,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[84].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[109].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Destroy); i {
 			case 0:
 				return &v.state
@@ -10194,7 +13921,19 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[85].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[110].Exporter = func(v any, i int) any {
+			switch v := v.(*Terraform_Command_Progress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[111].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Exec); i {
 			case 0:
 				return &v.state
@@ -10206,7 +13945,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[86].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[112].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Output); i {
 			case 0:
 				return &v.state
@@ -10218,7 +13957,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[87].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[113].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Show); i {
 			case 0:
 				return &v.state
@@ -10230,7 +13969,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[88].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[114].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Init_Response); i {
 			case 0:
 				return &v.state
@@ -10242,7 +13981,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[89].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[115].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Validate_Response); i {
 			case 0:
 				return &v.state
@@ -10254,7 +13993,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[90].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[116].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Plan_Response); i {
 			case 0:
 				return &v.state
@@ -10266,7 +14005,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[91].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[117].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Apply_Response); i {
 			case 0:
 				return &v.state
@@ -10278,7 +14017,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[92].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[118].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Destroy_Response); i {
 			case 0:
 				return &v.state
@@ -10290,7 +14029,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[93].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[119].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Exec_Response); i {
 			case 0:
 				return &v.state
@@ -10302,7 +14041,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[94].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[120].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Output_Response); i {
 			case 0:
 				return &v.state
@@ -10314,7 +14053,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[95].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[121].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Output_Response_Meta); i {
 			case 0:
 				return &v.state
@@ -10326,7 +14065,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[96].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[122].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Command_Show_Response); i {
 			case 0:
 				return &v.state
@@ -10338,7 +14077,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[97].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[123].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Runner_Config); i {
 			case 0:
 				return &v.state
@@ -10350,7 +14089,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[99].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[125].Exporter = func(v any, i int) any {
 			switch v := v.(*Terraform_Runner_Config_Flags); i {
 			case 0:
 				return &v.state
@@ -10362,7 +14101,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[100].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[126].Exporter = func(v any, i int) any {
 			switch v := v.(*Matrix_Vector); i {
 			case 0:
 				return &v.state
@@ -10374,7 +14113,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[101].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[127].Exporter = func(v any, i int) any {
 			switch v := v.(*Matrix_Element); i {
 			case 0:
 				return &v.state
@@ -10386,7 +14125,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[102].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[128].Exporter = func(v any, i int) any {
 			switch v := v.(*Matrix_Exclude); i {
 			case 0:
 				return &v.state
@@ -10398,7 +14137,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[103].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[129].Exporter = func(v any, i int) any {
 			switch v := v.(*Sample_ID); i {
 			case 0:
 				return &v.state
@@ -10410,7 +14149,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[104].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[130].Exporter = func(v any, i int) any {
 			switch v := v.(*Sample_Subset); i {
 			case 0:
 				return &v.state
@@ -10422,7 +14161,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[105].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[131].Exporter = func(v any, i int) any {
 			switch v := v.(*Sample_Filter); i {
 			case 0:
 				return &v.state
@@ -10434,7 +14173,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[106].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[132].Exporter = func(v any, i int) any {
 			switch v := v.(*Sample_Element); i {
 			case 0:
 				return &v.state
@@ -10446,7 +14185,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[107].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[133].Exporter = func(v any, i int) any {
 			switch v := v.(*Sample_Observation); i {
 			case 0:
 				return &v.state
@@ -10458,7 +14197,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[108].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[134].Exporter = func(v any, i int) any {
 			switch v := v.(*Sample_Attribute); i {
 			case 0:
 				return &v.state
@@ -10470,7 +14209,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[109].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[135].Exporter = func(v any, i int) any {
 			switch v := v.(*Sample_Subset_ID); i {
 			case 0:
 				return &v.state
@@ -10482,7 +14221,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[110].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[136].Exporter = func(v any, i int) any {
 			switch v := v.(*Ref_Scenario); i {
 			case 0:
 				return &v.state
@@ -10494,7 +14233,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[111].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[137].Exporter = func(v any, i int) any {
 			switch v := v.(*Ref_Operation); i {
 			case 0:
 				return &v.state
@@ -10506,7 +14245,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[112].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[138].Exporter = func(v any, i int) any {
 			switch v := v.(*Ref_Sample); i {
 			case 0:
 				return &v.state
@@ -10518,7 +14257,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[113].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[139].Exporter = func(v any, i int) any {
 			switch v := v.(*Ref_Sample_Subset); i {
 			case 0:
 				return &v.state
@@ -10530,7 +14269,67 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[114].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[140].Exporter = func(v any, i int) any {
+			switch v := v.(*Lint_Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[141].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecScenarioStreamRequest_Start); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[142].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecScenarioStreamRequest_Stdin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[143].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecScenarioStreamRequest_TerminalSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[144].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecScenarioStreamResponse_Exit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[145].Exporter = func(v any, i int) any {
 			switch v := v.(*FormatRequest_File); i {
 			case 0:
 				return &v.state
@@ -10542,7 +14341,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[115].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[146].Exporter = func(v any, i int) any {
 			switch v := v.(*FormatRequest_Config); i {
 			case 0:
 				return &v.state
@@ -10554,7 +14353,7 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
-		file_hashicorp_enos_v1_enos_proto_msgTypes[116].Exporter = func(v any, i int) any {
+		file_hashicorp_enos_v1_enos_proto_msgTypes[147].Exporter = func(v any, i int) any {
 			switch v := v.(*FormatResponse_Response); i {
 			case 0:
 				return &v.state
@@ -10566,12 +14365,38 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 				return nil
 			}
 		}
+		file_hashicorp_enos_v1_enos_proto_msgTypes[148].Exporter = func(v any, i int) any {
+			switch v := v.(*CleanScenariosResponse_Module); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_hashicorp_enos_v1_enos_proto_msgTypes[19].OneofWrappers = []any{
+	file_hashicorp_enos_v1_enos_proto_msgTypes[28].OneofWrappers = []any{
 		(*EnosServiceListScenariosResponse_Scenario)(nil),
 		(*EnosServiceListScenariosResponse_Decode)(nil),
 	}
+	file_hashicorp_enos_v1_enos_proto_msgTypes[43].OneofWrappers = []any{
+		(*ExecScenarioStreamRequest_Start_)(nil),
+		(*ExecScenarioStreamRequest_Stdin_)(nil),
+		(*ExecScenarioStreamRequest_Resize)(nil),
+	}
+	file_hashicorp_enos_v1_enos_proto_msgTypes[44].OneofWrappers = []any{
+		(*ExecScenarioStreamResponse_Stdout)(nil),
+		(*ExecScenarioStreamResponse_Stderr)(nil),
+		(*ExecScenarioStreamResponse_Exit_)(nil),
+	}
 	file_hashicorp_enos_v1_enos_proto_msgTypes[60].OneofWrappers = []any{
+		(*OperationRecord_Event)(nil),
+		(*OperationRecord_Responses)(nil),
+	}
+	file_hashicorp_enos_v1_enos_proto_msgTypes[80].OneofWrappers = []any{
 		(*Operation_Request_Generate_)(nil),
 		(*Operation_Request_Check_)(nil),
 		(*Operation_Request_Launch_)(nil),
@@ -10579,8 +14404,9 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 		(*Operation_Request_Run_)(nil),
 		(*Operation_Request_Exec_)(nil),
 		(*Operation_Request_Output_)(nil),
+		(*Operation_Request_State_)(nil),
 	}
-	file_hashicorp_enos_v1_enos_proto_msgTypes[61].OneofWrappers = []any{
+	file_hashicorp_enos_v1_enos_proto_msgTypes[81].OneofWrappers = []any{
 		(*Operation_Response_Generate_)(nil),
 		(*Operation_Response_Check_)(nil),
 		(*Operation_Response_Launch_)(nil),
@@ -10588,8 +14414,9 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 		(*Operation_Response_Run_)(nil),
 		(*Operation_Response_Exec_)(nil),
 		(*Operation_Response_Output_)(nil),
+		(*Operation_Response_State_)(nil),
 	}
-	file_hashicorp_enos_v1_enos_proto_msgTypes[62].OneofWrappers = []any{
+	file_hashicorp_enos_v1_enos_proto_msgTypes[82].OneofWrappers = []any{
 		(*Operation_Event_Decode)(nil),
 		(*Operation_Event_Generate)(nil),
 		(*Operation_Event_Init)(nil),
@@ -10600,14 +14427,16 @@ func file_hashicorp_enos_v1_enos_proto_init() {
 		(*Operation_Event_Exec)(nil),
 		(*Operation_Event_Output)(nil),
 		(*Operation_Event_Show)(nil),
+		(*Operation_Event_Progress)(nil),
+		(*Operation_Event_Hook)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hashicorp_enos_v1_enos_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   117,
+			NumEnums:      10,
+			NumMessages:   149,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
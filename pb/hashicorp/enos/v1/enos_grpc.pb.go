@@ -38,6 +38,13 @@ const (
 	EnosService_ListSamples_FullMethodName                    = "/hashicorp.enos.v1.EnosService/ListSamples"
 	EnosService_ObserveSample_FullMethodName                  = "/hashicorp.enos.v1.EnosService/ObserveSample"
 	EnosService_OutlineScenarios_FullMethodName               = "/hashicorp.enos.v1.EnosService/OutlineScenarios"
+	EnosService_CleanScenarios_FullMethodName                 = "/hashicorp.enos.v1.EnosService/CleanScenarios"
+	EnosService_StatusScenarios_FullMethodName                = "/hashicorp.enos.v1.EnosService/StatusScenarios"
+	EnosService_ExecScenarioStream_FullMethodName             = "/hashicorp.enos.v1.EnosService/ExecScenarioStream"
+	EnosService_ExplainDiagnosticCode_FullMethodName          = "/hashicorp.enos.v1.EnosService/ExplainDiagnosticCode"
+	EnosService_LintScenarios_FullMethodName                  = "/hashicorp.enos.v1.EnosService/LintScenarios"
+	EnosService_ListScenarioVariables_FullMethodName          = "/hashicorp.enos.v1.EnosService/ListScenarioVariables"
+	EnosService_ReapScenarios_FullMethodName                  = "/hashicorp.enos.v1.EnosService/ReapScenarios"
 )
 
 // EnosServiceClient is the client API for EnosService service.
@@ -60,6 +67,13 @@ type EnosServiceClient interface {
 	ListSamples(ctx context.Context, in *ListSamplesRequest, opts ...grpc.CallOption) (*ListSamplesResponse, error)
 	ObserveSample(ctx context.Context, in *ObserveSampleRequest, opts ...grpc.CallOption) (*ObserveSampleResponse, error)
 	OutlineScenarios(ctx context.Context, in *OutlineScenariosRequest, opts ...grpc.CallOption) (*OutlineScenariosResponse, error)
+	CleanScenarios(ctx context.Context, in *CleanScenariosRequest, opts ...grpc.CallOption) (*CleanScenariosResponse, error)
+	StatusScenarios(ctx context.Context, in *StatusScenariosRequest, opts ...grpc.CallOption) (*StatusScenariosResponse, error)
+	ExecScenarioStream(ctx context.Context, opts ...grpc.CallOption) (EnosService_ExecScenarioStreamClient, error)
+	ExplainDiagnosticCode(ctx context.Context, in *ExplainDiagnosticCodeRequest, opts ...grpc.CallOption) (*ExplainDiagnosticCodeResponse, error)
+	LintScenarios(ctx context.Context, in *LintScenariosRequest, opts ...grpc.CallOption) (*LintScenariosResponse, error)
+	ListScenarioVariables(ctx context.Context, in *ListScenarioVariablesRequest, opts ...grpc.CallOption) (*ListScenarioVariablesResponse, error)
+	ReapScenarios(ctx context.Context, in *ReapScenariosRequest, opts ...grpc.CallOption) (*ReapScenariosResponse, error)
 }
 
 type enosServiceClient struct {
@@ -276,6 +290,98 @@ func (c *enosServiceClient) OutlineScenarios(ctx context.Context, in *OutlineSce
 	return out, nil
 }
 
+func (c *enosServiceClient) CleanScenarios(ctx context.Context, in *CleanScenariosRequest, opts ...grpc.CallOption) (*CleanScenariosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CleanScenariosResponse)
+	err := c.cc.Invoke(ctx, EnosService_CleanScenarios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enosServiceClient) StatusScenarios(ctx context.Context, in *StatusScenariosRequest, opts ...grpc.CallOption) (*StatusScenariosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusScenariosResponse)
+	err := c.cc.Invoke(ctx, EnosService_StatusScenarios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enosServiceClient) ExecScenarioStream(ctx context.Context, opts ...grpc.CallOption) (EnosService_ExecScenarioStreamClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EnosService_ServiceDesc.Streams[2], EnosService_ExecScenarioStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enosServiceExecScenarioStreamClient{ClientStream: stream}
+	return x, nil
+}
+
+type EnosService_ExecScenarioStreamClient interface {
+	Send(*ExecScenarioStreamRequest) error
+	Recv() (*ExecScenarioStreamResponse, error)
+	grpc.ClientStream
+}
+
+type enosServiceExecScenarioStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *enosServiceExecScenarioStreamClient) Send(m *ExecScenarioStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *enosServiceExecScenarioStreamClient) Recv() (*ExecScenarioStreamResponse, error) {
+	m := new(ExecScenarioStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *enosServiceClient) ExplainDiagnosticCode(ctx context.Context, in *ExplainDiagnosticCodeRequest, opts ...grpc.CallOption) (*ExplainDiagnosticCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainDiagnosticCodeResponse)
+	err := c.cc.Invoke(ctx, EnosService_ExplainDiagnosticCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enosServiceClient) LintScenarios(ctx context.Context, in *LintScenariosRequest, opts ...grpc.CallOption) (*LintScenariosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LintScenariosResponse)
+	err := c.cc.Invoke(ctx, EnosService_LintScenarios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enosServiceClient) ListScenarioVariables(ctx context.Context, in *ListScenarioVariablesRequest, opts ...grpc.CallOption) (*ListScenarioVariablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScenarioVariablesResponse)
+	err := c.cc.Invoke(ctx, EnosService_ListScenarioVariables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enosServiceClient) ReapScenarios(ctx context.Context, in *ReapScenariosRequest, opts ...grpc.CallOption) (*ReapScenariosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReapScenariosResponse)
+	err := c.cc.Invoke(ctx, EnosService_ReapScenarios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnosServiceServer is the server API for EnosService service.
 // All implementations should embed UnimplementedEnosServiceServer
 // for forward compatibility
@@ -296,6 +402,13 @@ type EnosServiceServer interface {
 	ListSamples(context.Context, *ListSamplesRequest) (*ListSamplesResponse, error)
 	ObserveSample(context.Context, *ObserveSampleRequest) (*ObserveSampleResponse, error)
 	OutlineScenarios(context.Context, *OutlineScenariosRequest) (*OutlineScenariosResponse, error)
+	CleanScenarios(context.Context, *CleanScenariosRequest) (*CleanScenariosResponse, error)
+	StatusScenarios(context.Context, *StatusScenariosRequest) (*StatusScenariosResponse, error)
+	ExecScenarioStream(EnosService_ExecScenarioStreamServer) error
+	ExplainDiagnosticCode(context.Context, *ExplainDiagnosticCodeRequest) (*ExplainDiagnosticCodeResponse, error)
+	LintScenarios(context.Context, *LintScenariosRequest) (*LintScenariosResponse, error)
+	ListScenarioVariables(context.Context, *ListScenarioVariablesRequest) (*ListScenarioVariablesResponse, error)
+	ReapScenarios(context.Context, *ReapScenariosRequest) (*ReapScenariosResponse, error)
 }
 
 // UnimplementedEnosServiceServer should be embedded to have forward compatible implementations.
@@ -350,6 +463,27 @@ func (UnimplementedEnosServiceServer) ObserveSample(context.Context, *ObserveSam
 func (UnimplementedEnosServiceServer) OutlineScenarios(context.Context, *OutlineScenariosRequest) (*OutlineScenariosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OutlineScenarios not implemented")
 }
+func (UnimplementedEnosServiceServer) CleanScenarios(context.Context, *CleanScenariosRequest) (*CleanScenariosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanScenarios not implemented")
+}
+func (UnimplementedEnosServiceServer) StatusScenarios(context.Context, *StatusScenariosRequest) (*StatusScenariosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatusScenarios not implemented")
+}
+func (UnimplementedEnosServiceServer) ExecScenarioStream(EnosService_ExecScenarioStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExecScenarioStream not implemented")
+}
+func (UnimplementedEnosServiceServer) ExplainDiagnosticCode(context.Context, *ExplainDiagnosticCodeRequest) (*ExplainDiagnosticCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainDiagnosticCode not implemented")
+}
+func (UnimplementedEnosServiceServer) LintScenarios(context.Context, *LintScenariosRequest) (*LintScenariosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LintScenarios not implemented")
+}
+func (UnimplementedEnosServiceServer) ListScenarioVariables(context.Context, *ListScenarioVariablesRequest) (*ListScenarioVariablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScenarioVariables not implemented")
+}
+func (UnimplementedEnosServiceServer) ReapScenarios(context.Context, *ReapScenariosRequest) (*ReapScenariosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReapScenarios not implemented")
+}
 
 // UnsafeEnosServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EnosServiceServer will
@@ -656,6 +790,140 @@ func _EnosService_OutlineScenarios_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnosService_CleanScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanScenariosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnosServiceServer).CleanScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnosService_CleanScenarios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnosServiceServer).CleanScenarios(ctx, req.(*CleanScenariosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnosService_StatusScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusScenariosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnosServiceServer).StatusScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnosService_StatusScenarios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnosServiceServer).StatusScenarios(ctx, req.(*StatusScenariosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnosService_ExecScenarioStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EnosServiceServer).ExecScenarioStream(&enosServiceExecScenarioStreamServer{ServerStream: stream})
+}
+
+type EnosService_ExecScenarioStreamServer interface {
+	Send(*ExecScenarioStreamResponse) error
+	Recv() (*ExecScenarioStreamRequest, error)
+	grpc.ServerStream
+}
+
+type enosServiceExecScenarioStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *enosServiceExecScenarioStreamServer) Send(m *ExecScenarioStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *enosServiceExecScenarioStreamServer) Recv() (*ExecScenarioStreamRequest, error) {
+	m := new(ExecScenarioStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _EnosService_ExplainDiagnosticCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainDiagnosticCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnosServiceServer).ExplainDiagnosticCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnosService_ExplainDiagnosticCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnosServiceServer).ExplainDiagnosticCode(ctx, req.(*ExplainDiagnosticCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnosService_LintScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LintScenariosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnosServiceServer).LintScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnosService_LintScenarios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnosServiceServer).LintScenarios(ctx, req.(*LintScenariosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnosService_ListScenarioVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScenarioVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnosServiceServer).ListScenarioVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnosService_ListScenarioVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnosServiceServer).ListScenarioVariables(ctx, req.(*ListScenarioVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnosService_ReapScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReapScenariosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnosServiceServer).ReapScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnosService_ReapScenarios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnosServiceServer).ReapScenarios(ctx, req.(*ReapScenariosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EnosService_ServiceDesc is the grpc.ServiceDesc for EnosService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -719,6 +987,30 @@ var EnosService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OutlineScenarios",
 			Handler:    _EnosService_OutlineScenarios_Handler,
 		},
+		{
+			MethodName: "CleanScenarios",
+			Handler:    _EnosService_CleanScenarios_Handler,
+		},
+		{
+			MethodName: "StatusScenarios",
+			Handler:    _EnosService_StatusScenarios_Handler,
+		},
+		{
+			MethodName: "ExplainDiagnosticCode",
+			Handler:    _EnosService_ExplainDiagnosticCode_Handler,
+		},
+		{
+			MethodName: "LintScenarios",
+			Handler:    _EnosService_LintScenarios_Handler,
+		},
+		{
+			MethodName: "ListScenarioVariables",
+			Handler:    _EnosService_ListScenarioVariables_Handler,
+		},
+		{
+			MethodName: "ReapScenarios",
+			Handler:    _EnosService_ReapScenarios_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -731,6 +1023,12 @@ var EnosService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _EnosService_OperationEventStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExecScenarioStream",
+			Handler:       _EnosService_ExecScenarioStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "hashicorp/enos/v1/enos.proto",
 }